@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = AllocateRangesFunction{}
+
+func NewAllocateRangesFunction() function.Function {
+	return &AllocateRangesFunction{
+		name: "allocate_ranges",
+	}
+}
+
+type AllocateRangesFunction struct {
+	name string
+}
+
+func (f AllocateRangesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f AllocateRangesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns non-overlapping IP CIDR ranges of the given sizes within a base range.",
+		Description: "Takes a base IP CIDR range and a list of prefix lengths, and returns a list of non-overlapping ranges within the base range with those prefix lengths, in the same order. Ranges are packed from the start of the base range, largest first, e.g. when the function is passed \"10.0.0.0/16\" and [24, 20, 22] as arguments it will return [\"10.0.20.0/24\", \"10.0.0.0/20\", \"10.0.16.0/22\"].",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "base",
+				Description: "The IP CIDR range to allocate ranges from, e.g. \"10.0.0.0/16\".",
+			},
+			function.ListParameter{
+				Name:        "prefix_lengths",
+				Description: "The prefix lengths of the ranges to allocate, e.g. [24, 20, 22].",
+				ElementType: types.Int64Type,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f AllocateRangesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	var arg1 []int64
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg0, &arg1))
+	if resp.Error != nil {
+		return
+	}
+
+	base, err := ParseCidrRangeArgument(arg0, 0)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	basePrefixLength, bits := base.Mask.Size()
+	for _, prefixLength := range arg1 {
+		if prefixLength < int64(basePrefixLength) || prefixLength > int64(bits) {
+			resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(1, fmt.Sprintf("The prefix length %d must be between the prefix length of the base range, %d, and %d.", prefixLength, basePrefixLength, bits)))
+			return
+		}
+	}
+
+	// Allocating the largest ranges first keeps every range aligned to its own
+	// size without leaving gaps between them.
+	order := make([]int, len(arg1))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return arg1[order[i]] < arg1[order[j]]
+	})
+
+	ranges := make([]string, len(arg1))
+	var previous *net.IPNet
+	for _, i := range order {
+		prefixLength := int(arg1[i])
+		var next *net.IPNet
+		if previous == nil {
+			next, _ = cidr.Subnet(base, prefixLength-basePrefixLength, 0)
+		} else {
+			var rollover bool
+			next, rollover = cidr.NextSubnet(previous, prefixLength)
+			if rollover || !base.Contains(next.IP) {
+				resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(1, fmt.Sprintf("The ranges with prefix lengths %v do not fit in the base range \"%s\".", arg1, arg0)))
+				return
+			}
+		}
+		ranges[i] = next.String()
+		previous = next
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, ranges))
+}
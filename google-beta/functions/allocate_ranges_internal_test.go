@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFunctionRun_allocate_ranges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it packs ranges largest first and returns them in the given order": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/16"), types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(24), types.Int64Value(20), types.Int64Value(22)})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.20.0/24"), types.StringValue("10.0.0.0/20"), types.StringValue("10.0.16.0/22")})),
+			},
+		},
+		"it allocates ranges filling the base range": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/23"), types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(24), types.Int64Value(24)})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/24"), types.StringValue("10.0.1.0/24")})),
+			},
+		},
+		"it returns an empty list when given no prefix lengths": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/16"), types.ListValueMust(types.Int64Type, []attr.Value{})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+		"it returns an error when the ranges do not fit in the base range": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/24"), types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(25), types.Int64Value(25), types.Int64Value(26)})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListNull(types.StringType)),
+				Error:  function.NewArgumentFuncError(1, "The ranges with prefix lengths [25 25 26] do not fit in the base range \"10.0.0.0/24\"."),
+			},
+		},
+		"it returns an error when a prefix length is shorter than the base range": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/16"), types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(8)})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ListNull(types.StringType)),
+				Error:  function.NewArgumentFuncError(1, "The prefix length 8 must be between the prefix length of the base range, 16, and 32."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(types.ListNull(types.StringType)),
+			}
+
+			// Act
+			NewAllocateRangesFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_allocate_ranges(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "allocate_ranges",
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Allocates the primary and secondary ranges of a GKE subnet
+				Config: testProviderFunction_allocate_ranges(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("ranges", "10.0.0.0/20,10.0.32.0/22,10.0.16.0/20"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_allocate_ranges(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+output "ranges" {
+  value = join(",", provider::google::%{function_name}("10.0.0.0/16", [20, 22, 20]))
+}
+`, context)
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = CidrOverlapsFunction{}
+
+func NewCidrOverlapsFunction() function.Function {
+	return &CidrOverlapsFunction{
+		name: "cidr_overlaps",
+	}
+}
+
+type CidrOverlapsFunction struct {
+	name string
+}
+
+func (f CidrOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f CidrOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns whether two IP CIDR ranges overlap.",
+		Description: "Takes two IP CIDR ranges and returns true if they have any address in common, e.g. when the function is passed \"10.0.0.0/16\" and \"10.0.4.0/22\" as arguments it will return true.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "range_a",
+				Description: "An IP CIDR range, e.g. \"10.0.0.0/16\".",
+			},
+			function.StringParameter{
+				Name:        "range_b",
+				Description: "An IP CIDR range, e.g. \"10.0.4.0/22\".",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f CidrOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0, arg1 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg0, &arg1))
+	if resp.Error != nil {
+		return
+	}
+
+	a, errA := ParseCidrRangeArgument(arg0, 0)
+	b, errB := ParseCidrRangeArgument(arg1, 1)
+	resp.Error = function.ConcatFuncErrors(errA, errB)
+	if resp.Error != nil {
+		return
+	}
+
+	// Aligned ranges either contain one another or are disjoint. Ranges of
+	// different IP versions never overlap.
+	overlaps := a.Contains(b.IP) || b.Contains(a.IP)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, overlaps))
+}
@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_cidr_overlaps(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns true when a range contains the other": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/16"), types.StringValue("10.0.4.0/22")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		"it returns true when the other range contains a range": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.4.0/22"), types.StringValue("10.0.0.0/16")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(true)),
+			},
+		},
+		"it returns false when ranges are adjacent": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/24"), types.StringValue("10.0.1.0/24")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+		"it returns false when ranges are of different IP versions": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/8"), types.StringValue("2600:1900::/64")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolValue(false)),
+			},
+		},
+		"it returns errors when given inputs are not ranges": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("/24"), types.StringValue("foobar")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.BoolNull()),
+				Error: function.ConcatFuncErrors(
+					function.NewArgumentFuncError(0, "The input string \"/24\" is only a netmask, expected a CIDR range like \"10.0.0.0/24\"."),
+					function.NewArgumentFuncError(1, "The input string \"foobar\" is not a valid CIDR range."),
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.BoolValue{}),
+			}
+
+			// Act
+			NewCidrOverlapsFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
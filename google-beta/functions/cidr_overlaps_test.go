@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_cidr_overlaps(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "cidr_overlaps",
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Compares a secondary range to the primary range of a subnet
+				Config: testProviderFunction_cidr_overlaps(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("overlapping", "true"),
+					resource.TestCheckOutput("disjoint", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_cidr_overlaps(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+output "overlapping" {
+  value = provider::google::%{function_name}("10.0.0.0/16", "10.0.4.0/22")
+}
+
+output "disjoint" {
+  value = provider::google::%{function_name}("10.0.0.0/16", "10.1.0.0/16")
+}
+`, context)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// GCP reserves the network address, the default gateway address, the second-to-last
+// address and the broadcast address in the primary IPv4 range of every subnet.
+// https://cloud.google.com/vpc/docs/subnets#unusable-ip-addresses-in-every-subnet
+const subnetReservedAddressCount = 4
+
+// Smallest IPv4 range allowed for a subnet
+const subnetMaxPrefixLength = 29
+
+// ParseCidrRangeArgument is reusable validation logic used in provider-defined functions that take an IP CIDR range
+func ParseCidrRangeArgument(input string, argument int64) (*net.IPNet, *function.FuncError) {
+	// Ranges given only as a netmask, e.g. "/24", are filled in by the API and so
+	// have no addresses to plan with. See tpgresource.IpCidrRangeDiffSuppress.
+	if strings.HasPrefix(input, "/") {
+		return nil, function.NewArgumentFuncError(argument, fmt.Sprintf("The input string \"%s\" is only a netmask, expected a CIDR range like \"10.0.0.0%s\".", input, input))
+	}
+
+	_, ipNet, err := net.ParseCIDR(input)
+	if err != nil {
+		return nil, function.NewArgumentFuncError(argument, fmt.Sprintf("The input string \"%s\" is not a valid CIDR range.", input))
+	}
+	return ipNet, nil
+}
+
+// ParseSubnetRangeArgument is reusable validation logic used in provider-defined functions that take the primary IPv4 range of a subnet
+func ParseSubnetRangeArgument(input string, argument int64) (*net.IPNet, *function.FuncError) {
+	ipNet, err := ParseCidrRangeArgument(input, argument)
+	if err != nil {
+		return nil, err
+	}
+
+	if ipNet.IP.To4() == nil {
+		return nil, function.NewArgumentFuncError(argument, fmt.Sprintf("The input string \"%s\" is not an IPv4 range.", input))
+	}
+	if prefixLength, _ := ipNet.Mask.Size(); prefixLength > subnetMaxPrefixLength {
+		return nil, function.NewArgumentFuncError(argument, fmt.Sprintf("The input string \"%s\" is smaller than the smallest subnet range, /%d.", input, subnetMaxPrefixLength))
+	}
+	return ipNet, nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgiamresource"
+)
+
+var _ function.Function = IamMemberFunction{}
+
+func NewIamMemberFunction() function.Function {
+	return &IamMemberFunction{
+		name: "iam_member",
+	}
+}
+
+type IamMemberFunction struct {
+	name string
+}
+
+func (f IamMemberFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f IamMemberFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns an IAM member string built from a principal type and identifier.",
+		Description: "Takes a principal type, such as \"user\", \"serviceAccount\", \"group\" or \"domain\", and an identifier, and returns the IAM member string used in IAM policies, e.g. \"user:jane@example.com\". The identifier is lowercased unless the principal type is case sensitive, matching how IAM compares members.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "type",
+				Description: "The type of the principal, e.g. \"user\", \"serviceAccount\", \"group\", \"domain\", \"principal\" or \"principalSet\".",
+			},
+			function.StringParameter{
+				Name:        "identifier",
+				Description: "The identifier of the principal, e.g. an email address, a domain, or a principal identifier like \"//iam.googleapis.com/locations/global/workforcePools/my-pool/subject/jane\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f IamMemberFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var memberType, identifier string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &memberType, &identifier))
+	if resp.Error != nil {
+		return
+	}
+
+	if memberType == "" {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, "The principal type cannot be empty."))
+		return
+	}
+	if identifier == "" {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(1, "The principal identifier cannot be empty."))
+		return
+	}
+
+	member := fmt.Sprintf("%s:%s", memberType, identifier)
+	if err := ValidateIamMemberArgument(member, 0); err != nil {
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tpgiamresource.NormalizeIamMemberCasing(member)))
+}
+
+// ValidateIamMemberArgument is reusable validation logic used in provider-defined functions returning IAM members
+func ValidateIamMemberArgument(member string, argument int64) *function.FuncError {
+	_, errs := tpgiamresource.ValidateIAMMember(member, "member")
+	if len(errs) > 0 {
+		return function.NewArgumentFuncError(argument, fmt.Sprintf("The IAM member \"%s\" is not valid: %s", member, errs[0]))
+	}
+	return nil
+}
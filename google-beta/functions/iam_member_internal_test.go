@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_iam_member(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the member with a lowercased identifier": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("user"), types.StringValue("Jane@Example.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("user:jane@example.com")),
+			},
+		},
+		"it keeps the casing of case sensitive principals": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("principal"), types.StringValue("//iam.googleapis.com/locations/global/workforcePools/my-pool/subject/Jane")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("principal://iam.googleapis.com/locations/global/workforcePools/my-pool/subject/Jane")),
+			},
+		},
+		"it returns an error when given type is empty": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(""), types.StringValue("jane@example.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The principal type cannot be empty."),
+			},
+		},
+		"it returns an error when given identifier is empty": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("user"), types.StringValue("")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(1, "The principal identifier cannot be empty."),
+			},
+		},
+		"it returns an error when the member is for a deleted principal": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("deleted"), types.StringValue("user:jane@example.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The IAM member \"deleted:user:jane@example.com\" is not valid: invalid value for member (Terraform does not support IAM members for deleted principals)"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.StringValue{}),
+			}
+
+			// Act
+			NewIamMemberFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_iam_member(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "iam_member",
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Builds IAM members with the casing IAM stores them with
+				Config: testProviderFunction_iam_member(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("user", "user:jane@example.com"),
+					resource.TestCheckOutput("principal", "principal://iam.googleapis.com/locations/global/workforcePools/my-pool/subject/Jane"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_iam_member(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+output "user" {
+  value = provider::google::%{function_name}("user", "Jane@Example.com")
+}
+
+output "principal" {
+  value = provider::google::%{function_name}("principal", "//iam.googleapis.com/locations/global/workforcePools/my-pool/subject/Jane")
+}
+`, context)
+}
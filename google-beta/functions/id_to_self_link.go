@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+var _ function.Function = IdToSelfLinkFunction{}
+
+func NewIdToSelfLinkFunction() function.Function {
+	return &IdToSelfLinkFunction{
+		name: "id_to_self_link",
+	}
+}
+
+type IdToSelfLinkFunction struct {
+	name string
+}
+
+func (f IdToSelfLinkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f IdToSelfLinkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the self link of a resource from its id and service.",
+		Description: "Takes a resource's id, the name of the service it belongs to and optionally a universe domain, and returns the resource's self link using the provider's default endpoint for the service, e.g. when the function is passed \"projects/my-project/notes/my-note\" and \"container_analysis\" as arguments it will return \"https://containeranalysis.googleapis.com/v1beta1/projects/my-project/notes/my-note\". Compute Engine self links are returned in the v1 form the Compute Engine API returns them in, like normalize_self_link does, e.g. \"https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network\". Custom endpoints set in the provider configuration are not used.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "The id of a resource, e.g. \"projects/my-project/global/networks/my-network\".",
+			},
+			function.StringParameter{
+				Name:        "service",
+				Description: "The name of the service the resource belongs to, as used in the provider's custom endpoint arguments without the \"_custom_endpoint\" suffix, e.g. \"compute\" or \"container\".",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "universe_domain",
+			Description: "The universe domain of the self link, defaults to \"googleapis.com\". At most one can be given.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f IdToSelfLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var id, service string
+	var universeDomains []string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id, &service, &universeDomains))
+	if resp.Error != nil {
+		return
+	}
+
+	universeDomain := defaultUniverseDomain
+	if len(universeDomains) > 1 {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(2, "At most one universe domain can be given."))
+		return
+	}
+	if len(universeDomains) == 1 {
+		universeDomain = strings.Trim(universeDomains[0], ".")
+		if universeDomain == "" || strings.ContainsAny(universeDomain, "/:") {
+			resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(2, fmt.Sprintf("The universe domain \"%s\" is not a domain, e.g. \"googleapis.com\".", universeDomains[0])))
+			return
+		}
+	}
+
+	id = strings.Trim(id, "/")
+	if id == "" {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, "The input string cannot be empty."))
+		return
+	}
+
+	// Base path keys are the product names, e.g. "ContainerAnalysis" for "container_analysis"
+	var basePath string
+	for key, bp := range transport_tpg.DefaultBasePaths {
+		if strings.EqualFold(key, strings.ReplaceAll(service, "_", "")) {
+			basePath = bp
+			break
+		}
+	}
+	if basePath == "" {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(1, fmt.Sprintf("The service \"%s\" is not supported by the provider.", service)))
+		return
+	}
+
+	// Compute Engine self links are compared in their v1 form, see normalize_self_link
+	if strings.EqualFold(service, "compute") {
+		resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, computeSelfLink(universeDomain, "v1", id)))
+		return
+	}
+
+	basePath = strings.Replace(basePath, "."+defaultUniverseDomain+"/", "."+universeDomain+"/", 1)
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, strings.TrimSuffix(basePath, "/")+"/"+id))
+}
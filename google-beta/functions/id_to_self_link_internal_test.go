@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_id_to_self_link(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the self link of a Compute Engine resource": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/global/networks/my-network"), types.StringValue("compute"), types.TupleValueMust([]attr.Type{}, []attr.Value{})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network")),
+			},
+		},
+		"it returns the self link of a Compute Engine resource in another universe domain": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/global/networks/my-network"), types.StringValue("compute"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("example-universe.com")})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("https://compute.example-universe.com/compute/v1/projects/my-project/global/networks/my-network")),
+			},
+		},
+		"it returns the self link of a resource in another universe domain": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/notes/my-note"), types.StringValue("container_analysis"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("example-universe.com")})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("https://containeranalysis.example-universe.com/v1beta1/projects/my-project/notes/my-note")),
+			},
+		},
+		"it returns an error when given universe domain is not a domain": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/global/networks/my-network"), types.StringValue("compute"), types.TupleValueMust([]attr.Type{types.StringType}, []attr.Value{types.StringValue("https://example-universe.com")})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(2, "The universe domain \"https://example-universe.com\" is not a domain, e.g. \"googleapis.com\"."),
+			},
+		},
+		"it returns an error when given more than one universe domain": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/global/networks/my-network"), types.StringValue("compute"), types.TupleValueMust([]attr.Type{types.StringType, types.StringType}, []attr.Value{types.StringValue("googleapis.com"), types.StringValue("example-universe.com")})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(2, "At most one universe domain can be given."),
+			},
+		},
+		"it returns the self link of a resource of a service with a multi-word name": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/notes/my-note"), types.StringValue("container_analysis"), types.TupleValueMust([]attr.Type{}, []attr.Value{})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("https://containeranalysis.googleapis.com/v1beta1/projects/my-project/notes/my-note")),
+			},
+		},
+		"it returns an error when given id is empty": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(""), types.StringValue("compute"), types.TupleValueMust([]attr.Type{}, []attr.Value{})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The input string cannot be empty."),
+			},
+		},
+		"it returns an error when given service is unknown": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/things/my-thing"), types.StringValue("foobar"), types.TupleValueMust([]attr.Type{}, []attr.Value{})}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(1, "The service \"foobar\" is not supported by the provider."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.StringValue{}),
+			}
+
+			// Act
+			NewIdToSelfLinkFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
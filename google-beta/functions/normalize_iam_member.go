@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgiamresource"
+)
+
+var _ function.Function = NormalizeIamMemberFunction{}
+
+func NewNormalizeIamMemberFunction() function.Function {
+	return &NormalizeIamMemberFunction{
+		name: "normalize_iam_member",
+	}
+}
+
+type NormalizeIamMemberFunction struct {
+	name string
+}
+
+func (f NormalizeIamMemberFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f NormalizeIamMemberFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns an IAM member string with the casing IAM stores it with.",
+		Description: "Takes a single string argument, which should be an IAM member such as \"user:Jane@Example.com\". IAM ignores the casing of most principal identifiers, so this function returns the member with its identifier lowercased, e.g. \"user:jane@example.com\". Identifiers of case sensitive principals, like \"principal:\" and \"principalSet:\" members and allUsers, are returned unchanged.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "member",
+				Description: "An IAM member, e.g. \"user:jane@example.com\", \"serviceAccount:my-sa@my-project.iam.gserviceaccount.com\" or \"allUsers\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f NormalizeIamMemberFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(ValidateIamMemberArgument(arg0, 0))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, tpgiamresource.NormalizeIamMemberCasing(arg0)))
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_normalize_iam_member(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the member with a lowercased identifier": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("serviceAccount:My-SA@my-project.iam.gserviceaccount.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("serviceAccount:my-sa@my-project.iam.gserviceaccount.com")),
+			},
+		},
+		"it keeps the casing of case sensitive principals": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("principalSet://iam.googleapis.com/locations/global/workforcePools/my-pool/group/Admins")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("principalSet://iam.googleapis.com/locations/global/workforcePools/my-pool/group/Admins")),
+			},
+		},
+		"it returns special members unchanged": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("allAuthenticatedUsers")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("allAuthenticatedUsers")),
+			},
+		},
+		"it returns an error when given input is not an IAM member": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("foobar")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, fmt.Sprintf("The IAM member \"%s\" is not valid: invalid value for member (IAM members must have one of the values outlined here: https://cloud.google.com/billing/docs/reference/rest/v1/Policy#Binding)", "foobar")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.StringValue{}),
+			}
+
+			// Act
+			NewNormalizeIamMemberFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_normalize_iam_member(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "normalize_iam_member",
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Normalizes the casing of IAM members
+				Config: testProviderFunction_normalize_iam_member(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("group", "group:admins@example.com"),
+					resource.TestCheckOutput("all_users", "allUsers"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_normalize_iam_member(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+output "group" {
+  value = provider::google::%{function_name}("group:Admins@Example.com")
+}
+
+output "all_users" {
+  value = provider::google::%{function_name}("allUsers")
+}
+`, context)
+}
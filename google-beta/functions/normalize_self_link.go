@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+var _ function.Function = NormalizeSelfLinkFunction{}
+
+func NewNormalizeSelfLinkFunction() function.Function {
+	return &NormalizeSelfLinkFunction{
+		name: "normalize_self_link",
+	}
+}
+
+type NormalizeSelfLinkFunction struct {
+	name string
+}
+
+func (f NormalizeSelfLinkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f NormalizeSelfLinkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns a Compute Engine self link in its canonical v1 form.",
+		Description: "Takes a single string argument, which should be a Compute Engine self link or full resource name. This function will return the v1 self link of the resource as returned by the Compute Engine API, so that links from the v1 and beta APIs and from Cloud Asset Inventory can be compared, e.g. when the function is passed \"https://compute.googleapis.com/compute/beta/projects/my-project/global/networks/my-network\" or \"//compute.googleapis.com/projects/my-project/global/networks/my-network\" as an argument it will return \"https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "self_link",
+				Description: "A Compute Engine self link or full resource name, in any API version or universe domain.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f NormalizeSelfLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := ParseSelfLinkArgument(arg0, 0)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+	if parsed.Service != "compute" {
+		err := function.NewArgumentFuncError(0, fmt.Sprintf("The input string \"%s\" is not a Compute Engine self link, found service \"%s\".", arg0, parsed.Service))
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	// The provider converts self links to v1 when comparing them too
+	selfLink := tpgresource.ConvertSelfLinkToV1(computeSelfLink(parsed.Domain, parsed.Version, parsed.RelativePath))
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, selfLink))
+}
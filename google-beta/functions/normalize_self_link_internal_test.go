@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_normalize_self_link(t *testing.T) {
+	t.Parallel()
+
+	selfLink := "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network"
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns a v1 self link unchanged": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(selfLink)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(selfLink)),
+			},
+		},
+		"it returns the v1 self link of a beta self link": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://www.googleapis.com/compute/beta/projects/my-project/global/networks/my-network")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(selfLink)),
+			},
+		},
+		"it returns the v1 self link of a self link on the service endpoint": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://compute.googleapis.com/compute/beta/projects/my-project/global/networks/my-network")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(selfLink)),
+			},
+		},
+		"it returns the v1 self link of a full resource name": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("//compute.googleapis.com/projects/my-project/global/networks/my-network")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(selfLink)),
+			},
+		},
+		"it keeps the universe domain of a self link": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("//compute.example-universe.com/projects/my-project/global/networks/my-network")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("https://compute.example-universe.com/compute/v1/projects/my-project/global/networks/my-network")),
+			},
+		},
+		"it returns an error when given input is not a Compute Engine self link": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("//container.googleapis.com/projects/my-project/locations/us-central1/clusters/my-cluster")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The input string \"//container.googleapis.com/projects/my-project/locations/us-central1/clusters/my-cluster\" is not a Compute Engine self link, found service \"container\"."),
+			},
+		},
+		"it returns an error when given input is an id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/global/networks/my-network")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The input string \"projects/my-project/global/networks/my-network\" is not a self link or full resource name."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.StringValue{}),
+			}
+
+			// Act
+			NewNormalizeSelfLinkFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_normalize_self_link(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "normalize_self_link",
+		"resource_name": fmt.Sprintf("tf-test-normalize-self-link-%s", acctest.RandString(t, 10)),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Uses google_compute_network resource's self_link attribute
+				Config: testProviderFunction_normalize_self_link(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchOutput("self_link", regexp.MustCompile(fmt.Sprintf("^https://www.googleapis.com/compute/v1/projects/[^/]+/global/networks/%s$", context["resource_name"]))),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_normalize_self_link(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+resource "google_compute_network" "default" {
+  name                    = "%{resource_name}"
+  auto_create_subnetworks = false
+}
+
+output "self_link" {
+  value = provider::google::%{function_name}(google_compute_network.default.self_link)
+}
+`, context)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = ParseIamMemberFunction{}
+
+var parseIamMemberAttributeTypes = map[string]attr.Type{
+	"type":       types.StringType,
+	"identifier": types.StringType,
+	"deleted":    types.BoolType,
+	"uid":        types.StringType,
+}
+
+func NewParseIamMemberFunction() function.Function {
+	return &ParseIamMemberFunction{
+		name: "parse_iam_member",
+	}
+}
+
+type ParseIamMemberFunction struct {
+	name string
+}
+
+func (f ParseIamMemberFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f ParseIamMemberFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the principal type and identifier of an IAM member.",
+		Description: "Takes a single string argument, which should be an IAM member. This function will return an object with the member's principal \"type\" and \"identifier\", e.g. when the function is passed \"user:jane@example.com\" as an argument it will return {type = \"user\", identifier = \"jane@example.com\", deleted = false, uid = null}. Members of deleted principals, like \"deleted:user:jane@example.com?uid=123456789012345678901\", have \"deleted\" set and their \"uid\" returned. The special members allUsers and allAuthenticatedUsers have a null identifier.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "member",
+				Description: "An IAM member, e.g. \"user:jane@example.com\", \"serviceAccount:my-sa@my-project.iam.gserviceaccount.com\" or \"allUsers\".",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: parseIamMemberAttributeTypes,
+		},
+	}
+}
+
+func (f ParseIamMemberFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	member := arg0
+	deleted := strings.HasPrefix(member, "deleted:")
+	uid := types.StringNull()
+	if deleted {
+		member = strings.TrimPrefix(member, "deleted:")
+		if i := strings.LastIndex(member, "?uid="); i >= 0 {
+			uid = types.StringValue(member[i+len("?uid="):])
+			member = member[:i]
+		}
+	}
+
+	// Deleted members are validated like the member they were before deletion
+	resp.Error = function.ConcatFuncErrors(ValidateIamMemberArgument(member, 0))
+	if resp.Error != nil {
+		return
+	}
+
+	memberType, identifier := member, types.StringNull()
+	if i := strings.Index(member, ":"); i >= 0 {
+		memberType, identifier = member[:i], types.StringValue(member[i+1:])
+	}
+
+	result, diags := types.ObjectValue(parseIamMemberAttributeTypes, map[string]attr.Value{
+		"type":       types.StringValue(memberType),
+		"identifier": identifier,
+		"deleted":    types.BoolValue(deleted),
+		"uid":        uid,
+	})
+	resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
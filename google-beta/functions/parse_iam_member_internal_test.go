@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFunctionRun_parse_iam_member(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the type and identifier of a member": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("user:jane@example.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectValueMust(parseIamMemberAttributeTypes, map[string]attr.Value{
+					"type":       types.StringValue("user"),
+					"identifier": types.StringValue("jane@example.com"),
+					"deleted":    types.BoolValue(false),
+					"uid":        types.StringNull(),
+				})),
+			},
+		},
+		"it returns a null identifier for special members": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("allUsers")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectValueMust(parseIamMemberAttributeTypes, map[string]attr.Value{
+					"type":       types.StringValue("allUsers"),
+					"identifier": types.StringNull(),
+					"deleted":    types.BoolValue(false),
+					"uid":        types.StringNull(),
+				})),
+			},
+		},
+		"it returns the uid of deleted members": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("deleted:serviceAccount:my-sa@my-project.iam.gserviceaccount.com?uid=123456789012345678901")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectValueMust(parseIamMemberAttributeTypes, map[string]attr.Value{
+					"type":       types.StringValue("serviceAccount"),
+					"identifier": types.StringValue("my-sa@my-project.iam.gserviceaccount.com"),
+					"deleted":    types.BoolValue(true),
+					"uid":        types.StringValue("123456789012345678901"),
+				})),
+			},
+		},
+		"it returns an error when given input is not an IAM member": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("foobar")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectNull(parseIamMemberAttributeTypes)),
+				Error:  function.NewArgumentFuncError(0, fmt.Sprintf("The IAM member \"%s\" is not valid: invalid value for member (IAM members must have one of the values outlined here: https://cloud.google.com/billing/docs/reference/rest/v1/Policy#Binding)", "foobar")),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(types.ObjectNull(parseIamMemberAttributeTypes)),
+			}
+
+			// Act
+			NewParseIamMemberFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_parse_iam_member(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "parse_iam_member",
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Parses the type and identifier of IAM members
+				Config: testProviderFunction_parse_iam_member(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("type", "serviceAccount"),
+					resource.TestCheckOutput("identifier", "my-sa@my-project.iam.gserviceaccount.com"),
+					resource.TestCheckOutput("deleted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_parse_iam_member(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+locals {
+  member = provider::google::%{function_name}("deleted:serviceAccount:my-sa@my-project.iam.gserviceaccount.com?uid=123456789012345678901")
+}
+
+output "type" {
+  value = local.member.type
+}
+
+output "identifier" {
+  value = local.member.identifier
+}
+
+output "deleted" {
+  value = local.member.deleted
+}
+`, context)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+var _ function.Function = RelativePathFunction{}
+
+func NewRelativePathFunction() function.Function {
+	return &RelativePathFunction{
+		name: "relative_path",
+	}
+}
+
+type RelativePathFunction struct {
+	name string
+}
+
+func (f RelativePathFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f RelativePathFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the path of a resource starting from its project.",
+		Description: "Takes a single string argument, which should be a self link, full resource name or id of a resource within a project. This function will return the resource's path starting from \"projects/\", the form the provider uses to compare self links, e.g. when the function is passed \"https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network\" as an argument it will return \"projects/my-project/global/networks/my-network\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "self_link",
+				Description: "A self link, full resource name or id of a resource within a project.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f RelativePathFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	path, err := tpgresource.GetRelativePath(arg0)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, fmt.Sprintf("The input string \"%s\" doesn't contain the expected pattern \"projects/\".", arg0)))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, path))
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_relative_path(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "relative_path",
+		"resource_name": fmt.Sprintf("tf-test-relative-path-%s", acctest.RandString(t, 10)),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Uses google_compute_network resource's self_link and id attributes
+				Config: testProviderFunction_relative_path(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("path_matches", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_relative_path(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+resource "google_compute_network" "default" {
+  name                    = "%{resource_name}"
+  auto_create_subnetworks = false
+}
+
+output "path_matches" {
+  value = provider::google::%{function_name}(google_compute_network.default.self_link) == google_compute_network.default.id
+}
+`, context)
+}
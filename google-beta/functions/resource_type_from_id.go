@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+var _ function.Function = ResourceTypeFromIdFunction{}
+
+func NewResourceTypeFromIdFunction() function.Function {
+	return &ResourceTypeFromIdFunction{
+		name: "resource_type_from_id",
+	}
+}
+
+type ResourceTypeFromIdFunction struct {
+	name string
+}
+
+func (f ResourceTypeFromIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f ResourceTypeFromIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the resource type from a provided resource's id or self link.",
+		Description: "Takes a single string argument, which should be an id, self link or full resource name of a resource. This function will return the collection the resource belongs to, e.g. when the function is passed \"projects/my-project/zones/us-central1-a/instances/my-instance\" as an argument it will return \"instances\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "An id, self link or full resource name of a resource, e.g. \"projects/my-project/zones/us-central1-a/instances/my-instance\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ResourceTypeFromIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	id := arg0
+	if parsed, err := ParseSelfLinkArgument(arg0, 0); err == nil {
+		id = parsed.RelativePath
+	}
+
+	// Ids alternate between collections and names, except for the "global" scope of Compute Engine ids
+	id = strings.Trim(id, "/")
+	segments := removeGlobalScope(strings.Split(id, "/"))
+	if len(segments) < 2 || len(segments)%2 != 0 {
+		err := function.NewArgumentFuncError(0, fmt.Sprintf("The input string \"%s\" is not a resource id, expected \"{collection}/{name}\" pairs.", arg0))
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	// The collection is the name of the id's parent path
+	name := tpgresource.GetResourceNameFromSelfLink(id)
+	collection := tpgresource.GetResourceNameFromSelfLink(strings.TrimSuffix(id, "/"+name))
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, collection))
+}
+
+// removeGlobalScope drops the "global" segment of Compute Engine ids like
+// "projects/{project}/global/networks/{name}", which isn't followed by a name
+func removeGlobalScope(segments []string) []string {
+	result := make([]string, 0, len(segments))
+	for _, segment := range segments {
+		if segment == "global" && len(result) > 0 && len(result)%2 == 0 {
+			continue
+		}
+		result = append(result, segment)
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_resource_type_from_id(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the resource type of an id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/zones/us-central1-a/instances/my-instance")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("instances")),
+			},
+		},
+		"it returns the resource type of an id with a global scope": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/global/networks/my-network")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("networks")),
+			},
+		},
+		"it returns the resource type of an id in the global location": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/locations/global/keyRings/my-key-ring")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("keyRings")),
+			},
+		},
+		"it returns the resource type of a self link": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("networks")),
+			},
+		},
+		"it returns the resource type of a full resource name": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("//cloudresourcemanager.googleapis.com/folders/123456789012")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("folders")),
+			},
+		},
+		"it returns an error when given input is not an id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/zones")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The input string \"projects/my-project/zones\" is not a resource id, expected \"{collection}/{name}\" pairs."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.StringValue{}),
+			}
+
+			// Act
+			NewResourceTypeFromIdFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
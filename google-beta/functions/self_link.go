@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+)
+
+const defaultUniverseDomain = "googleapis.com"
+
+// apiVersionRegex matches the API version segment of a self link, e.g. "v1", "v1beta4" or "beta"
+var apiVersionRegex = regexp.MustCompile(`^(?:v[0-9]+(?:(?:alpha|beta|p)[0-9a-z]*)?|alpha|beta)$`)
+
+// parsedSelfLink holds the parts of a self link or full resource name
+type parsedSelfLink struct {
+	// Service is the name of the API serving the resource, e.g. "compute"
+	Service string
+	// Domain is the universe domain of the API, e.g. "googleapis.com"
+	Domain string
+	// Version is the API version of a self link, e.g. "beta". It's empty for full resource names.
+	Version string
+	// RelativePath is the path of the resource, its id, e.g. "projects/my-project/zones/us-central1-a/instances/my-instance"
+	RelativePath string
+}
+
+// ParseSelfLinkArgument is reusable validation logic used in provider-defined functions that take self links.
+// It accepts both self links like "https://www.googleapis.com/compute/v1/projects/my-project/global/networks/my-network"
+// and full resource names like "//compute.googleapis.com/projects/my-project/global/networks/my-network".
+func ParseSelfLinkArgument(input string, argument int64) (*parsedSelfLink, *function.FuncError) {
+	invalid := function.NewArgumentFuncError(argument, fmt.Sprintf("The input string \"%s\" is not a self link or full resource name.", input))
+
+	u, err := url.Parse(input)
+	if err != nil || u.Host == "" || u.Path == "" {
+		return nil, invalid
+	}
+
+	host := strings.SplitN(u.Host, ".", 2)
+	if len(host) != 2 {
+		return nil, invalid
+	}
+	parsed := &parsedSelfLink{
+		Service: host[0],
+		Domain:  host[1],
+	}
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+
+	switch u.Scheme {
+	case "":
+		// Full resource names have no scheme or version, e.g. "//compute.googleapis.com/projects/..."
+	case "http", "https":
+		// Self links on the shared endpoint name the service in the path, e.g. "https://www.googleapis.com/compute/v1/projects/..."
+		if parsed.Service == "www" && len(segments) > 0 {
+			parsed.Service = segments[0]
+			segments = segments[1:]
+		}
+		// Drop path segments up to the API version, e.g. "compute/v1/" in "https://compute.googleapis.com/compute/v1/projects/..."
+		for i, segment := range segments {
+			if apiVersionRegex.MatchString(segment) {
+				parsed.Version = segment
+				segments = segments[i+1:]
+				break
+			}
+		}
+		// Compute Engine self links are read the way the provider reads them when comparing them
+		if parsed.Service == "compute" {
+			if relativePath, err := tpgresource.GetRelativePath(u.Path); err == nil {
+				segments = strings.Split(strings.Trim(relativePath, "/"), "/")
+			}
+		}
+	default:
+		return nil, invalid
+	}
+
+	parsed.RelativePath = strings.Join(segments, "/")
+	if parsed.RelativePath == "" {
+		return nil, invalid
+	}
+	return parsed, nil
+}
+
+// computeSelfLink returns the self link of a Compute Engine resource in the
+// form the Compute Engine API returns it, from the universe domain and version
+// of the API and the resource's id. The version defaults to v1.
+func computeSelfLink(domain, version, relativePath string) string {
+	// Compute Engine serves self links from the shared endpoint in the default
+	// universe, and from its own endpoint in other universes
+	host := "www.googleapis.com"
+	if domain != defaultUniverseDomain {
+		host = "compute." + domain
+	}
+	if version == "" {
+		version = "v1"
+	}
+	return fmt.Sprintf("https://%s/compute/%s/%s", host, version, relativePath)
+}
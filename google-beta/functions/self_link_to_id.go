@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = SelfLinkToIdFunction{}
+
+func NewSelfLinkToIdFunction() function.Function {
+	return &SelfLinkToIdFunction{
+		name: "self_link_to_id",
+	}
+}
+
+type SelfLinkToIdFunction struct {
+	name string
+}
+
+func (f SelfLinkToIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f SelfLinkToIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the id of a resource from its self link or full resource name.",
+		Description: "Takes a single string argument, which should be a self link or full resource name. This function will return the resource's id, its path without the API host and version, e.g. when the function is passed \"https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/my-instance\" or \"//compute.googleapis.com/projects/my-project/zones/us-central1-a/instances/my-instance\" as an argument it will return \"projects/my-project/zones/us-central1-a/instances/my-instance\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "self_link",
+				Description: "A self link or full resource name of a resource, in any API version or universe domain.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f SelfLinkToIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	parsed, err := ParseSelfLinkArgument(arg0, 0)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, parsed.RelativePath))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_self_link_to_id(t *testing.T) {
+	t.Parallel()
+
+	id := "projects/my-project/zones/us-central1-a/instances/my-instance"
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the id of a Compute Engine self link": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://www.googleapis.com/compute/v1/projects/my-project/zones/us-central1-a/instances/my-instance")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(id)),
+			},
+		},
+		"it returns the id of a beta Compute Engine self link on the service endpoint": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://compute.googleapis.com/compute/beta/projects/my-project/zones/us-central1-a/instances/my-instance")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(id)),
+			},
+		},
+		"it returns the id of a full resource name": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("//compute.googleapis.com/projects/my-project/zones/us-central1-a/instances/my-instance")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(id)),
+			},
+		},
+		"it returns the id of a self link in another universe domain": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://compute.example-universe.com/compute/v1/projects/my-project/zones/us-central1-a/instances/my-instance")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(id)),
+			},
+		},
+		"it returns the id of a self link of another service": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("https://sqladmin.googleapis.com/sql/v1beta4/projects/my-project/instances/my-instance")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("projects/my-project/instances/my-instance")),
+			},
+		},
+		"it returns an error when given input is an id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(id)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, fmt.Sprintf("The input string \"%s\" is not a self link or full resource name.", id)),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.StringValue{}),
+			}
+
+			// Act
+			NewSelfLinkToIdFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = ServiceAccountMemberFunction{}
+
+// serviceAccountEmailRegex matches the email of a service account, optionally
+// within a service account's id or name
+var serviceAccountEmailRegex = regexp.MustCompile(`^(?:projects/[^/]+/serviceAccounts/)?([^/@]+@[^/@]+)$`)
+
+func NewServiceAccountMemberFunction() function.Function {
+	return &ServiceAccountMemberFunction{
+		name: "service_account_member",
+	}
+}
+
+type ServiceAccountMemberFunction struct {
+	name string
+}
+
+func (f ServiceAccountMemberFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f ServiceAccountMemberFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the IAM member of a service account.",
+		Description: "Takes a single string argument, which should be a service account's email or id. This function will return the IAM member for the service account, e.g. when the function is passed the id \"projects/my-project/serviceAccounts/my-sa@my-project.iam.gserviceaccount.com\" or the email \"my-sa@my-project.iam.gserviceaccount.com\" as an argument it will return \"serviceAccount:my-sa@my-project.iam.gserviceaccount.com\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "email_or_id",
+				Description: "A service account's email, like \"my-sa@my-project.iam.gserviceaccount.com\", or id, like \"projects/my-project/serviceAccounts/my-sa@my-project.iam.gserviceaccount.com\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f ServiceAccountMemberFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	submatches := serviceAccountEmailRegex.FindStringSubmatch(arg0)
+	if submatches == nil {
+		err := function.NewArgumentFuncError(0, fmt.Sprintf("The input string \"%s\" is not a service account email or id, expected \"{email}\" or \"projects/{project}/serviceAccounts/{email}\".", arg0))
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, "serviceAccount:"+strings.ToLower(submatches[1])))
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_service_account_member(t *testing.T) {
+	t.Parallel()
+
+	member := "serviceAccount:my-sa@my-project.iam.gserviceaccount.com"
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the expected output value when given a service account email": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("my-sa@my-project.iam.gserviceaccount.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(member)),
+			},
+		},
+		"it returns the expected output value when given a service account id": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/serviceAccounts/my-sa@my-project.iam.gserviceaccount.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(member)),
+			},
+		},
+		"it lowercases the service account email": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("My-SA@my-project.iam.gserviceaccount.com")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(member)),
+			},
+		},
+		"it returns an error when given input is not a service account": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/my-project/serviceAccounts/my-sa")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The input string \"projects/my-project/serviceAccounts/my-sa\" is not a service account email or id, expected \"{email}\" or \"projects/{project}/serviceAccounts/{email}\"."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.StringValue{}),
+			}
+
+			// Act
+			NewServiceAccountMemberFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_service_account_member(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "service_account_member",
+		"resource_name": fmt.Sprintf("tf-test-sa-member-%s", acctest.RandString(t, 10)),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Uses google_service_account resource's id and email attributes
+				Config: testProviderFunction_service_account_member(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("from_id", "true"),
+					resource.TestCheckOutput("from_email", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_service_account_member(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+resource "google_service_account" "default" {
+  account_id = "%{resource_name}"
+}
+
+output "from_id" {
+  value = provider::google::%{function_name}(google_service_account.default.id) == google_service_account.default.member
+}
+
+output "from_email" {
+  value = provider::google::%{function_name}(google_service_account.default.email) == google_service_account.default.member
+}
+`, context)
+}
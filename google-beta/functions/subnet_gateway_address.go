@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+
+	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = SubnetGatewayAddressFunction{}
+
+func NewSubnetGatewayAddressFunction() function.Function {
+	return &SubnetGatewayAddressFunction{
+		name: "subnet_gateway_address",
+	}
+}
+
+type SubnetGatewayAddressFunction struct {
+	name string
+}
+
+func (f SubnetGatewayAddressFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f SubnetGatewayAddressFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the default gateway address of a subnet.",
+		Description: "Takes a single string argument, which should be the primary IPv4 range of a subnet. This function will return the address GCP reserves for the subnet's default gateway, e.g. when the function is passed \"10.0.0.0/24\" as an argument it will return \"10.0.0.1\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip_cidr_range",
+				Description: "The primary IPv4 range of a subnet, e.g. \"10.0.0.0/24\".",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f SubnetGatewayAddressFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	ipNet, err := ParseSubnetRangeArgument(arg0, 0)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	// The gateway is the second address of the range
+	gateway, _ := cidr.Host(ipNet, 1)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, gateway.String()))
+}
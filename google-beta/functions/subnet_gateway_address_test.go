@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_subnet_gateway_address(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "subnet_gateway_address",
+		"resource_name": fmt.Sprintf("tf-test-subnet-gateway-%s", acctest.RandString(t, 10)),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Uses google_compute_subnetwork resource's ip_cidr_range and gateway_address attributes
+				Config: testProviderFunction_subnet_gateway_address(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("gateway_matches", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_subnet_gateway_address(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+resource "google_compute_network" "default" {
+  name                    = "%{resource_name}"
+  auto_create_subnetworks = false
+}
+
+resource "google_compute_subnetwork" "default" {
+  name          = "%{resource_name}"
+  region        = "us-central1"
+  network       = google_compute_network.default.id
+  ip_cidr_range = "10.2.0.0/16"
+}
+
+output "gateway_matches" {
+  value = provider::google::%{function_name}(google_compute_subnetwork.default.ip_cidr_range) == google_compute_subnetwork.default.gateway_address
+}
+`, context)
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+
+	"github.com/apparentlymart/go-cidr/cidr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = SubnetUsableRangeFunction{}
+
+var subnetUsableRangeAttributeTypes = map[string]attr.Type{
+	"first_address": types.StringType,
+	"last_address":  types.StringType,
+	"count":         types.Int64Type,
+}
+
+func NewSubnetUsableRangeFunction() function.Function {
+	return &SubnetUsableRangeFunction{
+		name: "subnet_usable_range",
+	}
+}
+
+type SubnetUsableRangeFunction struct {
+	name string
+}
+
+func (f SubnetUsableRangeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f SubnetUsableRangeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the addresses of a subnet that can be assigned to resources.",
+		Description: "Takes a single string argument, which should be the primary IPv4 range of a subnet. GCP reserves the first two and the last two addresses of the range, so this function returns an object with the first and last usable addresses and their count, e.g. when the function is passed \"10.0.0.0/24\" as an argument it will return {first_address = \"10.0.0.2\", last_address = \"10.0.0.253\", count = 252}.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "ip_cidr_range",
+				Description: "The primary IPv4 range of a subnet, e.g. \"10.0.0.0/24\".",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: subnetUsableRangeAttributeTypes,
+		},
+	}
+}
+
+func (f SubnetUsableRangeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var arg0 string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.GetArgument(ctx, 0, &arg0))
+	if resp.Error != nil {
+		return
+	}
+
+	ipNet, err := ParseSubnetRangeArgument(arg0, 0)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	first, last := cidr.AddressRange(ipNet)
+	first = cidr.Inc(cidr.Inc(first))
+	last = cidr.Dec(cidr.Dec(last))
+
+	result, diags := types.ObjectValue(subnetUsableRangeAttributeTypes, map[string]attr.Value{
+		"first_address": types.StringValue(first.String()),
+		"last_address":  types.StringValue(last.String()),
+		"count":         types.Int64Value(int64(cidr.AddressCount(ipNet)) - subnetReservedAddressCount),
+	})
+	resp.Error = function.ConcatFuncErrors(function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
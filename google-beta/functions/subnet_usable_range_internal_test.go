@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFunctionRun_subnet_usable_range(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it excludes the first two and last two addresses of the range": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0/24")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectValueMust(subnetUsableRangeAttributeTypes, map[string]attr.Value{
+					"first_address": types.StringValue("10.0.0.2"),
+					"last_address":  types.StringValue("10.0.0.253"),
+					"count":         types.Int64Value(252),
+				})),
+			},
+		},
+		"it returns the usable addresses of the smallest subnet range": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("192.168.1.8/29")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectValueMust(subnetUsableRangeAttributeTypes, map[string]attr.Value{
+					"first_address": types.StringValue("192.168.1.10"),
+					"last_address":  types.StringValue("192.168.1.13"),
+					"count":         types.Int64Value(4),
+				})),
+			},
+		},
+		"it returns an error when given input is not a range": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("10.0.0.0")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.ObjectNull(subnetUsableRangeAttributeTypes)),
+				Error:  function.NewArgumentFuncError(0, "The input string \"10.0.0.0\" is not a valid CIDR range."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(types.ObjectNull(subnetUsableRangeAttributeTypes)),
+			}
+
+			// Act
+			NewSubnetUsableRangeFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
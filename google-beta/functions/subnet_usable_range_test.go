@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_subnet_usable_range(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "subnet_usable_range",
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Returns the addresses of a subnet range that are not reserved
+				Config: testProviderFunction_subnet_usable_range(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckOutput("first_address", "10.0.0.2"),
+					resource.TestCheckOutput("last_address", "10.0.0.253"),
+					resource.TestCheckOutput("count", "252"),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_subnet_usable_range(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+locals {
+  usable = provider::google::%{function_name}("10.0.0.0/24")
+}
+
+output "first_address" {
+  value = local.usable.first_address
+}
+
+output "last_address" {
+  value = local.usable.last_address
+}
+
+output "count" {
+  value = local.usable.count
+}
+`, context)
+}
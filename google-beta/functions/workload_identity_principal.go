@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = WorkloadIdentityPrincipalFunction{}
+
+// workloadIdentityPoolRegex matches the name of a workload identity pool,
+// optionally as a full resource name
+var workloadIdentityPoolRegex = regexp.MustCompile(`^(?://iam\.googleapis\.com/)?(projects/[^/]+/locations/global/workloadIdentityPools/[^/]+)$`)
+
+func NewWorkloadIdentityPrincipalFunction() function.Function {
+	return &WorkloadIdentityPrincipalFunction{
+		name: "workload_identity_principal",
+	}
+}
+
+type WorkloadIdentityPrincipalFunction struct {
+	name string
+}
+
+func (f WorkloadIdentityPrincipalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f WorkloadIdentityPrincipalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Returns the IAM member of a subject of a workload identity pool.",
+		Description: "Takes the name of a workload identity pool and a subject, and returns the IAM member for a single identity of the pool, e.g. when the function is passed the GKE workload identity pool \"projects/123456789012/locations/global/workloadIdentityPools/my-project.svc.id.goog\" and \"ns/my-namespace/sa/my-ksa\" as arguments it will return \"principal://iam.googleapis.com/projects/123456789012/locations/global/workloadIdentityPools/my-project.svc.id.goog/subject/ns/my-namespace/sa/my-ksa\".",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "pool",
+				Description: "The name of a workload identity pool, like the name attribute of google_iam_workload_identity_pool, e.g. \"projects/123456789012/locations/global/workloadIdentityPools/my-pool\". The project must be given by number.",
+			},
+			function.StringParameter{
+				Name:        "subject",
+				Description: "The subject of the identity, as mapped by the pool's provider to google.subject.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f WorkloadIdentityPrincipalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	// Load arguments from function call
+	var pool, subject string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pool, &subject))
+	if resp.Error != nil {
+		return
+	}
+
+	submatches := workloadIdentityPoolRegex.FindStringSubmatch(pool)
+	if submatches == nil {
+		err := function.NewArgumentFuncError(0, fmt.Sprintf("The input string \"%s\" is not a workload identity pool name, expected \"projects/{project_number}/locations/global/workloadIdentityPools/{pool}\".", pool))
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	if subject == "" {
+		err := function.NewArgumentFuncError(1, "The subject must not be empty.")
+		resp.Error = function.ConcatFuncErrors(err)
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, fmt.Sprintf("principal://iam.googleapis.com/%s/subject/%s", submatches[1], subject)))
+}
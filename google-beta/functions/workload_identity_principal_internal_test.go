@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestFunctionRun_workload_identity_principal(t *testing.T) {
+	t.Parallel()
+
+	pool := "projects/123456789012/locations/global/workloadIdentityPools/my-pool"
+	principal := "principal://iam.googleapis.com/projects/123456789012/locations/global/workloadIdentityPools/my-pool/subject/system:serviceaccount:my-namespace:my-ksa"
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"it returns the expected output value when given a pool name": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(pool), types.StringValue("system:serviceaccount:my-namespace:my-ksa")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(principal)),
+			},
+		},
+		"it returns the expected output value when given a full pool resource name": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("//iam.googleapis.com/" + pool), types.StringValue("system:serviceaccount:my-namespace:my-ksa")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(principal)),
+			},
+		},
+		"it returns the expected output value when given a GKE subject": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("projects/123456789012/locations/global/workloadIdentityPools/my-project.svc.id.goog"), types.StringValue("ns/my-namespace/sa/my-ksa")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("principal://iam.googleapis.com/projects/123456789012/locations/global/workloadIdentityPools/my-project.svc.id.goog/subject/ns/my-namespace/sa/my-ksa")),
+			},
+		},
+		"it returns the expected output value when given a GitHub subject": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(pool), types.StringValue("repo:my-org/my-repo:ref:refs/heads/main")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue("principal://iam.googleapis.com/projects/123456789012/locations/global/workloadIdentityPools/my-pool/subject/repo:my-org/my-repo:ref:refs/heads/main")),
+			},
+		},
+		"it returns an error when given input is not a pool": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("my-pool"), types.StringValue("system:serviceaccount:my-namespace:my-ksa")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(0, "The input string \"my-pool\" is not a workload identity pool name, expected \"projects/{project_number}/locations/global/workloadIdentityPools/{pool}\"."),
+			},
+		},
+		"it returns an error when given subject is empty": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(pool), types.StringValue("")}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringNull()),
+				Error:  function.NewArgumentFuncError(1, "The subject must not be empty."),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		tn, tc := name, testCase
+
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			got := function.RunResponse{
+				Result: function.NewResultData(basetypes.StringValue{}),
+			}
+
+			// Act
+			NewWorkloadIdentityPrincipalFunction().Run(context.Background(), tc.request, &got)
+
+			// Assert
+			if diff := cmp.Diff(got.Result, tc.expected.Result); diff != "" {
+				t.Errorf("unexpected diff between expected and received result: %s", diff)
+			}
+			if diff := cmp.Diff(got.Error, tc.expected.Error); diff != "" {
+				t.Errorf("unexpected diff between expected and received errors: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package functions_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccProviderFunction_workload_identity_principal(t *testing.T) {
+	t.Parallel()
+	// Skipping due to requiring TF 1.8.0 in VCR systems : https://github.com/hashicorp/terraform-provider-google/issues/17451
+	acctest.SkipIfVcr(t)
+
+	context := map[string]interface{}{
+		"function_name": "workload_identity_principal",
+		"resource_name": fmt.Sprintf("tf-test-pool-%s", acctest.RandString(t, 10)),
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Uses google_iam_workload_identity_pool resource's name attribute
+				Config: testProviderFunction_workload_identity_principal(context),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchOutput("principal", regexp.MustCompile(fmt.Sprintf("^principal://iam.googleapis.com/projects/[0-9]+/locations/global/workloadIdentityPools/%s/subject/system:serviceaccount:my-namespace:my-ksa$", context["resource_name"]))),
+				),
+			},
+		},
+	})
+}
+
+func testProviderFunction_workload_identity_principal(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+# terraform block required for provider function to be found
+terraform {
+  required_providers {
+    google = {
+      source = "hashicorp/google"
+    }
+  }
+}
+
+resource "google_iam_workload_identity_pool" "default" {
+  workload_identity_pool_id = "%{resource_name}"
+}
+
+output "principal" {
+  value = provider::google::%{function_name}(google_iam_workload_identity_pool.default.name, "system:serviceaccount:my-namespace:my-ksa")
+}
+`, context)
+}
@@ -19,11 +19,19 @@ type ProviderModel struct {
 	Zone                                      types.String `tfsdk:"zone"`
 	Scopes                                    types.List   `tfsdk:"scopes"`
 	Batching                                  types.List   `tfsdk:"batching"`
+	Retry                                     types.List   `tfsdk:"retry"`
+	RequestRateLimits                         types.Map    `tfsdk:"request_rate_limits"`
+	RequestTracing                            types.List   `tfsdk:"request_tracing"`
+	RequestCache                              types.List   `tfsdk:"request_cache"`
+	Recording                                 types.List   `tfsdk:"recording"`
 	UserProjectOverride                       types.Bool   `tfsdk:"user_project_override"`
 	RequestTimeout                            types.String `tfsdk:"request_timeout"`
 	RequestReason                             types.String `tfsdk:"request_reason"`
 	UniverseDomain                            types.String `tfsdk:"universe_domain"`
 	DefaultLabels                             types.Map    `tfsdk:"default_labels"`
+	DefaultAnnotations                        types.Map    `tfsdk:"default_annotations"`
+	DefaultResourceManagerTags                types.Map    `tfsdk:"default_resource_manager_tags"`
+	LabelPolicy                               types.List   `tfsdk:"label_policy"`
 	AddTerraformAttributionLabel              types.Bool   `tfsdk:"add_terraform_attribution_label"`
 	TerraformAttributionLabelAdditionStrategy types.String `tfsdk:"terraform_attribution_label_addition_strategy"`
 
@@ -197,6 +205,66 @@ var ProviderBatchingAttributes = map[string]attr.Type{
 	"enable_batching": types.BoolType,
 }
 
+type ProviderRetry struct {
+	MaxAttempts           types.Int64   `tfsdk:"max_attempts"`
+	InitialBackoff        types.String  `tfsdk:"initial_backoff"`
+	MaxBackoff            types.String  `tfsdk:"max_backoff"`
+	Jitter                types.Float64 `tfsdk:"jitter"`
+	RetryableStatusCodes  types.List    `tfsdk:"retryable_status_codes"`
+	RetryableErrorReasons types.List    `tfsdk:"retryable_error_reasons"`
+}
+
+var ProviderRetryAttributes = map[string]attr.Type{
+	"max_attempts":            types.Int64Type,
+	"initial_backoff":         types.StringType,
+	"max_backoff":             types.StringType,
+	"jitter":                  types.Float64Type,
+	"retryable_status_codes":  types.ListType{ElemType: types.Int64Type},
+	"retryable_error_reasons": types.ListType{ElemType: types.StringType},
+}
+
+type ProviderRequestTracing struct {
+	Path   types.String `tfsdk:"path"`
+	Format types.String `tfsdk:"format"`
+}
+
+var ProviderRequestTracingAttributes = map[string]attr.Type{
+	"path":   types.StringType,
+	"format": types.StringType,
+}
+
+type ProviderRequestCache struct {
+	TTL types.String `tfsdk:"ttl"`
+}
+
+var ProviderRequestCacheAttributes = map[string]attr.Type{
+	"ttl": types.StringType,
+}
+
+type ProviderRecording struct {
+	Path types.String `tfsdk:"path"`
+	Mode types.String `tfsdk:"mode"`
+}
+
+var ProviderRecordingAttributes = map[string]attr.Type{
+	"path": types.StringType,
+	"mode": types.StringType,
+}
+
+type ProviderLabelPolicy struct {
+	RequiredKeys  types.List  `tfsdk:"required_keys"`
+	AllowedValues types.Map   `tfsdk:"allowed_values"`
+	DeniedKeys    types.List  `tfsdk:"denied_keys"`
+	MaxLabels     types.Int64 `tfsdk:"max_labels"`
+}
+
+var ProviderLabelPolicyAttributes = map[string]attr.Type{
+	"required_keys":  types.ListType{ElemType: types.StringType},
+	"allowed_values": types.MapType{ElemType: types.StringType},
+	"denied_keys":    types.ListType{ElemType: types.StringType},
+	"max_labels":     types.Int64Type,
+}
+
 // ProviderMetaModel describes the provider meta model
 type ProviderMetaModel struct {
 	ModuleName types.String `tfsdk:"module_name"`
@@ -5,6 +5,9 @@ package fwprovider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -122,6 +125,10 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"request_rate_limits": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.Float64Type,
+			},
 			"user_project_override": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -138,6 +145,14 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				ElementType: types.StringType,
 			},
+			"default_annotations": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"default_resource_manager_tags": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"add_terraform_attribution_label": schema.BoolAttribute{
 				Optional: true,
 			},
@@ -1018,6 +1033,128 @@ func (p *FrameworkProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 					},
 				},
 			},
+			"retry": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"max_attempts": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+						},
+						"initial_backoff": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								NonNegativeDurationValidator(),
+							},
+						},
+						"max_backoff": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								NonNegativeDurationValidator(),
+							},
+						},
+						"jitter": schema.Float64Attribute{
+							Optional: true,
+							Validators: []validator.Float64{
+								float64validator.Between(0, 1),
+							},
+						},
+						"retryable_status_codes": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.Int64Type,
+							Validators: []validator.List{
+								listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+							},
+						},
+						"retryable_error_reasons": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"request_tracing": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Required: true,
+						},
+						"format": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(transport_tpg.RequestTraceFormats...),
+							},
+						},
+					},
+				},
+			},
+			"request_cache": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ttl": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								NonNegativeDurationValidator(),
+							},
+						},
+					},
+				},
+			},
+			"label_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"required_keys": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"allowed_values": schema.MapAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"denied_keys": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+						},
+						"max_labels": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 64),
+							},
+						},
+					},
+				},
+			},
+			"recording": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Required: true,
+						},
+						"mode": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(transport_tpg.RecordingModes...),
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 
@@ -1064,11 +1201,25 @@ func (p *FrameworkProvider) Resources(_ context.Context) []func() resource.Resou
 // Functions defines the provider functions implemented in the provider.
 func (p *FrameworkProvider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
+		functions.NewAllocateRangesFunction,
+		functions.NewCidrOverlapsFunction,
+		functions.NewIamMemberFunction,
+		functions.NewIdToSelfLinkFunction,
 		functions.NewLocationFromIdFunction,
 		functions.NewNameFromIdFunction,
+		functions.NewNormalizeIamMemberFunction,
+		functions.NewNormalizeSelfLinkFunction,
+		functions.NewParseIamMemberFunction,
 		functions.NewProjectFromIdFunction,
 		functions.NewRegionFromIdFunction,
 		functions.NewRegionFromZoneFunction,
+		functions.NewRelativePathFunction,
+		functions.NewResourceTypeFromIdFunction,
+		functions.NewSelfLinkToIdFunction,
+		functions.NewServiceAccountMemberFunction,
+		functions.NewSubnetGatewayAddressFunction,
+		functions.NewSubnetUsableRangeFunction,
+		functions.NewWorkloadIdentityPrincipalFunction,
 		functions.NewZoneFromIdFunction,
 	}
 }
@@ -3,6 +3,7 @@
 package fwprovider_test
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -235,7 +236,7 @@ func testAccCheckDNSManagedZoneDestroyProducerFramework(t *testing.T) func(s *te
 				billingProject = p.BillingProject.String()
 			}
 
-			_, diags := fwtransport.SendFrameworkRequest(&p.FrameworkProvider.FrameworkProviderConfig, "GET", billingProject, url, p.UserAgent, nil)
+			_, diags := fwtransport.SendFrameworkRequest(context.Background(), &p.FrameworkProvider.FrameworkProviderConfig, "GET", billingProject, url, p.UserAgent, nil)
 			if !diags.HasError() {
 				return fmt.Errorf("DNSManagedZone still exists at %s", url)
 			}
@@ -37,6 +37,7 @@ type FrameworkProviderConfig struct {
 	BillingProject             types.String
 	Client                     *http.Client
 	Context                    context.Context
+	Recording                  *transport_tpg.RecordingConfig
 	gRPCLoggingOptions         []option.ClientOption
 	PollInterval               time.Duration
 	Project                    types.String
@@ -44,6 +45,8 @@ type FrameworkProviderConfig struct {
 	Zone                       types.String
 	RequestBatcherIam          *transport_tpg.RequestBatcher
 	RequestBatcherServiceUsage *transport_tpg.RequestBatcher
+	RequestBatcherDns          *transport_tpg.RequestBatcher
+	RetryPolicy                *transport_tpg.RetryPolicy
 	Scopes                     types.List
 	TokenSource                oauth2.TokenSource
 	UniverseDomain             types.String
@@ -203,6 +206,18 @@ func (p *FrameworkProviderConfig) LoadAndValidateFramework(ctx context.Context,
 		p.UserAgent = fmt.Sprintf("%s %s", ua, ext)
 	}
 
+	// Handle Retry Policy, which is needed to build the client's retry transport
+	p.RetryPolicy = GetRetryPolicy(ctx, data.Retry, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Handle Recording, which is needed to build the client's recording transport
+	p.Recording = GetRecording(ctx, data.Recording, diags)
+	if diags.HasError() {
+		return
+	}
+
 	// Set up client configuration
 	p.SetupClient(ctx, *data, diags)
 	if diags.HasError() {
@@ -359,10 +374,14 @@ func (p *FrameworkProviderConfig) LoadAndValidateFramework(ctx context.Context,
 	p.Zone = data.Zone
 	p.UserProjectOverride = data.UserProjectOverride
 	p.PollInterval = 10 * time.Second
+	if p.Recording.IsReplay() {
+		p.PollInterval = transport_tpg.RecordingReplayPollInterval
+	}
 	p.Project = data.Project
 	p.UniverseDomain = data.UniverseDomain
 	p.RequestBatcherServiceUsage = transport_tpg.NewRequestBatcher("Service Usage", ctx, batchingConfig)
 	p.RequestBatcherIam = transport_tpg.NewRequestBatcher("IAM", ctx, batchingConfig)
+	p.RequestBatcherDns = transport_tpg.NewRequestBatcher("DNS", ctx, batchingConfig)
 }
 
 // HandleDefaults will handle all the defaults necessary in the provider
@@ -1712,9 +1731,25 @@ func (p *FrameworkProviderConfig) HandleDefaults(ctx context.Context, data *fwmo
 }
 
 func (p *FrameworkProviderConfig) SetupClient(ctx context.Context, data fwmodels.ProviderModel, diags *diag.Diagnostics) {
-	tokenSource := GetTokenSource(ctx, data, false, diags)
-	if diags.HasError() {
-		return
+	var recorder *transport_tpg.RequestRecorder
+	if p.Recording != nil {
+		var err error
+		recorder, err = transport_tpg.GetRequestRecorder(p.Recording)
+		if err != nil {
+			diags.AddError("error setting up request recording", err.Error())
+			return
+		}
+	}
+
+	var tokenSource oauth2.TokenSource
+	if p.Recording.IsReplay() {
+		// Replayed requests are answered from recorded interactions, so no credentials are needed.
+		tokenSource = transport_tpg.RecordingReplayTokenSource()
+	} else {
+		tokenSource = GetTokenSource(ctx, data, false, diags)
+		if diags.HasError() {
+			return
+		}
 	}
 
 	cleanCtx := context.WithValue(ctx, oauth2.HTTPClient, cleanhttp.DefaultClient())
@@ -1727,23 +1762,62 @@ func (p *FrameworkProviderConfig) SetupClient(ctx context.Context, data fwmodels
 	}
 
 	// Userinfo is fetched before request logging is enabled to reduce additional noise.
-	p.logGoogleIdentities(ctx, data, diags)
-	if diags.HasError() {
-		return
+	// It isn't recorded, so it can't be fetched when replaying.
+	if !p.Recording.IsReplay() {
+		p.logGoogleIdentities(ctx, data, diags)
+		if diags.HasError() {
+			return
+		}
 	}
 
 	// 2. Logging Transport - ensure we log HTTP requests to GCP APIs.
 	loggingTransport := logging.NewTransport("Google", client.Transport)
 
-	// 3. Retry Transport - retries common temporary errors
+	// 3. Rate Limit Transport - throttles requests per service if configured.
+	// Keep it beneath the retry transport so each retried request is throttled as well.
+	// Base paths are resolved on first use, as they're set after the client. The token
+	// buckets are shared with the SDK provider's transport.
+	rateLimits := GetRequestRateLimits(ctx, data.RequestRateLimits, diags)
+	if diags.HasError() {
+		return
+	}
+	var rateLimitedTransport http.RoundTripper = loggingTransport
+	if len(rateLimits) > 0 {
+		rateLimitedTransport = transport_tpg.NewTransportWithRateLimits(loggingTransport, rateLimits, transport_tpg.BasePathResolver(p))
+	}
+
+	// Request tracing records each attempt beneath the retry transport, so retries
+	// show up as attempts of the traced request.
+	requestTracing := GetRequestTracing(ctx, data.RequestTracing, diags)
+	if diags.HasError() {
+		return
+	}
+	var tracer *transport_tpg.RequestTracer
+	if requestTracing != nil {
+		tracer = transport_tpg.GetRequestTracer(requestTracing)
+		rateLimitedTransport = tracer.AttemptTransport(rateLimitedTransport)
+	}
+
+	// 4. Retry Transport - retries common temporary errors
 	// Keep order for wrapping logging so we log each retried request as well.
 	// This value should be used if needed to create shallow copies with additional retry predicates.
 	// See ClientWithAdditionalRetries
-	retryTransport := transport_tpg.NewTransportWithDefaultRetries(loggingTransport)
+	retryTransport := transport_tpg.NewTransportWithDefaultRetries(rateLimitedTransport, p.RetryPolicy)
+
+	// 5. Request Cache Transport - reuses responses to identical read requests if configured.
+	// Keep it above the retry transport so a cached response is the final result of a request.
+	requestCache := GetRequestCache(ctx, data.RequestCache, diags)
+	if diags.HasError() {
+		return
+	}
+	var cachedTransport http.RoundTripper = retryTransport
+	if requestCache != nil {
+		cachedTransport = transport_tpg.NewTransportWithRequestCache(retryTransport, requestCache)
+	}
 
-	// 4. Header Transport - outer wrapper to inject additional headers we want to apply
+	// 6. Header Transport - outer wrapper to inject additional headers we want to apply
 	// before making requests
-	headerTransport := transport_tpg.NewTransportWithHeaders(retryTransport)
+	headerTransport := transport_tpg.NewTransportWithHeaders(cachedTransport)
 	if !data.RequestReason.IsNull() {
 		headerTransport.Set("X-Goog-Request-Reason", data.RequestReason.ValueString())
 	}
@@ -1757,6 +1831,17 @@ func (p *FrameworkProviderConfig) SetupClient(ctx context.Context, data fwmodels
 	// Set final transport value.
 	client.Transport = headerTransport
 
+	// 7. Recording Transport - records each request and its response, or answers it from
+	// recorded interactions when replaying, if configured.
+	if recorder != nil {
+		client.Transport = recorder.Transport(client.Transport)
+	}
+
+	// 8. Trace Transport - records each request, including its retries, if configured.
+	if tracer != nil {
+		client.Transport = tracer.Transport(client.Transport)
+	}
+
 	// This timeout is a timeout per HTTP request, not per logical operation.
 	timeout, err := time.ParseDuration(data.RequestTimeout.ValueString())
 	if err != nil {
@@ -1802,7 +1887,7 @@ func (p *FrameworkProviderConfig) logGoogleIdentities(ctx context.Context, data
 
 		p.Client = oauth2.NewClient(ctx, tokenSource) // p.Client isn't initialised fully when this code is called.
 
-		email := GetCurrentUserEmailFramework(p, p.UserAgent, &d)
+		email := GetCurrentUserEmailFramework(ctx, p, p.UserAgent, &d)
 		if d.HasError() {
 			tflog.Info(ctx, "error retrieving userinfo for your provider credentials. have you enabled the 'https://www.googleapis.com/auth/userinfo.email' scope?")
 		}
@@ -1818,7 +1903,7 @@ func (p *FrameworkProviderConfig) logGoogleIdentities(ctx context.Context, data
 	}
 
 	p.Client = oauth2.NewClient(ctx, tokenSource) // p.Client isn't initialised fully when this code is called.
-	email := GetCurrentUserEmailFramework(p, p.UserAgent, &d)
+	email := GetCurrentUserEmailFramework(ctx, p, p.UserAgent, &d)
 	if d.HasError() {
 		tflog.Info(ctx, "error retrieving userinfo for your provider credentials. have you enabled the 'https://www.googleapis.com/auth/userinfo.email' scope?")
 	}
@@ -1980,6 +2065,190 @@ func GetBatchingConfig(ctx context.Context, data types.List, diags *diag.Diagnos
 	return bc
 }
 
+// GetRetryPolicy returns the retry policy given the provider configuration
+// set for retry. It returns nil if the retry block is unset.
+func GetRetryPolicy(ctx context.Context, data types.List, diags *diag.Diagnostics) *transport_tpg.RetryPolicy {
+	if data.IsNull() || data.IsUnknown() || len(data.Elements()) == 0 {
+		return nil
+	}
+
+	var prConfigs []fwmodels.ProviderRetry
+	d := data.ElementsAs(ctx, &prConfigs, true)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	policy := &transport_tpg.RetryPolicy{}
+	cfg := prConfigs[0]
+
+	if !cfg.MaxAttempts.IsNull() {
+		policy.MaxAttempts = int(cfg.MaxAttempts.ValueInt64())
+	}
+
+	if !cfg.InitialBackoff.IsNull() && cfg.InitialBackoff.ValueString() != "" {
+		initialBackoff, err := time.ParseDuration(cfg.InitialBackoff.ValueString())
+		if err != nil {
+			diags.AddError("error parsing initial backoff time duration", err.Error())
+			return nil
+		}
+		policy.InitialBackoff = initialBackoff
+	}
+
+	if !cfg.MaxBackoff.IsNull() && cfg.MaxBackoff.ValueString() != "" {
+		maxBackoff, err := time.ParseDuration(cfg.MaxBackoff.ValueString())
+		if err != nil {
+			diags.AddError("error parsing max backoff time duration", err.Error())
+			return nil
+		}
+		policy.MaxBackoff = maxBackoff
+	}
+
+	if !cfg.Jitter.IsNull() {
+		policy.Jitter = cfg.Jitter.ValueFloat64()
+	}
+
+	if !cfg.RetryableStatusCodes.IsNull() {
+		var codes []int64
+		diags.Append(cfg.RetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+		if diags.HasError() {
+			return nil
+		}
+		for _, code := range codes {
+			policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, int(code))
+		}
+	}
+
+	if !cfg.RetryableErrorReasons.IsNull() {
+		diags.Append(cfg.RetryableErrorReasons.ElementsAs(ctx, &policy.RetryableErrorReasons, false)...)
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	if err := policy.Validate(); err != nil {
+		diags.AddError("invalid retry configuration", err.Error())
+		return nil
+	}
+
+	return policy
+}
+
+// GetRequestRateLimits returns the per-service request rate limits given the
+// provider configuration set for request_rate_limits
+func GetRequestRateLimits(ctx context.Context, data types.Map, diags *diag.Diagnostics) map[string]float64 {
+	if data.IsNull() || data.IsUnknown() {
+		return nil
+	}
+
+	var rates map[string]float64
+	d := data.ElementsAs(ctx, &rates, false)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	v := make(map[string]interface{}, len(rates))
+	for service, rate := range rates {
+		v[service] = rate
+	}
+	limits, err := transport_tpg.ExpandProviderRateLimits(v)
+	if err != nil {
+		diags.AddError("invalid request_rate_limits configuration", err.Error())
+		return nil
+	}
+	return limits
+}
+
+// GetRequestTracing returns the request tracing configuration given the
+// provider configuration set for request_tracing. It returns nil if the
+// request_tracing block is unset.
+func GetRequestTracing(ctx context.Context, data types.List, diags *diag.Diagnostics) *transport_tpg.RequestTracingConfig {
+	if data.IsNull() || data.IsUnknown() || len(data.Elements()) == 0 {
+		return nil
+	}
+
+	var prtConfigs []fwmodels.ProviderRequestTracing
+	d := data.ElementsAs(ctx, &prtConfigs, true)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	cfg := &transport_tpg.RequestTracingConfig{
+		Path:   prtConfigs[0].Path.ValueString(),
+		Format: transport_tpg.RequestTraceFormatSpans,
+	}
+	if !prtConfigs[0].Format.IsNull() && prtConfigs[0].Format.ValueString() != "" {
+		cfg.Format = prtConfigs[0].Format.ValueString()
+	}
+
+	if err := cfg.Validate(); err != nil {
+		diags.AddError("invalid request_tracing configuration", err.Error())
+		return nil
+	}
+	return cfg
+}
+
+// GetRecording returns the recording configuration given the provider
+// configuration set for recording. It returns nil if the recording block is
+// unset.
+func GetRecording(ctx context.Context, data types.List, diags *diag.Diagnostics) *transport_tpg.RecordingConfig {
+	if data.IsNull() || data.IsUnknown() || len(data.Elements()) == 0 {
+		return nil
+	}
+
+	var prConfigs []fwmodels.ProviderRecording
+	d := data.ElementsAs(ctx, &prConfigs, true)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	cfg := &transport_tpg.RecordingConfig{
+		Path: prConfigs[0].Path.ValueString(),
+		Mode: transport_tpg.RecordingModeRecord,
+	}
+	if !prConfigs[0].Mode.IsNull() && prConfigs[0].Mode.ValueString() != "" {
+		cfg.Mode = prConfigs[0].Mode.ValueString()
+	}
+
+	if err := cfg.Validate(); err != nil {
+		diags.AddError("invalid recording configuration", err.Error())
+		return nil
+	}
+	return cfg
+}
+
+// GetRequestCache returns the request cache configuration given the provider
+// configuration set for request_cache. It returns nil if the request_cache
+// block is unset.
+func GetRequestCache(ctx context.Context, data types.List, diags *diag.Diagnostics) *transport_tpg.RequestCacheConfig {
+	if data.IsNull() || data.IsUnknown() || len(data.Elements()) == 0 {
+		return nil
+	}
+
+	var prcConfigs []fwmodels.ProviderRequestCache
+	d := data.ElementsAs(ctx, &prcConfigs, true)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil
+	}
+
+	cfg := &transport_tpg.RequestCacheConfig{
+		TTL: transport_tpg.DefaultRequestCacheTTL,
+	}
+	if !prcConfigs[0].TTL.IsNull() && prcConfigs[0].TTL.ValueString() != "" {
+		ttl, err := time.ParseDuration(prcConfigs[0].TTL.ValueString())
+		if err != nil {
+			diags.AddError("error parsing request cache ttl", err.Error())
+			return nil
+		}
+		cfg.TTL = ttl
+	}
+	return cfg
+}
+
 func GetRegionFromRegionSelfLink(selfLink basetypes.StringValue) basetypes.StringValue {
 	re := regexp.MustCompile("/compute/[a-zA-Z0-9]*/projects/[a-zA-Z0-9-]*/regions/([a-zA-Z0-9-]*)")
 	value := selfLink.String()
@@ -5,6 +5,7 @@ package fwtransport_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -1713,6 +1714,353 @@ func TestFrameworkProvider_LoadAndValidateFramework_batching(t *testing.T) {
 	}
 }
 
+func TestGetRetryPolicy(t *testing.T) {
+	cases := map[string]struct {
+		SetRetryAsNull    bool
+		MaxAttemptsValue  basetypes.Int64Value
+		InitialBackoff    basetypes.StringValue
+		MaxBackoff        basetypes.StringValue
+		StatusCodesValues []attr.Value
+
+		ExpectNilPolicy         bool
+		ExpectMaxAttempts       int
+		ExpectInitialBackoff    time.Duration
+		ExpectMaxBackoff        time.Duration
+		ExpectRetryableStatuses []int
+		ExpectError             bool
+	}{
+		"when the retry block is null, no policy is returned": {
+			SetRetryAsNull:  true,
+			ExpectNilPolicy: true,
+		},
+		"retry can be configured with all values": {
+			MaxAttemptsValue:        types.Int64Value(4),
+			InitialBackoff:          types.StringValue("1s"),
+			MaxBackoff:              types.StringValue("20s"),
+			StatusCodesValues:       []attr.Value{types.Int64Value(504)},
+			ExpectMaxAttempts:       4,
+			ExpectInitialBackoff:    time.Second,
+			ExpectMaxBackoff:        20 * time.Second,
+			ExpectRetryableStatuses: []int{504},
+		},
+		"if retry is an empty block, a policy with default values is returned": {
+			MaxAttemptsValue: types.Int64Null(),
+			InitialBackoff:   types.StringNull(),
+			MaxBackoff:       types.StringNull(),
+		},
+		"if initial_backoff is greater than max_backoff, there's an error": {
+			MaxAttemptsValue: types.Int64Null(),
+			InitialBackoff:   types.StringValue("1m"),
+			MaxBackoff:       types.StringValue("1s"),
+			ExpectError:      true,
+		},
+		"if initial_backoff is an invalid value, there's an error": {
+			MaxAttemptsValue: types.Int64Null(),
+			InitialBackoff:   types.StringValue("invalid value"),
+			MaxBackoff:       types.StringNull(),
+			ExpectError:      true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			diags := diag.Diagnostics{}
+
+			retry := types.ListNull(types.ObjectType{}.WithAttributeTypes(fwmodels.ProviderRetryAttributes))
+			if !tc.SetRetryAsNull {
+				statusCodes := types.ListNull(types.Int64Type)
+				if tc.StatusCodesValues != nil {
+					statusCodes, _ = types.ListValue(types.Int64Type, tc.StatusCodesValues)
+				}
+				r, d := types.ObjectValue(
+					fwmodels.ProviderRetryAttributes,
+					map[string]attr.Value{
+						"max_attempts":            tc.MaxAttemptsValue,
+						"initial_backoff":         tc.InitialBackoff,
+						"max_backoff":             tc.MaxBackoff,
+						"jitter":                  types.Float64Null(),
+						"retryable_status_codes":  statusCodes,
+						"retryable_error_reasons": types.ListNull(types.StringType),
+					},
+				)
+				if d.HasError() {
+					t.Fatalf("unable to build retry block: %v", d)
+				}
+				retry, _ = types.ListValue(types.ObjectType{}.WithAttributeTypes(fwmodels.ProviderRetryAttributes), []attr.Value{r})
+			}
+
+			// Act
+			policy := fwtransport.GetRetryPolicy(ctx, retry, &diags)
+
+			// Assert
+			if diags.HasError() {
+				if !tc.ExpectError {
+					t.Fatalf("did not expect error, but [%d] error(s) occurred", diags.ErrorsCount())
+				}
+				return
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error, but got none")
+			}
+			if tc.ExpectNilPolicy {
+				if policy != nil {
+					t.Fatalf("want no retry policy, but got %#v", policy)
+				}
+				return
+			}
+			if policy == nil {
+				t.Fatalf("want a retry policy, but got nil")
+			}
+			if policy.MaxAttempts != tc.ExpectMaxAttempts {
+				t.Fatalf("want max_attempts to be `%d`, but got the value `%d`", tc.ExpectMaxAttempts, policy.MaxAttempts)
+			}
+			if policy.InitialBackoff != tc.ExpectInitialBackoff {
+				t.Fatalf("want initial_backoff to be `%s`, but got the value `%s`", tc.ExpectInitialBackoff, policy.InitialBackoff)
+			}
+			if policy.MaxBackoff != tc.ExpectMaxBackoff {
+				t.Fatalf("want max_backoff to be `%s`, but got the value `%s`", tc.ExpectMaxBackoff, policy.MaxBackoff)
+			}
+			if len(policy.RetryableStatusCodes) != len(tc.ExpectRetryableStatuses) {
+				t.Fatalf("want retryable_status_codes to be `%v`, but got the value `%v`", tc.ExpectRetryableStatuses, policy.RetryableStatusCodes)
+			}
+		})
+	}
+}
+
+func TestGetRequestTracing(t *testing.T) {
+	cases := map[string]struct {
+		SetAsNull    bool
+		Path         types.String
+		Format       types.String
+		ExpectNil    bool
+		ExpectFormat string
+		ExpectError  bool
+	}{
+		"if request_tracing is unset, no configuration is returned": {
+			SetAsNull: true,
+			ExpectNil: true,
+		},
+		"if format is unset, spans are recorded": {
+			Path:         types.StringValue("/tmp/trace.jsonl"),
+			Format:       types.StringNull(),
+			ExpectFormat: "spans",
+		},
+		"format can be set to summary": {
+			Path:         types.StringValue("/tmp/trace.json"),
+			Format:       types.StringValue("summary"),
+			ExpectFormat: "summary",
+		},
+		"if format is an invalid value, there's an error": {
+			Path:        types.StringValue("/tmp/trace.json"),
+			Format:      types.StringValue("xml"),
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			diags := diag.Diagnostics{}
+
+			tracing := types.ListNull(types.ObjectType{}.WithAttributeTypes(fwmodels.ProviderRequestTracingAttributes))
+			if !tc.SetAsNull {
+				rt, d := types.ObjectValue(
+					fwmodels.ProviderRequestTracingAttributes,
+					map[string]attr.Value{
+						"path":   tc.Path,
+						"format": tc.Format,
+					},
+				)
+				if d.HasError() {
+					t.Fatalf("unable to build request_tracing block: %v", d)
+				}
+				tracing, _ = types.ListValue(types.ObjectType{}.WithAttributeTypes(fwmodels.ProviderRequestTracingAttributes), []attr.Value{rt})
+			}
+
+			// Act
+			cfg := fwtransport.GetRequestTracing(ctx, tracing, &diags)
+
+			// Assert
+			if diags.HasError() {
+				if !tc.ExpectError {
+					t.Fatalf("did not expect error, but [%d] error(s) occurred", diags.ErrorsCount())
+				}
+				return
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error, but got none")
+			}
+			if tc.ExpectNil {
+				if cfg != nil {
+					t.Fatalf("want no request tracing configuration, but got %#v", cfg)
+				}
+				return
+			}
+			if cfg.Path != tc.Path.ValueString() {
+				t.Fatalf("want path to be `%s`, but got the value `%s`", tc.Path.ValueString(), cfg.Path)
+			}
+			if cfg.Format != tc.ExpectFormat {
+				t.Fatalf("want format to be `%s`, but got the value `%s`", tc.ExpectFormat, cfg.Format)
+			}
+		})
+	}
+}
+
+func TestGetRequestCache(t *testing.T) {
+	cases := map[string]struct {
+		SetAsNull   bool
+		TTL         types.String
+		ExpectNil   bool
+		ExpectTTL   time.Duration
+		ExpectError bool
+	}{
+		"if request_cache is unset, no cache is configured": {
+			SetAsNull: true,
+			ExpectNil: true,
+		},
+		"if ttl is unset, the default ttl is used": {
+			TTL:       types.StringNull(),
+			ExpectTTL: transport_tpg.DefaultRequestCacheTTL,
+		},
+		"ttl can be set": {
+			TTL:       types.StringValue("2m"),
+			ExpectTTL: 2 * time.Minute,
+		},
+		"if ttl is an invalid value, there's an error": {
+			TTL:         types.StringValue("invalid value"),
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			diags := diag.Diagnostics{}
+
+			cache := types.ListNull(types.ObjectType{}.WithAttributeTypes(fwmodels.ProviderRequestCacheAttributes))
+			if !tc.SetAsNull {
+				rc, d := types.ObjectValue(
+					fwmodels.ProviderRequestCacheAttributes,
+					map[string]attr.Value{
+						"ttl": tc.TTL,
+					},
+				)
+				if d.HasError() {
+					t.Fatalf("unable to build request_cache block: %v", d)
+				}
+				cache, _ = types.ListValue(types.ObjectType{}.WithAttributeTypes(fwmodels.ProviderRequestCacheAttributes), []attr.Value{rc})
+			}
+
+			// Act
+			cfg := fwtransport.GetRequestCache(ctx, cache, &diags)
+
+			// Assert
+			if diags.HasError() {
+				if !tc.ExpectError {
+					t.Fatalf("did not expect error, but [%d] error(s) occurred", diags.ErrorsCount())
+				}
+				return
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error, but got none")
+			}
+			if tc.ExpectNil {
+				if cfg != nil {
+					t.Fatalf("want no request cache configuration, but got %#v", cfg)
+				}
+				return
+			}
+			if cfg.TTL != tc.ExpectTTL {
+				t.Fatalf("want ttl to be `%s`, but got the value `%s`", tc.ExpectTTL, cfg.TTL)
+			}
+		})
+	}
+}
+
+func TestGetRecording(t *testing.T) {
+	cases := map[string]struct {
+		SetAsNull   bool
+		Path        types.String
+		Mode        types.String
+		ExpectNil   bool
+		ExpectMode  string
+		ExpectError bool
+	}{
+		"if recording is unset, no configuration is returned": {
+			SetAsNull: true,
+			ExpectNil: true,
+		},
+		"if mode is unset, requests are recorded": {
+			Path:       types.StringValue("/tmp/recording"),
+			Mode:       types.StringNull(),
+			ExpectMode: "record",
+		},
+		"mode can be set to replay": {
+			Path:       types.StringValue("/tmp/recording"),
+			Mode:       types.StringValue("replay"),
+			ExpectMode: "replay",
+		},
+		"if mode is an invalid value, there's an error": {
+			Path:        types.StringValue("/tmp/recording"),
+			Mode:        types.StringValue("rewind"),
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			// Arrange
+			ctx := context.Background()
+			diags := diag.Diagnostics{}
+
+			recording := types.ListNull(types.ObjectType{}.WithAttributeTypes(fwmodels.ProviderRecordingAttributes))
+			if !tc.SetAsNull {
+				r, d := types.ObjectValue(
+					fwmodels.ProviderRecordingAttributes,
+					map[string]attr.Value{
+						"path": tc.Path,
+						"mode": tc.Mode,
+					},
+				)
+				if d.HasError() {
+					t.Fatalf("unable to build recording block: %v", d)
+				}
+				recording, _ = types.ListValue(types.ObjectType{}.WithAttributeTypes(fwmodels.ProviderRecordingAttributes), []attr.Value{r})
+			}
+
+			// Act
+			cfg := fwtransport.GetRecording(ctx, recording, &diags)
+
+			// Assert
+			if diags.HasError() {
+				if !tc.ExpectError {
+					t.Fatalf("did not expect error, but [%d] error(s) occurred", diags.ErrorsCount())
+				}
+				return
+			}
+			if tc.ExpectError {
+				t.Fatalf("expected an error, but got none")
+			}
+			if tc.ExpectNil {
+				if cfg != nil {
+					t.Fatalf("want no recording configuration, but got %#v", cfg)
+				}
+				return
+			}
+			if cfg.Path != tc.Path.ValueString() {
+				t.Fatalf("want path to be `%s`, but got the value `%s`", tc.Path.ValueString(), cfg.Path)
+			}
+			if cfg.Mode != tc.ExpectMode {
+				t.Fatalf("want mode to be `%s`, but got the value `%s`", tc.ExpectMode, cfg.Mode)
+			}
+		})
+	}
+}
+
 func TestGetRegionFromRegionSelfLink(t *testing.T) {
 	cases := map[string]struct {
 		Input          basetypes.StringValue
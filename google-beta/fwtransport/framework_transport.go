@@ -4,6 +4,7 @@ package fwtransport
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http"
 	"time"
@@ -14,11 +15,13 @@ import (
 	"google.golang.org/api/googleapi"
 )
 
-func SendFrameworkRequest(p *FrameworkProviderConfig, method, project, rawurl, userAgent string, body map[string]interface{}, errorRetryPredicates ...transport_tpg.RetryErrorPredicateFunc) (map[string]interface{}, diag.Diagnostics) {
-	return SendFrameworkRequestWithTimeout(p, method, project, rawurl, userAgent, body, transport_tpg.DefaultRequestTimeout, errorRetryPredicates...)
+func SendFrameworkRequest(ctx context.Context, p *FrameworkProviderConfig, method, project, rawurl, userAgent string, body map[string]interface{}, errorRetryPredicates ...transport_tpg.RetryErrorPredicateFunc) (map[string]interface{}, diag.Diagnostics) {
+	return SendFrameworkRequestWithTimeout(ctx, p, method, project, rawurl, userAgent, body, transport_tpg.DefaultRequestTimeout, errorRetryPredicates...)
 }
 
-func SendFrameworkRequestWithTimeout(p *FrameworkProviderConfig, method, project, rawurl, userAgent string, body map[string]interface{}, timeout time.Duration, errorRetryPredicates ...transport_tpg.RetryErrorPredicateFunc) (map[string]interface{}, diag.Diagnostics) {
+// SendFrameworkRequestWithTimeout sends a request, cancelling it and any
+// retries once ctx is done, e.g. when the handler's context is cancelled.
+func SendFrameworkRequestWithTimeout(ctx context.Context, p *FrameworkProviderConfig, method, project, rawurl, userAgent string, body map[string]interface{}, timeout time.Duration, errorRetryPredicates ...transport_tpg.RetryErrorPredicateFunc) (map[string]interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	reqHeaders := make(http.Header)
@@ -41,6 +44,12 @@ func SendFrameworkRequestWithTimeout(p *FrameworkProviderConfig, method, project
 		timeout = time.Hour
 	}
 
+	// The retry policy caps attempts here, so the retry transport sends each attempt once
+	reqCtx := ctx
+	if p.RetryPolicy != nil {
+		reqCtx = transport_tpg.WithoutTransportRetries(reqCtx)
+	}
+
 	var res *http.Response
 	err := transport_tpg.Retry(transport_tpg.RetryOptions{
 		RetryFunc: func() error {
@@ -56,7 +65,7 @@ func SendFrameworkRequestWithTimeout(p *FrameworkProviderConfig, method, project
 			if err != nil {
 				return err
 			}
-			req, err := http.NewRequest(method, u, &buf)
+			req, err := http.NewRequestWithContext(reqCtx, method, u, &buf)
 			if err != nil {
 				return err
 			}
@@ -76,6 +85,8 @@ func SendFrameworkRequestWithTimeout(p *FrameworkProviderConfig, method, project
 		},
 		Timeout:              timeout,
 		ErrorRetryPredicates: errorRetryPredicates,
+		Policy:               p.RetryPolicy,
+		Context:              ctx,
 	})
 	if err != nil {
 		diags.AddError("error sending request", err.Error())
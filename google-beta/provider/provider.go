@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
 	"github.com/hashicorp/terraform-provider-google-beta/version"
@@ -113,6 +114,108 @@ func Provider() *schema.Provider {
 				},
 			},
 
+			"retry": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_attempts": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"initial_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"max_backoff": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+						"jitter": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatBetween(0, 1),
+						},
+						"retryable_status_codes": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(400, 599),
+							},
+						},
+						"retryable_error_reasons": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"request_rate_limits": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+
+			"request_tracing": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(transport_tpg.RequestTraceFormats, false),
+						},
+					},
+				},
+			},
+
+			"request_cache": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ttl": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidateNonNegativeDuration(),
+						},
+					},
+				},
+			},
+
+			"recording": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(transport_tpg.RecordingModes, false),
+						},
+					},
+				},
+			},
+
 			"user_project_override": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -134,6 +237,48 @@ func Provider() *schema.Provider {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"default_annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"default_resource_manager_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"label_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required_keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"allowed_values": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"denied_keys": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"max_labels": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(0, 64),
+						},
+					},
+				},
+			},
+
 			"add_terraform_attribution_label": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -991,6 +1136,26 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 		config.DefaultLabels[k] = v.(string)
 	}
 
+	config.DefaultAnnotations = make(map[string]string)
+	defaultAnnotations := d.Get("default_annotations").(map[string]interface{})
+
+	for k, v := range defaultAnnotations {
+		config.DefaultAnnotations[k] = v.(string)
+	}
+
+	config.DefaultResourceManagerTags = make(map[string]string)
+	defaultResourceManagerTags := d.Get("default_resource_manager_tags").(map[string]interface{})
+
+	for k, v := range defaultResourceManagerTags {
+		config.DefaultResourceManagerTags[k] = v.(string)
+	}
+
+	labelPolicy, err := transport_tpg.ExpandProviderLabelPolicy(d.Get("label_policy"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.LabelPolicy = labelPolicy
+
 	// Attribution label is opt-in; if unset, the default for AddTerraformAttributionLabel is false.
 	config.AddTerraformAttributionLabel = d.Get("add_terraform_attribution_label").(bool)
 	if config.AddTerraformAttributionLabel {
@@ -1011,6 +1176,36 @@ func ProviderConfigure(ctx context.Context, d *schema.ResourceData, p *schema.Pr
 	}
 	config.BatchingConfig = batchCfg
 
+	retryPolicy, err := transport_tpg.ExpandProviderRetryPolicy(d.Get("retry"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RetryPolicy = retryPolicy
+
+	rateLimits, err := transport_tpg.ExpandProviderRateLimits(d.Get("request_rate_limits").(map[string]interface{}))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RequestRateLimits = rateLimits
+
+	requestTracing, err := transport_tpg.ExpandProviderRequestTracing(d.Get("request_tracing"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RequestTracing = requestTracing
+
+	requestCache, err := transport_tpg.ExpandProviderRequestCache(d.Get("request_cache"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.RequestCache = requestCache
+
+	recording, err := transport_tpg.ExpandProviderRecording(d.Get("recording"))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	config.Recording = recording
+
 	// Generated products
 	config.AccessApprovalBasePath = d.Get("access_approval_custom_endpoint").(string)
 	config.AccessContextManagerBasePath = d.Get("access_context_manager_custom_endpoint").(string)
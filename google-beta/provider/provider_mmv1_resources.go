@@ -231,6 +231,7 @@ var handwrittenDatasources = map[string]*schema.Resource{
 	"google_container_attached_versions":                  containerattached.DataSourceGoogleContainerAttachedVersions(),
 	"google_container_attached_install_manifest":          containerattached.DataSourceGoogleContainerAttachedInstallManifest(),
 	"google_container_cluster":                            container.DataSourceGoogleContainerCluster(),
+	"google_container_cluster_kubeconfig":                 container.DataSourceGoogleContainerClusterKubeconfig(),
 	"google_container_engine_versions":                    container.DataSourceGoogleContainerEngineVersions(),
 	"google_container_registry_image":                     containeranalysis.DataSourceGoogleContainerImage(),
 	"google_container_registry_repository":                containeranalysis.DataSourceGoogleContainerRepo(),
@@ -260,6 +261,7 @@ var handwrittenDatasources = map[string]*schema.Resource{
 	"google_folder":                                       resourcemanager.DataSourceGoogleFolder(),
 	"google_folders":                                      resourcemanager.DataSourceGoogleFolders(),
 	"google_folder_organization_policy":                   resourcemanager.DataSourceGoogleFolderOrganizationPolicy(),
+	"google_gke_hub_membership_kubeconfig":                gkehub.DataSourceGoogleGkeHubMembershipKubeconfig(),
 	"google_logging_folder_settings":                      logging.DataSourceGoogleLoggingFolderSettings(),
 	"google_logging_organization_settings":                logging.DataSourceGoogleLoggingOrganizationSettings(),
 	"google_logging_project_cmek_settings":                logging.DataSourceGoogleLoggingProjectCmekSettings(),
@@ -421,6 +423,7 @@ var generatedIAMDatasources = map[string]*schema.Resource{
 	"google_endpoints_service_consumers_iam_policy":          tpgiamresource.DataSourceIamPolicy(servicemanagement.ServiceManagementServiceConsumersIamSchema, servicemanagement.ServiceManagementServiceConsumersIamUpdaterProducer),
 	"google_sourcerepo_repository_iam_policy":                tpgiamresource.DataSourceIamPolicy(sourcerepo.SourceRepoRepositoryIamSchema, sourcerepo.SourceRepoRepositoryIamUpdaterProducer),
 	"google_storage_bucket_iam_policy":                       tpgiamresource.DataSourceIamPolicy(storage.StorageBucketIamSchema, storage.StorageBucketIamUpdaterProducer),
+	"google_storage_managed_folder_iam_policy":               tpgiamresource.DataSourceIamPolicy(storage.StorageManagedFolderIamSchema, storage.StorageManagedFolderIamUpdaterProducer),
 	"google_tags_tag_key_iam_policy":                         tpgiamresource.DataSourceIamPolicy(tags.TagsTagKeyIamSchema, tags.TagsTagKeyIamUpdaterProducer),
 	"google_tags_tag_value_iam_policy":                       tpgiamresource.DataSourceIamPolicy(tags.TagsTagValueIamSchema, tags.TagsTagValueIamUpdaterProducer),
 	"google_vertex_ai_endpoint_iam_policy":                   tpgiamresource.DataSourceIamPolicy(vertexai.VertexAIEndpointIamSchema, vertexai.VertexAIEndpointIamUpdaterProducer),
@@ -457,9 +460,9 @@ var handwrittenIAMDatasources = map[string]*schema.Resource{
 }
 
 // Resources
-// Generated resources: 473
-// Generated IAM resources: 279
-// Total generated resources: 752
+// Generated resources: 475
+// Generated IAM resources: 376
+// Total generated resources: 851
 var generatedResources = map[string]*schema.Resource{
 	"google_folder_access_approval_settings":                           accessapproval.ResourceAccessApprovalFolderSettings(),
 	"google_organization_access_approval_settings":                     accessapproval.ResourceAccessApprovalOrganizationSettings(),
@@ -470,6 +473,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_access_context_manager_access_policy":                      accesscontextmanager.ResourceAccessContextManagerAccessPolicy(),
 	"google_access_context_manager_access_policy_iam_binding":          tpgiamresource.ResourceIamBinding(accesscontextmanager.AccessContextManagerAccessPolicyIamSchema, accesscontextmanager.AccessContextManagerAccessPolicyIamUpdaterProducer, accesscontextmanager.AccessContextManagerAccessPolicyIdParseFunc),
 	"google_access_context_manager_access_policy_iam_member":           tpgiamresource.ResourceIamMember(accesscontextmanager.AccessContextManagerAccessPolicyIamSchema, accesscontextmanager.AccessContextManagerAccessPolicyIamUpdaterProducer, accesscontextmanager.AccessContextManagerAccessPolicyIdParseFunc),
+	"google_access_context_manager_access_policy_iam_member_remove":    tpgiamresource.ResourceIamMemberRemove(accesscontextmanager.AccessContextManagerAccessPolicyIamSchema, accesscontextmanager.AccessContextManagerAccessPolicyIamUpdaterProducer),
 	"google_access_context_manager_access_policy_iam_policy":           tpgiamresource.ResourceIamPolicy(accesscontextmanager.AccessContextManagerAccessPolicyIamSchema, accesscontextmanager.AccessContextManagerAccessPolicyIamUpdaterProducer, accesscontextmanager.AccessContextManagerAccessPolicyIdParseFunc),
 	"google_access_context_manager_authorized_orgs_desc":               accesscontextmanager.ResourceAccessContextManagerAuthorizedOrgsDesc(),
 	"google_access_context_manager_egress_policy":                      accesscontextmanager.ResourceAccessContextManagerEgressPolicy(),
@@ -491,14 +495,17 @@ var generatedResources = map[string]*schema.Resource{
 	"google_api_gateway_api":                                           apigateway.ResourceApiGatewayApi(),
 	"google_api_gateway_api_iam_binding":                               tpgiamresource.ResourceIamBinding(apigateway.ApiGatewayApiIamSchema, apigateway.ApiGatewayApiIamUpdaterProducer, apigateway.ApiGatewayApiIdParseFunc),
 	"google_api_gateway_api_iam_member":                                tpgiamresource.ResourceIamMember(apigateway.ApiGatewayApiIamSchema, apigateway.ApiGatewayApiIamUpdaterProducer, apigateway.ApiGatewayApiIdParseFunc),
+	"google_api_gateway_api_iam_member_remove":                         tpgiamresource.ResourceIamMemberRemove(apigateway.ApiGatewayApiIamSchema, apigateway.ApiGatewayApiIamUpdaterProducer),
 	"google_api_gateway_api_iam_policy":                                tpgiamresource.ResourceIamPolicy(apigateway.ApiGatewayApiIamSchema, apigateway.ApiGatewayApiIamUpdaterProducer, apigateway.ApiGatewayApiIdParseFunc),
 	"google_api_gateway_api_config":                                    apigateway.ResourceApiGatewayApiConfig(),
 	"google_api_gateway_api_config_iam_binding":                        tpgiamresource.ResourceIamBinding(apigateway.ApiGatewayApiConfigIamSchema, apigateway.ApiGatewayApiConfigIamUpdaterProducer, apigateway.ApiGatewayApiConfigIdParseFunc),
 	"google_api_gateway_api_config_iam_member":                         tpgiamresource.ResourceIamMember(apigateway.ApiGatewayApiConfigIamSchema, apigateway.ApiGatewayApiConfigIamUpdaterProducer, apigateway.ApiGatewayApiConfigIdParseFunc),
+	"google_api_gateway_api_config_iam_member_remove":                  tpgiamresource.ResourceIamMemberRemove(apigateway.ApiGatewayApiConfigIamSchema, apigateway.ApiGatewayApiConfigIamUpdaterProducer),
 	"google_api_gateway_api_config_iam_policy":                         tpgiamresource.ResourceIamPolicy(apigateway.ApiGatewayApiConfigIamSchema, apigateway.ApiGatewayApiConfigIamUpdaterProducer, apigateway.ApiGatewayApiConfigIdParseFunc),
 	"google_api_gateway_gateway":                                       apigateway.ResourceApiGatewayGateway(),
 	"google_api_gateway_gateway_iam_binding":                           tpgiamresource.ResourceIamBinding(apigateway.ApiGatewayGatewayIamSchema, apigateway.ApiGatewayGatewayIamUpdaterProducer, apigateway.ApiGatewayGatewayIdParseFunc),
 	"google_api_gateway_gateway_iam_member":                            tpgiamresource.ResourceIamMember(apigateway.ApiGatewayGatewayIamSchema, apigateway.ApiGatewayGatewayIamUpdaterProducer, apigateway.ApiGatewayGatewayIdParseFunc),
+	"google_api_gateway_gateway_iam_member_remove":                     tpgiamresource.ResourceIamMemberRemove(apigateway.ApiGatewayGatewayIamSchema, apigateway.ApiGatewayGatewayIamUpdaterProducer),
 	"google_api_gateway_gateway_iam_policy":                            tpgiamresource.ResourceIamPolicy(apigateway.ApiGatewayGatewayIamSchema, apigateway.ApiGatewayGatewayIamUpdaterProducer, apigateway.ApiGatewayGatewayIdParseFunc),
 	"google_apigee_addons_config":                                      apigee.ResourceApigeeAddonsConfig(),
 	"google_apigee_endpoint_attachment":                                apigee.ResourceApigeeEndpointAttachment(),
@@ -509,6 +516,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_apigee_environment":                                        apigee.ResourceApigeeEnvironment(),
 	"google_apigee_environment_iam_binding":                            tpgiamresource.ResourceIamBinding(apigee.ApigeeEnvironmentIamSchema, apigee.ApigeeEnvironmentIamUpdaterProducer, apigee.ApigeeEnvironmentIdParseFunc),
 	"google_apigee_environment_iam_member":                             tpgiamresource.ResourceIamMember(apigee.ApigeeEnvironmentIamSchema, apigee.ApigeeEnvironmentIamUpdaterProducer, apigee.ApigeeEnvironmentIdParseFunc),
+	"google_apigee_environment_iam_member_remove":                      tpgiamresource.ResourceIamMemberRemove(apigee.ApigeeEnvironmentIamSchema, apigee.ApigeeEnvironmentIamUpdaterProducer),
 	"google_apigee_environment_iam_policy":                             tpgiamresource.ResourceIamPolicy(apigee.ApigeeEnvironmentIamSchema, apigee.ApigeeEnvironmentIamUpdaterProducer, apigee.ApigeeEnvironmentIdParseFunc),
 	"google_apigee_instance":                                           apigee.ResourceApigeeInstance(),
 	"google_apigee_instance_attachment":                                apigee.ResourceApigeeInstanceAttachment(),
@@ -531,6 +539,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_artifact_registry_repository":                              artifactregistry.ResourceArtifactRegistryRepository(),
 	"google_artifact_registry_repository_iam_binding":                  tpgiamresource.ResourceIamBinding(artifactregistry.ArtifactRegistryRepositoryIamSchema, artifactregistry.ArtifactRegistryRepositoryIamUpdaterProducer, artifactregistry.ArtifactRegistryRepositoryIdParseFunc),
 	"google_artifact_registry_repository_iam_member":                   tpgiamresource.ResourceIamMember(artifactregistry.ArtifactRegistryRepositoryIamSchema, artifactregistry.ArtifactRegistryRepositoryIamUpdaterProducer, artifactregistry.ArtifactRegistryRepositoryIdParseFunc),
+	"google_artifact_registry_repository_iam_member_remove":            tpgiamresource.ResourceIamMemberRemove(artifactregistry.ArtifactRegistryRepositoryIamSchema, artifactregistry.ArtifactRegistryRepositoryIamUpdaterProducer),
 	"google_artifact_registry_repository_iam_policy":                   tpgiamresource.ResourceIamPolicy(artifactregistry.ArtifactRegistryRepositoryIamSchema, artifactregistry.ArtifactRegistryRepositoryIamUpdaterProducer, artifactregistry.ArtifactRegistryRepositoryIdParseFunc),
 	"google_artifact_registry_vpcsc_config":                            artifactregistry.ResourceArtifactRegistryVPCSCConfig(),
 	"google_backup_dr_management_server":                               backupdr.ResourceBackupDRManagementServer(),
@@ -546,22 +555,27 @@ var generatedResources = map[string]*schema.Resource{
 	"google_bigquery_routine":                                          bigquery.ResourceBigQueryRoutine(),
 	"google_bigquery_table_iam_binding":                                tpgiamresource.ResourceIamBinding(bigquery.BigQueryTableIamSchema, bigquery.BigQueryTableIamUpdaterProducer, bigquery.BigQueryTableIdParseFunc),
 	"google_bigquery_table_iam_member":                                 tpgiamresource.ResourceIamMember(bigquery.BigQueryTableIamSchema, bigquery.BigQueryTableIamUpdaterProducer, bigquery.BigQueryTableIdParseFunc),
+	"google_bigquery_table_iam_member_remove":                          tpgiamresource.ResourceIamMemberRemove(bigquery.BigQueryTableIamSchema, bigquery.BigQueryTableIamUpdaterProducer),
 	"google_bigquery_table_iam_policy":                                 tpgiamresource.ResourceIamPolicy(bigquery.BigQueryTableIamSchema, bigquery.BigQueryTableIamUpdaterProducer, bigquery.BigQueryTableIdParseFunc),
 	"google_bigquery_analytics_hub_data_exchange":                      bigqueryanalyticshub.ResourceBigqueryAnalyticsHubDataExchange(),
 	"google_bigquery_analytics_hub_data_exchange_iam_binding":          tpgiamresource.ResourceIamBinding(bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIamSchema, bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIamUpdaterProducer, bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIdParseFunc),
 	"google_bigquery_analytics_hub_data_exchange_iam_member":           tpgiamresource.ResourceIamMember(bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIamSchema, bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIamUpdaterProducer, bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIdParseFunc),
+	"google_bigquery_analytics_hub_data_exchange_iam_member_remove":    tpgiamresource.ResourceIamMemberRemove(bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIamSchema, bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIamUpdaterProducer),
 	"google_bigquery_analytics_hub_data_exchange_iam_policy":           tpgiamresource.ResourceIamPolicy(bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIamSchema, bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIamUpdaterProducer, bigqueryanalyticshub.BigqueryAnalyticsHubDataExchangeIdParseFunc),
 	"google_bigquery_analytics_hub_listing":                            bigqueryanalyticshub.ResourceBigqueryAnalyticsHubListing(),
 	"google_bigquery_analytics_hub_listing_iam_binding":                tpgiamresource.ResourceIamBinding(bigqueryanalyticshub.BigqueryAnalyticsHubListingIamSchema, bigqueryanalyticshub.BigqueryAnalyticsHubListingIamUpdaterProducer, bigqueryanalyticshub.BigqueryAnalyticsHubListingIdParseFunc),
 	"google_bigquery_analytics_hub_listing_iam_member":                 tpgiamresource.ResourceIamMember(bigqueryanalyticshub.BigqueryAnalyticsHubListingIamSchema, bigqueryanalyticshub.BigqueryAnalyticsHubListingIamUpdaterProducer, bigqueryanalyticshub.BigqueryAnalyticsHubListingIdParseFunc),
+	"google_bigquery_analytics_hub_listing_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(bigqueryanalyticshub.BigqueryAnalyticsHubListingIamSchema, bigqueryanalyticshub.BigqueryAnalyticsHubListingIamUpdaterProducer),
 	"google_bigquery_analytics_hub_listing_iam_policy":                 tpgiamresource.ResourceIamPolicy(bigqueryanalyticshub.BigqueryAnalyticsHubListingIamSchema, bigqueryanalyticshub.BigqueryAnalyticsHubListingIamUpdaterProducer, bigqueryanalyticshub.BigqueryAnalyticsHubListingIdParseFunc),
 	"google_bigquery_connection":                                       bigqueryconnection.ResourceBigqueryConnectionConnection(),
 	"google_bigquery_connection_iam_binding":                           tpgiamresource.ResourceIamBinding(bigqueryconnection.BigqueryConnectionConnectionIamSchema, bigqueryconnection.BigqueryConnectionConnectionIamUpdaterProducer, bigqueryconnection.BigqueryConnectionConnectionIdParseFunc),
 	"google_bigquery_connection_iam_member":                            tpgiamresource.ResourceIamMember(bigqueryconnection.BigqueryConnectionConnectionIamSchema, bigqueryconnection.BigqueryConnectionConnectionIamUpdaterProducer, bigqueryconnection.BigqueryConnectionConnectionIdParseFunc),
+	"google_bigquery_connection_iam_member_remove":                     tpgiamresource.ResourceIamMemberRemove(bigqueryconnection.BigqueryConnectionConnectionIamSchema, bigqueryconnection.BigqueryConnectionConnectionIamUpdaterProducer),
 	"google_bigquery_connection_iam_policy":                            tpgiamresource.ResourceIamPolicy(bigqueryconnection.BigqueryConnectionConnectionIamSchema, bigqueryconnection.BigqueryConnectionConnectionIamUpdaterProducer, bigqueryconnection.BigqueryConnectionConnectionIdParseFunc),
 	"google_bigquery_datapolicy_data_policy":                           bigquerydatapolicy.ResourceBigqueryDatapolicyDataPolicy(),
 	"google_bigquery_datapolicy_data_policy_iam_binding":               tpgiamresource.ResourceIamBinding(bigquerydatapolicy.BigqueryDatapolicyDataPolicyIamSchema, bigquerydatapolicy.BigqueryDatapolicyDataPolicyIamUpdaterProducer, bigquerydatapolicy.BigqueryDatapolicyDataPolicyIdParseFunc),
 	"google_bigquery_datapolicy_data_policy_iam_member":                tpgiamresource.ResourceIamMember(bigquerydatapolicy.BigqueryDatapolicyDataPolicyIamSchema, bigquerydatapolicy.BigqueryDatapolicyDataPolicyIamUpdaterProducer, bigquerydatapolicy.BigqueryDatapolicyDataPolicyIdParseFunc),
+	"google_bigquery_datapolicy_data_policy_iam_member_remove":         tpgiamresource.ResourceIamMemberRemove(bigquerydatapolicy.BigqueryDatapolicyDataPolicyIamSchema, bigquerydatapolicy.BigqueryDatapolicyDataPolicyIamUpdaterProducer),
 	"google_bigquery_datapolicy_data_policy_iam_policy":                tpgiamresource.ResourceIamPolicy(bigquerydatapolicy.BigqueryDatapolicyDataPolicyIamSchema, bigquerydatapolicy.BigqueryDatapolicyDataPolicyIamUpdaterProducer, bigquerydatapolicy.BigqueryDatapolicyDataPolicyIdParseFunc),
 	"google_bigquery_data_transfer_config":                             bigquerydatatransfer.ResourceBigqueryDataTransferConfig(),
 	"google_bigquery_bi_reservation":                                   bigqueryreservation.ResourceBigqueryReservationBiReservation(),
@@ -572,6 +586,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_binary_authorization_attestor":                             binaryauthorization.ResourceBinaryAuthorizationAttestor(),
 	"google_binary_authorization_attestor_iam_binding":                 tpgiamresource.ResourceIamBinding(binaryauthorization.BinaryAuthorizationAttestorIamSchema, binaryauthorization.BinaryAuthorizationAttestorIamUpdaterProducer, binaryauthorization.BinaryAuthorizationAttestorIdParseFunc),
 	"google_binary_authorization_attestor_iam_member":                  tpgiamresource.ResourceIamMember(binaryauthorization.BinaryAuthorizationAttestorIamSchema, binaryauthorization.BinaryAuthorizationAttestorIamUpdaterProducer, binaryauthorization.BinaryAuthorizationAttestorIdParseFunc),
+	"google_binary_authorization_attestor_iam_member_remove":           tpgiamresource.ResourceIamMemberRemove(binaryauthorization.BinaryAuthorizationAttestorIamSchema, binaryauthorization.BinaryAuthorizationAttestorIamUpdaterProducer),
 	"google_binary_authorization_attestor_iam_policy":                  tpgiamresource.ResourceIamPolicy(binaryauthorization.BinaryAuthorizationAttestorIamSchema, binaryauthorization.BinaryAuthorizationAttestorIamUpdaterProducer, binaryauthorization.BinaryAuthorizationAttestorIdParseFunc),
 	"google_binary_authorization_policy":                               binaryauthorization.ResourceBinaryAuthorizationPolicy(),
 	"google_blockchain_node_engine_blockchain_nodes":                   blockchainnodeengine.ResourceBlockchainNodeEngineBlockchainNodes(),
@@ -589,26 +604,32 @@ var generatedResources = map[string]*schema.Resource{
 	"google_cloudbuildv2_connection":                                   cloudbuildv2.ResourceCloudbuildv2Connection(),
 	"google_cloudbuildv2_connection_iam_binding":                       tpgiamresource.ResourceIamBinding(cloudbuildv2.Cloudbuildv2ConnectionIamSchema, cloudbuildv2.Cloudbuildv2ConnectionIamUpdaterProducer, cloudbuildv2.Cloudbuildv2ConnectionIdParseFunc),
 	"google_cloudbuildv2_connection_iam_member":                        tpgiamresource.ResourceIamMember(cloudbuildv2.Cloudbuildv2ConnectionIamSchema, cloudbuildv2.Cloudbuildv2ConnectionIamUpdaterProducer, cloudbuildv2.Cloudbuildv2ConnectionIdParseFunc),
+	"google_cloudbuildv2_connection_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(cloudbuildv2.Cloudbuildv2ConnectionIamSchema, cloudbuildv2.Cloudbuildv2ConnectionIamUpdaterProducer),
 	"google_cloudbuildv2_connection_iam_policy":                        tpgiamresource.ResourceIamPolicy(cloudbuildv2.Cloudbuildv2ConnectionIamSchema, cloudbuildv2.Cloudbuildv2ConnectionIamUpdaterProducer, cloudbuildv2.Cloudbuildv2ConnectionIdParseFunc),
 	"google_cloudbuildv2_repository":                                   cloudbuildv2.ResourceCloudbuildv2Repository(),
 	"google_clouddeploy_automation":                                    clouddeploy.ResourceClouddeployAutomation(),
 	"google_clouddeploy_custom_target_type":                            clouddeploy.ResourceClouddeployCustomTargetType(),
 	"google_clouddeploy_custom_target_type_iam_binding":                tpgiamresource.ResourceIamBinding(clouddeploy.ClouddeployCustomTargetTypeIamSchema, clouddeploy.ClouddeployCustomTargetTypeIamUpdaterProducer, clouddeploy.ClouddeployCustomTargetTypeIdParseFunc),
 	"google_clouddeploy_custom_target_type_iam_member":                 tpgiamresource.ResourceIamMember(clouddeploy.ClouddeployCustomTargetTypeIamSchema, clouddeploy.ClouddeployCustomTargetTypeIamUpdaterProducer, clouddeploy.ClouddeployCustomTargetTypeIdParseFunc),
+	"google_clouddeploy_custom_target_type_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(clouddeploy.ClouddeployCustomTargetTypeIamSchema, clouddeploy.ClouddeployCustomTargetTypeIamUpdaterProducer),
 	"google_clouddeploy_custom_target_type_iam_policy":                 tpgiamresource.ResourceIamPolicy(clouddeploy.ClouddeployCustomTargetTypeIamSchema, clouddeploy.ClouddeployCustomTargetTypeIamUpdaterProducer, clouddeploy.ClouddeployCustomTargetTypeIdParseFunc),
 	"google_clouddeploy_delivery_pipeline_iam_binding":                 tpgiamresource.ResourceIamBinding(clouddeploy.ClouddeployDeliveryPipelineIamSchema, clouddeploy.ClouddeployDeliveryPipelineIamUpdaterProducer, clouddeploy.ClouddeployDeliveryPipelineIdParseFunc),
 	"google_clouddeploy_delivery_pipeline_iam_member":                  tpgiamresource.ResourceIamMember(clouddeploy.ClouddeployDeliveryPipelineIamSchema, clouddeploy.ClouddeployDeliveryPipelineIamUpdaterProducer, clouddeploy.ClouddeployDeliveryPipelineIdParseFunc),
+	"google_clouddeploy_delivery_pipeline_iam_member_remove":           tpgiamresource.ResourceIamMemberRemove(clouddeploy.ClouddeployDeliveryPipelineIamSchema, clouddeploy.ClouddeployDeliveryPipelineIamUpdaterProducer),
 	"google_clouddeploy_delivery_pipeline_iam_policy":                  tpgiamresource.ResourceIamPolicy(clouddeploy.ClouddeployDeliveryPipelineIamSchema, clouddeploy.ClouddeployDeliveryPipelineIamUpdaterProducer, clouddeploy.ClouddeployDeliveryPipelineIdParseFunc),
 	"google_clouddeploy_target_iam_binding":                            tpgiamresource.ResourceIamBinding(clouddeploy.ClouddeployTargetIamSchema, clouddeploy.ClouddeployTargetIamUpdaterProducer, clouddeploy.ClouddeployTargetIdParseFunc),
 	"google_clouddeploy_target_iam_member":                             tpgiamresource.ResourceIamMember(clouddeploy.ClouddeployTargetIamSchema, clouddeploy.ClouddeployTargetIamUpdaterProducer, clouddeploy.ClouddeployTargetIdParseFunc),
+	"google_clouddeploy_target_iam_member_remove":                      tpgiamresource.ResourceIamMemberRemove(clouddeploy.ClouddeployTargetIamSchema, clouddeploy.ClouddeployTargetIamUpdaterProducer),
 	"google_clouddeploy_target_iam_policy":                             tpgiamresource.ResourceIamPolicy(clouddeploy.ClouddeployTargetIamSchema, clouddeploy.ClouddeployTargetIamUpdaterProducer, clouddeploy.ClouddeployTargetIdParseFunc),
 	"google_clouddomains_registration":                                 clouddomains.ResourceClouddomainsRegistration(),
 	"google_cloudfunctions_function_iam_binding":                       tpgiamresource.ResourceIamBinding(cloudfunctions.CloudFunctionsCloudFunctionIamSchema, cloudfunctions.CloudFunctionsCloudFunctionIamUpdaterProducer, cloudfunctions.CloudFunctionsCloudFunctionIdParseFunc),
 	"google_cloudfunctions_function_iam_member":                        tpgiamresource.ResourceIamMember(cloudfunctions.CloudFunctionsCloudFunctionIamSchema, cloudfunctions.CloudFunctionsCloudFunctionIamUpdaterProducer, cloudfunctions.CloudFunctionsCloudFunctionIdParseFunc),
+	"google_cloudfunctions_function_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(cloudfunctions.CloudFunctionsCloudFunctionIamSchema, cloudfunctions.CloudFunctionsCloudFunctionIamUpdaterProducer),
 	"google_cloudfunctions_function_iam_policy":                        tpgiamresource.ResourceIamPolicy(cloudfunctions.CloudFunctionsCloudFunctionIamSchema, cloudfunctions.CloudFunctionsCloudFunctionIamUpdaterProducer, cloudfunctions.CloudFunctionsCloudFunctionIdParseFunc),
 	"google_cloudfunctions2_function":                                  cloudfunctions2.ResourceCloudfunctions2function(),
 	"google_cloudfunctions2_function_iam_binding":                      tpgiamresource.ResourceIamBinding(cloudfunctions2.Cloudfunctions2functionIamSchema, cloudfunctions2.Cloudfunctions2functionIamUpdaterProducer, cloudfunctions2.Cloudfunctions2functionIdParseFunc),
 	"google_cloudfunctions2_function_iam_member":                       tpgiamresource.ResourceIamMember(cloudfunctions2.Cloudfunctions2functionIamSchema, cloudfunctions2.Cloudfunctions2functionIamUpdaterProducer, cloudfunctions2.Cloudfunctions2functionIdParseFunc),
+	"google_cloudfunctions2_function_iam_member_remove":                tpgiamresource.ResourceIamMemberRemove(cloudfunctions2.Cloudfunctions2functionIamSchema, cloudfunctions2.Cloudfunctions2functionIamUpdaterProducer),
 	"google_cloudfunctions2_function_iam_policy":                       tpgiamresource.ResourceIamPolicy(cloudfunctions2.Cloudfunctions2functionIamSchema, cloudfunctions2.Cloudfunctions2functionIamUpdaterProducer, cloudfunctions2.Cloudfunctions2functionIdParseFunc),
 	"google_cloud_identity_group":                                      cloudidentity.ResourceCloudIdentityGroup(),
 	"google_cloud_identity_group_membership":                           cloudidentity.ResourceCloudIdentityGroupMembership(),
@@ -618,35 +639,42 @@ var generatedResources = map[string]*schema.Resource{
 	"google_cloud_run_service":                                         cloudrun.ResourceCloudRunService(),
 	"google_cloud_run_service_iam_binding":                             tpgiamresource.ResourceIamBinding(cloudrun.CloudRunServiceIamSchema, cloudrun.CloudRunServiceIamUpdaterProducer, cloudrun.CloudRunServiceIdParseFunc),
 	"google_cloud_run_service_iam_member":                              tpgiamresource.ResourceIamMember(cloudrun.CloudRunServiceIamSchema, cloudrun.CloudRunServiceIamUpdaterProducer, cloudrun.CloudRunServiceIdParseFunc),
+	"google_cloud_run_service_iam_member_remove":                       tpgiamresource.ResourceIamMemberRemove(cloudrun.CloudRunServiceIamSchema, cloudrun.CloudRunServiceIamUpdaterProducer),
 	"google_cloud_run_service_iam_policy":                              tpgiamresource.ResourceIamPolicy(cloudrun.CloudRunServiceIamSchema, cloudrun.CloudRunServiceIamUpdaterProducer, cloudrun.CloudRunServiceIdParseFunc),
 	"google_cloud_run_v2_job":                                          cloudrunv2.ResourceCloudRunV2Job(),
 	"google_cloud_run_v2_job_iam_binding":                              tpgiamresource.ResourceIamBinding(cloudrunv2.CloudRunV2JobIamSchema, cloudrunv2.CloudRunV2JobIamUpdaterProducer, cloudrunv2.CloudRunV2JobIdParseFunc),
 	"google_cloud_run_v2_job_iam_member":                               tpgiamresource.ResourceIamMember(cloudrunv2.CloudRunV2JobIamSchema, cloudrunv2.CloudRunV2JobIamUpdaterProducer, cloudrunv2.CloudRunV2JobIdParseFunc),
+	"google_cloud_run_v2_job_iam_member_remove":                        tpgiamresource.ResourceIamMemberRemove(cloudrunv2.CloudRunV2JobIamSchema, cloudrunv2.CloudRunV2JobIamUpdaterProducer),
 	"google_cloud_run_v2_job_iam_policy":                               tpgiamresource.ResourceIamPolicy(cloudrunv2.CloudRunV2JobIamSchema, cloudrunv2.CloudRunV2JobIamUpdaterProducer, cloudrunv2.CloudRunV2JobIdParseFunc),
 	"google_cloud_run_v2_service":                                      cloudrunv2.ResourceCloudRunV2Service(),
 	"google_cloud_run_v2_service_iam_binding":                          tpgiamresource.ResourceIamBinding(cloudrunv2.CloudRunV2ServiceIamSchema, cloudrunv2.CloudRunV2ServiceIamUpdaterProducer, cloudrunv2.CloudRunV2ServiceIdParseFunc),
 	"google_cloud_run_v2_service_iam_member":                           tpgiamresource.ResourceIamMember(cloudrunv2.CloudRunV2ServiceIamSchema, cloudrunv2.CloudRunV2ServiceIamUpdaterProducer, cloudrunv2.CloudRunV2ServiceIdParseFunc),
+	"google_cloud_run_v2_service_iam_member_remove":                    tpgiamresource.ResourceIamMemberRemove(cloudrunv2.CloudRunV2ServiceIamSchema, cloudrunv2.CloudRunV2ServiceIamUpdaterProducer),
 	"google_cloud_run_v2_service_iam_policy":                           tpgiamresource.ResourceIamPolicy(cloudrunv2.CloudRunV2ServiceIamSchema, cloudrunv2.CloudRunV2ServiceIamUpdaterProducer, cloudrunv2.CloudRunV2ServiceIdParseFunc),
 	"google_cloud_scheduler_job":                                       cloudscheduler.ResourceCloudSchedulerJob(),
 	"google_cloud_tasks_queue":                                         cloudtasks.ResourceCloudTasksQueue(),
 	"google_cloud_tasks_queue_iam_binding":                             tpgiamresource.ResourceIamBinding(cloudtasks.CloudTasksQueueIamSchema, cloudtasks.CloudTasksQueueIamUpdaterProducer, cloudtasks.CloudTasksQueueIdParseFunc),
 	"google_cloud_tasks_queue_iam_member":                              tpgiamresource.ResourceIamMember(cloudtasks.CloudTasksQueueIamSchema, cloudtasks.CloudTasksQueueIamUpdaterProducer, cloudtasks.CloudTasksQueueIdParseFunc),
+	"google_cloud_tasks_queue_iam_member_remove":                       tpgiamresource.ResourceIamMemberRemove(cloudtasks.CloudTasksQueueIamSchema, cloudtasks.CloudTasksQueueIamUpdaterProducer),
 	"google_cloud_tasks_queue_iam_policy":                              tpgiamresource.ResourceIamPolicy(cloudtasks.CloudTasksQueueIamSchema, cloudtasks.CloudTasksQueueIamUpdaterProducer, cloudtasks.CloudTasksQueueIdParseFunc),
 	"google_compute_address":                                           compute.ResourceComputeAddress(),
 	"google_compute_autoscaler":                                        compute.ResourceComputeAutoscaler(),
 	"google_compute_backend_bucket":                                    compute.ResourceComputeBackendBucket(),
 	"google_compute_backend_bucket_iam_binding":                        tpgiamresource.ResourceIamBinding(compute.ComputeBackendBucketIamSchema, compute.ComputeBackendBucketIamUpdaterProducer, compute.ComputeBackendBucketIdParseFunc),
 	"google_compute_backend_bucket_iam_member":                         tpgiamresource.ResourceIamMember(compute.ComputeBackendBucketIamSchema, compute.ComputeBackendBucketIamUpdaterProducer, compute.ComputeBackendBucketIdParseFunc),
+	"google_compute_backend_bucket_iam_member_remove":                  tpgiamresource.ResourceIamMemberRemove(compute.ComputeBackendBucketIamSchema, compute.ComputeBackendBucketIamUpdaterProducer),
 	"google_compute_backend_bucket_iam_policy":                         tpgiamresource.ResourceIamPolicy(compute.ComputeBackendBucketIamSchema, compute.ComputeBackendBucketIamUpdaterProducer, compute.ComputeBackendBucketIdParseFunc),
 	"google_compute_backend_bucket_signed_url_key":                     compute.ResourceComputeBackendBucketSignedUrlKey(),
 	"google_compute_backend_service":                                   compute.ResourceComputeBackendService(),
 	"google_compute_backend_service_iam_binding":                       tpgiamresource.ResourceIamBinding(compute.ComputeBackendServiceIamSchema, compute.ComputeBackendServiceIamUpdaterProducer, compute.ComputeBackendServiceIdParseFunc),
 	"google_compute_backend_service_iam_member":                        tpgiamresource.ResourceIamMember(compute.ComputeBackendServiceIamSchema, compute.ComputeBackendServiceIamUpdaterProducer, compute.ComputeBackendServiceIdParseFunc),
+	"google_compute_backend_service_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(compute.ComputeBackendServiceIamSchema, compute.ComputeBackendServiceIamUpdaterProducer),
 	"google_compute_backend_service_iam_policy":                        tpgiamresource.ResourceIamPolicy(compute.ComputeBackendServiceIamSchema, compute.ComputeBackendServiceIamUpdaterProducer, compute.ComputeBackendServiceIdParseFunc),
 	"google_compute_backend_service_signed_url_key":                    compute.ResourceComputeBackendServiceSignedUrlKey(),
 	"google_compute_disk":                                              compute.ResourceComputeDisk(),
 	"google_compute_disk_iam_binding":                                  tpgiamresource.ResourceIamBinding(compute.ComputeDiskIamSchema, compute.ComputeDiskIamUpdaterProducer, compute.ComputeDiskIdParseFunc),
 	"google_compute_disk_iam_member":                                   tpgiamresource.ResourceIamMember(compute.ComputeDiskIamSchema, compute.ComputeDiskIamUpdaterProducer, compute.ComputeDiskIdParseFunc),
+	"google_compute_disk_iam_member_remove":                            tpgiamresource.ResourceIamMemberRemove(compute.ComputeDiskIamSchema, compute.ComputeDiskIamUpdaterProducer),
 	"google_compute_disk_iam_policy":                                   tpgiamresource.ResourceIamPolicy(compute.ComputeDiskIamSchema, compute.ComputeDiskIamUpdaterProducer, compute.ComputeDiskIdParseFunc),
 	"google_compute_disk_resource_policy_attachment":                   compute.ResourceComputeDiskResourcePolicyAttachment(),
 	"google_compute_external_vpn_gateway":                              compute.ResourceComputeExternalVpnGateway(),
@@ -663,9 +691,11 @@ var generatedResources = map[string]*schema.Resource{
 	"google_compute_image":                                             compute.ResourceComputeImage(),
 	"google_compute_image_iam_binding":                                 tpgiamresource.ResourceIamBinding(compute.ComputeImageIamSchema, compute.ComputeImageIamUpdaterProducer, compute.ComputeImageIdParseFunc),
 	"google_compute_image_iam_member":                                  tpgiamresource.ResourceIamMember(compute.ComputeImageIamSchema, compute.ComputeImageIamUpdaterProducer, compute.ComputeImageIdParseFunc),
+	"google_compute_image_iam_member_remove":                           tpgiamresource.ResourceIamMemberRemove(compute.ComputeImageIamSchema, compute.ComputeImageIamUpdaterProducer),
 	"google_compute_image_iam_policy":                                  tpgiamresource.ResourceIamPolicy(compute.ComputeImageIamSchema, compute.ComputeImageIamUpdaterProducer, compute.ComputeImageIdParseFunc),
 	"google_compute_instance_iam_binding":                              tpgiamresource.ResourceIamBinding(compute.ComputeInstanceIamSchema, compute.ComputeInstanceIamUpdaterProducer, compute.ComputeInstanceIdParseFunc),
 	"google_compute_instance_iam_member":                               tpgiamresource.ResourceIamMember(compute.ComputeInstanceIamSchema, compute.ComputeInstanceIamUpdaterProducer, compute.ComputeInstanceIdParseFunc),
+	"google_compute_instance_iam_member_remove":                        tpgiamresource.ResourceIamMemberRemove(compute.ComputeInstanceIamSchema, compute.ComputeInstanceIamUpdaterProducer),
 	"google_compute_instance_iam_policy":                               tpgiamresource.ResourceIamPolicy(compute.ComputeInstanceIamSchema, compute.ComputeInstanceIamUpdaterProducer, compute.ComputeInstanceIdParseFunc),
 	"google_compute_instance_group_membership":                         compute.ResourceComputeInstanceGroupMembership(),
 	"google_compute_instance_group_named_port":                         compute.ResourceComputeInstanceGroupNamedPort(),
@@ -675,6 +705,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_compute_machine_image":                                     compute.ResourceComputeMachineImage(),
 	"google_compute_machine_image_iam_binding":                         tpgiamresource.ResourceIamBinding(compute.ComputeMachineImageIamSchema, compute.ComputeMachineImageIamUpdaterProducer, compute.ComputeMachineImageIdParseFunc),
 	"google_compute_machine_image_iam_member":                          tpgiamresource.ResourceIamMember(compute.ComputeMachineImageIamSchema, compute.ComputeMachineImageIamUpdaterProducer, compute.ComputeMachineImageIdParseFunc),
+	"google_compute_machine_image_iam_member_remove":                   tpgiamresource.ResourceIamMemberRemove(compute.ComputeMachineImageIamSchema, compute.ComputeMachineImageIamUpdaterProducer),
 	"google_compute_machine_image_iam_policy":                          tpgiamresource.ResourceIamPolicy(compute.ComputeMachineImageIamSchema, compute.ComputeMachineImageIamUpdaterProducer, compute.ComputeMachineImageIdParseFunc),
 	"google_compute_managed_ssl_certificate":                           compute.ResourceComputeManagedSslCertificate(),
 	"google_compute_network":                                           compute.ResourceComputeNetwork(),
@@ -698,11 +729,13 @@ var generatedResources = map[string]*schema.Resource{
 	"google_compute_region_backend_service":                            compute.ResourceComputeRegionBackendService(),
 	"google_compute_region_backend_service_iam_binding":                tpgiamresource.ResourceIamBinding(compute.ComputeRegionBackendServiceIamSchema, compute.ComputeRegionBackendServiceIamUpdaterProducer, compute.ComputeRegionBackendServiceIdParseFunc),
 	"google_compute_region_backend_service_iam_member":                 tpgiamresource.ResourceIamMember(compute.ComputeRegionBackendServiceIamSchema, compute.ComputeRegionBackendServiceIamUpdaterProducer, compute.ComputeRegionBackendServiceIdParseFunc),
+	"google_compute_region_backend_service_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(compute.ComputeRegionBackendServiceIamSchema, compute.ComputeRegionBackendServiceIamUpdaterProducer),
 	"google_compute_region_backend_service_iam_policy":                 tpgiamresource.ResourceIamPolicy(compute.ComputeRegionBackendServiceIamSchema, compute.ComputeRegionBackendServiceIamUpdaterProducer, compute.ComputeRegionBackendServiceIdParseFunc),
 	"google_compute_region_commitment":                                 compute.ResourceComputeRegionCommitment(),
 	"google_compute_region_disk":                                       compute.ResourceComputeRegionDisk(),
 	"google_compute_region_disk_iam_binding":                           tpgiamresource.ResourceIamBinding(compute.ComputeRegionDiskIamSchema, compute.ComputeRegionDiskIamUpdaterProducer, compute.ComputeRegionDiskIdParseFunc),
 	"google_compute_region_disk_iam_member":                            tpgiamresource.ResourceIamMember(compute.ComputeRegionDiskIamSchema, compute.ComputeRegionDiskIamUpdaterProducer, compute.ComputeRegionDiskIdParseFunc),
+	"google_compute_region_disk_iam_member_remove":                     tpgiamresource.ResourceIamMemberRemove(compute.ComputeRegionDiskIamSchema, compute.ComputeRegionDiskIamUpdaterProducer),
 	"google_compute_region_disk_iam_policy":                            tpgiamresource.ResourceIamPolicy(compute.ComputeRegionDiskIamSchema, compute.ComputeRegionDiskIamUpdaterProducer, compute.ComputeRegionDiskIdParseFunc),
 	"google_compute_region_disk_resource_policy_attachment":            compute.ResourceComputeRegionDiskResourcePolicyAttachment(),
 	"google_compute_region_health_check":                               compute.ResourceComputeRegionHealthCheck(),
@@ -728,12 +761,14 @@ var generatedResources = map[string]*schema.Resource{
 	"google_compute_snapshot":                                          compute.ResourceComputeSnapshot(),
 	"google_compute_snapshot_iam_binding":                              tpgiamresource.ResourceIamBinding(compute.ComputeSnapshotIamSchema, compute.ComputeSnapshotIamUpdaterProducer, compute.ComputeSnapshotIdParseFunc),
 	"google_compute_snapshot_iam_member":                               tpgiamresource.ResourceIamMember(compute.ComputeSnapshotIamSchema, compute.ComputeSnapshotIamUpdaterProducer, compute.ComputeSnapshotIdParseFunc),
+	"google_compute_snapshot_iam_member_remove":                        tpgiamresource.ResourceIamMemberRemove(compute.ComputeSnapshotIamSchema, compute.ComputeSnapshotIamUpdaterProducer),
 	"google_compute_snapshot_iam_policy":                               tpgiamresource.ResourceIamPolicy(compute.ComputeSnapshotIamSchema, compute.ComputeSnapshotIamUpdaterProducer, compute.ComputeSnapshotIdParseFunc),
 	"google_compute_ssl_certificate":                                   compute.ResourceComputeSslCertificate(),
 	"google_compute_ssl_policy":                                        compute.ResourceComputeSslPolicy(),
 	"google_compute_subnetwork":                                        compute.ResourceComputeSubnetwork(),
 	"google_compute_subnetwork_iam_binding":                            tpgiamresource.ResourceIamBinding(compute.ComputeSubnetworkIamSchema, compute.ComputeSubnetworkIamUpdaterProducer, compute.ComputeSubnetworkIdParseFunc),
 	"google_compute_subnetwork_iam_member":                             tpgiamresource.ResourceIamMember(compute.ComputeSubnetworkIamSchema, compute.ComputeSubnetworkIamUpdaterProducer, compute.ComputeSubnetworkIdParseFunc),
+	"google_compute_subnetwork_iam_member_remove":                      tpgiamresource.ResourceIamMemberRemove(compute.ComputeSubnetworkIamSchema, compute.ComputeSubnetworkIamUpdaterProducer),
 	"google_compute_subnetwork_iam_policy":                             tpgiamresource.ResourceIamPolicy(compute.ComputeSubnetworkIamSchema, compute.ComputeSubnetworkIamUpdaterProducer, compute.ComputeSubnetworkIdParseFunc),
 	"google_compute_target_grpc_proxy":                                 compute.ResourceComputeTargetGrpcProxy(),
 	"google_compute_target_http_proxy":                                 compute.ResourceComputeTargetHttpProxy(),
@@ -747,6 +782,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_container_analysis_note":                                   containeranalysis.ResourceContainerAnalysisNote(),
 	"google_container_analysis_note_iam_binding":                       tpgiamresource.ResourceIamBinding(containeranalysis.ContainerAnalysisNoteIamSchema, containeranalysis.ContainerAnalysisNoteIamUpdaterProducer, containeranalysis.ContainerAnalysisNoteIdParseFunc),
 	"google_container_analysis_note_iam_member":                        tpgiamresource.ResourceIamMember(containeranalysis.ContainerAnalysisNoteIamSchema, containeranalysis.ContainerAnalysisNoteIamUpdaterProducer, containeranalysis.ContainerAnalysisNoteIdParseFunc),
+	"google_container_analysis_note_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(containeranalysis.ContainerAnalysisNoteIamSchema, containeranalysis.ContainerAnalysisNoteIamUpdaterProducer),
 	"google_container_analysis_note_iam_policy":                        tpgiamresource.ResourceIamPolicy(containeranalysis.ContainerAnalysisNoteIamSchema, containeranalysis.ContainerAnalysisNoteIamUpdaterProducer, containeranalysis.ContainerAnalysisNoteIdParseFunc),
 	"google_container_analysis_occurrence":                             containeranalysis.ResourceContainerAnalysisOccurrence(),
 	"google_container_attached_cluster":                                containerattached.ResourceContainerAttachedCluster(),
@@ -757,29 +793,35 @@ var generatedResources = map[string]*schema.Resource{
 	"google_data_catalog_entry_group":                                  datacatalog.ResourceDataCatalogEntryGroup(),
 	"google_data_catalog_entry_group_iam_binding":                      tpgiamresource.ResourceIamBinding(datacatalog.DataCatalogEntryGroupIamSchema, datacatalog.DataCatalogEntryGroupIamUpdaterProducer, datacatalog.DataCatalogEntryGroupIdParseFunc),
 	"google_data_catalog_entry_group_iam_member":                       tpgiamresource.ResourceIamMember(datacatalog.DataCatalogEntryGroupIamSchema, datacatalog.DataCatalogEntryGroupIamUpdaterProducer, datacatalog.DataCatalogEntryGroupIdParseFunc),
+	"google_data_catalog_entry_group_iam_member_remove":                tpgiamresource.ResourceIamMemberRemove(datacatalog.DataCatalogEntryGroupIamSchema, datacatalog.DataCatalogEntryGroupIamUpdaterProducer),
 	"google_data_catalog_entry_group_iam_policy":                       tpgiamresource.ResourceIamPolicy(datacatalog.DataCatalogEntryGroupIamSchema, datacatalog.DataCatalogEntryGroupIamUpdaterProducer, datacatalog.DataCatalogEntryGroupIdParseFunc),
 	"google_data_catalog_policy_tag":                                   datacatalog.ResourceDataCatalogPolicyTag(),
 	"google_data_catalog_policy_tag_iam_binding":                       tpgiamresource.ResourceIamBinding(datacatalog.DataCatalogPolicyTagIamSchema, datacatalog.DataCatalogPolicyTagIamUpdaterProducer, datacatalog.DataCatalogPolicyTagIdParseFunc),
 	"google_data_catalog_policy_tag_iam_member":                        tpgiamresource.ResourceIamMember(datacatalog.DataCatalogPolicyTagIamSchema, datacatalog.DataCatalogPolicyTagIamUpdaterProducer, datacatalog.DataCatalogPolicyTagIdParseFunc),
+	"google_data_catalog_policy_tag_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(datacatalog.DataCatalogPolicyTagIamSchema, datacatalog.DataCatalogPolicyTagIamUpdaterProducer),
 	"google_data_catalog_policy_tag_iam_policy":                        tpgiamresource.ResourceIamPolicy(datacatalog.DataCatalogPolicyTagIamSchema, datacatalog.DataCatalogPolicyTagIamUpdaterProducer, datacatalog.DataCatalogPolicyTagIdParseFunc),
 	"google_data_catalog_tag":                                          datacatalog.ResourceDataCatalogTag(),
 	"google_data_catalog_tag_template":                                 datacatalog.ResourceDataCatalogTagTemplate(),
 	"google_data_catalog_tag_template_iam_binding":                     tpgiamresource.ResourceIamBinding(datacatalog.DataCatalogTagTemplateIamSchema, datacatalog.DataCatalogTagTemplateIamUpdaterProducer, datacatalog.DataCatalogTagTemplateIdParseFunc),
 	"google_data_catalog_tag_template_iam_member":                      tpgiamresource.ResourceIamMember(datacatalog.DataCatalogTagTemplateIamSchema, datacatalog.DataCatalogTagTemplateIamUpdaterProducer, datacatalog.DataCatalogTagTemplateIdParseFunc),
+	"google_data_catalog_tag_template_iam_member_remove":               tpgiamresource.ResourceIamMemberRemove(datacatalog.DataCatalogTagTemplateIamSchema, datacatalog.DataCatalogTagTemplateIamUpdaterProducer),
 	"google_data_catalog_tag_template_iam_policy":                      tpgiamresource.ResourceIamPolicy(datacatalog.DataCatalogTagTemplateIamSchema, datacatalog.DataCatalogTagTemplateIamUpdaterProducer, datacatalog.DataCatalogTagTemplateIdParseFunc),
 	"google_data_catalog_taxonomy":                                     datacatalog.ResourceDataCatalogTaxonomy(),
 	"google_data_catalog_taxonomy_iam_binding":                         tpgiamresource.ResourceIamBinding(datacatalog.DataCatalogTaxonomyIamSchema, datacatalog.DataCatalogTaxonomyIamUpdaterProducer, datacatalog.DataCatalogTaxonomyIdParseFunc),
 	"google_data_catalog_taxonomy_iam_member":                          tpgiamresource.ResourceIamMember(datacatalog.DataCatalogTaxonomyIamSchema, datacatalog.DataCatalogTaxonomyIamUpdaterProducer, datacatalog.DataCatalogTaxonomyIdParseFunc),
+	"google_data_catalog_taxonomy_iam_member_remove":                   tpgiamresource.ResourceIamMemberRemove(datacatalog.DataCatalogTaxonomyIamSchema, datacatalog.DataCatalogTaxonomyIamUpdaterProducer),
 	"google_data_catalog_taxonomy_iam_policy":                          tpgiamresource.ResourceIamPolicy(datacatalog.DataCatalogTaxonomyIamSchema, datacatalog.DataCatalogTaxonomyIamUpdaterProducer, datacatalog.DataCatalogTaxonomyIdParseFunc),
 	"google_dataform_repository":                                       dataform.ResourceDataformRepository(),
 	"google_dataform_repository_iam_binding":                           tpgiamresource.ResourceIamBinding(dataform.DataformRepositoryIamSchema, dataform.DataformRepositoryIamUpdaterProducer, dataform.DataformRepositoryIdParseFunc),
 	"google_dataform_repository_iam_member":                            tpgiamresource.ResourceIamMember(dataform.DataformRepositoryIamSchema, dataform.DataformRepositoryIamUpdaterProducer, dataform.DataformRepositoryIdParseFunc),
+	"google_dataform_repository_iam_member_remove":                     tpgiamresource.ResourceIamMemberRemove(dataform.DataformRepositoryIamSchema, dataform.DataformRepositoryIamUpdaterProducer),
 	"google_dataform_repository_iam_policy":                            tpgiamresource.ResourceIamPolicy(dataform.DataformRepositoryIamSchema, dataform.DataformRepositoryIamUpdaterProducer, dataform.DataformRepositoryIdParseFunc),
 	"google_dataform_repository_release_config":                        dataform.ResourceDataformRepositoryReleaseConfig(),
 	"google_dataform_repository_workflow_config":                       dataform.ResourceDataformRepositoryWorkflowConfig(),
 	"google_data_fusion_instance":                                      datafusion.ResourceDataFusionInstance(),
 	"google_data_fusion_instance_iam_binding":                          tpgiamresource.ResourceIamBinding(datafusion.DataFusionInstanceIamSchema, datafusion.DataFusionInstanceIamUpdaterProducer, datafusion.DataFusionInstanceIdParseFunc),
 	"google_data_fusion_instance_iam_member":                           tpgiamresource.ResourceIamMember(datafusion.DataFusionInstanceIamSchema, datafusion.DataFusionInstanceIamUpdaterProducer, datafusion.DataFusionInstanceIdParseFunc),
+	"google_data_fusion_instance_iam_member_remove":                    tpgiamresource.ResourceIamMemberRemove(datafusion.DataFusionInstanceIamSchema, datafusion.DataFusionInstanceIamUpdaterProducer),
 	"google_data_fusion_instance_iam_policy":                           tpgiamresource.ResourceIamPolicy(datafusion.DataFusionInstanceIamSchema, datafusion.DataFusionInstanceIamUpdaterProducer, datafusion.DataFusionInstanceIdParseFunc),
 	"google_data_loss_prevention_deidentify_template":                  datalossprevention.ResourceDataLossPreventionDeidentifyTemplate(),
 	"google_data_loss_prevention_discovery_config":                     datalossprevention.ResourceDataLossPreventionDiscoveryConfig(),
@@ -790,43 +832,54 @@ var generatedResources = map[string]*schema.Resource{
 	"google_dataplex_aspect_type":                                      dataplex.ResourceDataplexAspectType(),
 	"google_dataplex_aspect_type_iam_binding":                          tpgiamresource.ResourceIamBinding(dataplex.DataplexAspectTypeIamSchema, dataplex.DataplexAspectTypeIamUpdaterProducer, dataplex.DataplexAspectTypeIdParseFunc),
 	"google_dataplex_aspect_type_iam_member":                           tpgiamresource.ResourceIamMember(dataplex.DataplexAspectTypeIamSchema, dataplex.DataplexAspectTypeIamUpdaterProducer, dataplex.DataplexAspectTypeIdParseFunc),
+	"google_dataplex_aspect_type_iam_member_remove":                    tpgiamresource.ResourceIamMemberRemove(dataplex.DataplexAspectTypeIamSchema, dataplex.DataplexAspectTypeIamUpdaterProducer),
 	"google_dataplex_aspect_type_iam_policy":                           tpgiamresource.ResourceIamPolicy(dataplex.DataplexAspectTypeIamSchema, dataplex.DataplexAspectTypeIamUpdaterProducer, dataplex.DataplexAspectTypeIdParseFunc),
 	"google_dataplex_asset_iam_binding":                                tpgiamresource.ResourceIamBinding(dataplex.DataplexAssetIamSchema, dataplex.DataplexAssetIamUpdaterProducer, dataplex.DataplexAssetIdParseFunc),
 	"google_dataplex_asset_iam_member":                                 tpgiamresource.ResourceIamMember(dataplex.DataplexAssetIamSchema, dataplex.DataplexAssetIamUpdaterProducer, dataplex.DataplexAssetIdParseFunc),
+	"google_dataplex_asset_iam_member_remove":                          tpgiamresource.ResourceIamMemberRemove(dataplex.DataplexAssetIamSchema, dataplex.DataplexAssetIamUpdaterProducer),
 	"google_dataplex_asset_iam_policy":                                 tpgiamresource.ResourceIamPolicy(dataplex.DataplexAssetIamSchema, dataplex.DataplexAssetIamUpdaterProducer, dataplex.DataplexAssetIdParseFunc),
 	"google_dataplex_datascan":                                         dataplex.ResourceDataplexDatascan(),
 	"google_dataplex_datascan_iam_binding":                             tpgiamresource.ResourceIamBinding(dataplex.DataplexDatascanIamSchema, dataplex.DataplexDatascanIamUpdaterProducer, dataplex.DataplexDatascanIdParseFunc),
 	"google_dataplex_datascan_iam_member":                              tpgiamresource.ResourceIamMember(dataplex.DataplexDatascanIamSchema, dataplex.DataplexDatascanIamUpdaterProducer, dataplex.DataplexDatascanIdParseFunc),
+	"google_dataplex_datascan_iam_member_remove":                       tpgiamresource.ResourceIamMemberRemove(dataplex.DataplexDatascanIamSchema, dataplex.DataplexDatascanIamUpdaterProducer),
 	"google_dataplex_datascan_iam_policy":                              tpgiamresource.ResourceIamPolicy(dataplex.DataplexDatascanIamSchema, dataplex.DataplexDatascanIamUpdaterProducer, dataplex.DataplexDatascanIdParseFunc),
 	"google_dataplex_entry_group":                                      dataplex.ResourceDataplexEntryGroup(),
 	"google_dataplex_entry_group_iam_binding":                          tpgiamresource.ResourceIamBinding(dataplex.DataplexEntryGroupIamSchema, dataplex.DataplexEntryGroupIamUpdaterProducer, dataplex.DataplexEntryGroupIdParseFunc),
 	"google_dataplex_entry_group_iam_member":                           tpgiamresource.ResourceIamMember(dataplex.DataplexEntryGroupIamSchema, dataplex.DataplexEntryGroupIamUpdaterProducer, dataplex.DataplexEntryGroupIdParseFunc),
+	"google_dataplex_entry_group_iam_member_remove":                    tpgiamresource.ResourceIamMemberRemove(dataplex.DataplexEntryGroupIamSchema, dataplex.DataplexEntryGroupIamUpdaterProducer),
 	"google_dataplex_entry_group_iam_policy":                           tpgiamresource.ResourceIamPolicy(dataplex.DataplexEntryGroupIamSchema, dataplex.DataplexEntryGroupIamUpdaterProducer, dataplex.DataplexEntryGroupIdParseFunc),
 	"google_dataplex_entry_type":                                       dataplex.ResourceDataplexEntryType(),
 	"google_dataplex_entry_type_iam_binding":                           tpgiamresource.ResourceIamBinding(dataplex.DataplexEntryTypeIamSchema, dataplex.DataplexEntryTypeIamUpdaterProducer, dataplex.DataplexEntryTypeIdParseFunc),
 	"google_dataplex_entry_type_iam_member":                            tpgiamresource.ResourceIamMember(dataplex.DataplexEntryTypeIamSchema, dataplex.DataplexEntryTypeIamUpdaterProducer, dataplex.DataplexEntryTypeIdParseFunc),
+	"google_dataplex_entry_type_iam_member_remove":                     tpgiamresource.ResourceIamMemberRemove(dataplex.DataplexEntryTypeIamSchema, dataplex.DataplexEntryTypeIamUpdaterProducer),
 	"google_dataplex_entry_type_iam_policy":                            tpgiamresource.ResourceIamPolicy(dataplex.DataplexEntryTypeIamSchema, dataplex.DataplexEntryTypeIamUpdaterProducer, dataplex.DataplexEntryTypeIdParseFunc),
 	"google_dataplex_lake_iam_binding":                                 tpgiamresource.ResourceIamBinding(dataplex.DataplexLakeIamSchema, dataplex.DataplexLakeIamUpdaterProducer, dataplex.DataplexLakeIdParseFunc),
 	"google_dataplex_lake_iam_member":                                  tpgiamresource.ResourceIamMember(dataplex.DataplexLakeIamSchema, dataplex.DataplexLakeIamUpdaterProducer, dataplex.DataplexLakeIdParseFunc),
+	"google_dataplex_lake_iam_member_remove":                           tpgiamresource.ResourceIamMemberRemove(dataplex.DataplexLakeIamSchema, dataplex.DataplexLakeIamUpdaterProducer),
 	"google_dataplex_lake_iam_policy":                                  tpgiamresource.ResourceIamPolicy(dataplex.DataplexLakeIamSchema, dataplex.DataplexLakeIamUpdaterProducer, dataplex.DataplexLakeIdParseFunc),
 	"google_dataplex_task":                                             dataplex.ResourceDataplexTask(),
 	"google_dataplex_task_iam_binding":                                 tpgiamresource.ResourceIamBinding(dataplex.DataplexTaskIamSchema, dataplex.DataplexTaskIamUpdaterProducer, dataplex.DataplexTaskIdParseFunc),
 	"google_dataplex_task_iam_member":                                  tpgiamresource.ResourceIamMember(dataplex.DataplexTaskIamSchema, dataplex.DataplexTaskIamUpdaterProducer, dataplex.DataplexTaskIdParseFunc),
+	"google_dataplex_task_iam_member_remove":                           tpgiamresource.ResourceIamMemberRemove(dataplex.DataplexTaskIamSchema, dataplex.DataplexTaskIamUpdaterProducer),
 	"google_dataplex_task_iam_policy":                                  tpgiamresource.ResourceIamPolicy(dataplex.DataplexTaskIamSchema, dataplex.DataplexTaskIamUpdaterProducer, dataplex.DataplexTaskIdParseFunc),
 	"google_dataplex_zone_iam_binding":                                 tpgiamresource.ResourceIamBinding(dataplex.DataplexZoneIamSchema, dataplex.DataplexZoneIamUpdaterProducer, dataplex.DataplexZoneIdParseFunc),
 	"google_dataplex_zone_iam_member":                                  tpgiamresource.ResourceIamMember(dataplex.DataplexZoneIamSchema, dataplex.DataplexZoneIamUpdaterProducer, dataplex.DataplexZoneIdParseFunc),
+	"google_dataplex_zone_iam_member_remove":                           tpgiamresource.ResourceIamMemberRemove(dataplex.DataplexZoneIamSchema, dataplex.DataplexZoneIamUpdaterProducer),
 	"google_dataplex_zone_iam_policy":                                  tpgiamresource.ResourceIamPolicy(dataplex.DataplexZoneIamSchema, dataplex.DataplexZoneIamUpdaterProducer, dataplex.DataplexZoneIdParseFunc),
 	"google_dataproc_autoscaling_policy":                               dataproc.ResourceDataprocAutoscalingPolicy(),
 	"google_dataproc_autoscaling_policy_iam_binding":                   tpgiamresource.ResourceIamBinding(dataproc.DataprocAutoscalingPolicyIamSchema, dataproc.DataprocAutoscalingPolicyIamUpdaterProducer, dataproc.DataprocAutoscalingPolicyIdParseFunc),
 	"google_dataproc_autoscaling_policy_iam_member":                    tpgiamresource.ResourceIamMember(dataproc.DataprocAutoscalingPolicyIamSchema, dataproc.DataprocAutoscalingPolicyIamUpdaterProducer, dataproc.DataprocAutoscalingPolicyIdParseFunc),
+	"google_dataproc_autoscaling_policy_iam_member_remove":             tpgiamresource.ResourceIamMemberRemove(dataproc.DataprocAutoscalingPolicyIamSchema, dataproc.DataprocAutoscalingPolicyIamUpdaterProducer),
 	"google_dataproc_autoscaling_policy_iam_policy":                    tpgiamresource.ResourceIamPolicy(dataproc.DataprocAutoscalingPolicyIamSchema, dataproc.DataprocAutoscalingPolicyIamUpdaterProducer, dataproc.DataprocAutoscalingPolicyIdParseFunc),
 	"google_dataproc_metastore_federation":                             dataprocmetastore.ResourceDataprocMetastoreFederation(),
 	"google_dataproc_metastore_federation_iam_binding":                 tpgiamresource.ResourceIamBinding(dataprocmetastore.DataprocMetastoreFederationIamSchema, dataprocmetastore.DataprocMetastoreFederationIamUpdaterProducer, dataprocmetastore.DataprocMetastoreFederationIdParseFunc),
 	"google_dataproc_metastore_federation_iam_member":                  tpgiamresource.ResourceIamMember(dataprocmetastore.DataprocMetastoreFederationIamSchema, dataprocmetastore.DataprocMetastoreFederationIamUpdaterProducer, dataprocmetastore.DataprocMetastoreFederationIdParseFunc),
+	"google_dataproc_metastore_federation_iam_member_remove":           tpgiamresource.ResourceIamMemberRemove(dataprocmetastore.DataprocMetastoreFederationIamSchema, dataprocmetastore.DataprocMetastoreFederationIamUpdaterProducer),
 	"google_dataproc_metastore_federation_iam_policy":                  tpgiamresource.ResourceIamPolicy(dataprocmetastore.DataprocMetastoreFederationIamSchema, dataprocmetastore.DataprocMetastoreFederationIamUpdaterProducer, dataprocmetastore.DataprocMetastoreFederationIdParseFunc),
 	"google_dataproc_metastore_service":                                dataprocmetastore.ResourceDataprocMetastoreService(),
 	"google_dataproc_metastore_service_iam_binding":                    tpgiamresource.ResourceIamBinding(dataprocmetastore.DataprocMetastoreServiceIamSchema, dataprocmetastore.DataprocMetastoreServiceIamUpdaterProducer, dataprocmetastore.DataprocMetastoreServiceIdParseFunc),
 	"google_dataproc_metastore_service_iam_member":                     tpgiamresource.ResourceIamMember(dataprocmetastore.DataprocMetastoreServiceIamSchema, dataprocmetastore.DataprocMetastoreServiceIamUpdaterProducer, dataprocmetastore.DataprocMetastoreServiceIdParseFunc),
+	"google_dataproc_metastore_service_iam_member_remove":              tpgiamresource.ResourceIamMemberRemove(dataprocmetastore.DataprocMetastoreServiceIamSchema, dataprocmetastore.DataprocMetastoreServiceIamUpdaterProducer),
 	"google_dataproc_metastore_service_iam_policy":                     tpgiamresource.ResourceIamPolicy(dataprocmetastore.DataprocMetastoreServiceIamSchema, dataprocmetastore.DataprocMetastoreServiceIamUpdaterProducer, dataprocmetastore.DataprocMetastoreServiceIdParseFunc),
 	"google_datastore_index":                                           datastore.ResourceDatastoreIndex(),
 	"google_datastream_connection_profile":                             datastream.ResourceDatastreamConnectionProfile(),
@@ -853,6 +906,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_dns_managed_zone":                                          dns.ResourceDNSManagedZone(),
 	"google_dns_managed_zone_iam_binding":                              tpgiamresource.ResourceIamBinding(dns.DNSManagedZoneIamSchema, dns.DNSManagedZoneIamUpdaterProducer, dns.DNSManagedZoneIdParseFunc),
 	"google_dns_managed_zone_iam_member":                               tpgiamresource.ResourceIamMember(dns.DNSManagedZoneIamSchema, dns.DNSManagedZoneIamUpdaterProducer, dns.DNSManagedZoneIdParseFunc),
+	"google_dns_managed_zone_iam_member_remove":                        tpgiamresource.ResourceIamMemberRemove(dns.DNSManagedZoneIamSchema, dns.DNSManagedZoneIamUpdaterProducer),
 	"google_dns_managed_zone_iam_policy":                               tpgiamresource.ResourceIamPolicy(dns.DNSManagedZoneIamSchema, dns.DNSManagedZoneIamUpdaterProducer, dns.DNSManagedZoneIdParseFunc),
 	"google_dns_policy":                                                dns.ResourceDNSPolicy(),
 	"google_dns_response_policy":                                       dns.ResourceDNSResponsePolicy(),
@@ -897,18 +951,22 @@ var generatedResources = map[string]*schema.Resource{
 	"google_gke_backup_backup_plan":                                    gkebackup.ResourceGKEBackupBackupPlan(),
 	"google_gke_backup_backup_plan_iam_binding":                        tpgiamresource.ResourceIamBinding(gkebackup.GKEBackupBackupPlanIamSchema, gkebackup.GKEBackupBackupPlanIamUpdaterProducer, gkebackup.GKEBackupBackupPlanIdParseFunc),
 	"google_gke_backup_backup_plan_iam_member":                         tpgiamresource.ResourceIamMember(gkebackup.GKEBackupBackupPlanIamSchema, gkebackup.GKEBackupBackupPlanIamUpdaterProducer, gkebackup.GKEBackupBackupPlanIdParseFunc),
+	"google_gke_backup_backup_plan_iam_member_remove":                  tpgiamresource.ResourceIamMemberRemove(gkebackup.GKEBackupBackupPlanIamSchema, gkebackup.GKEBackupBackupPlanIamUpdaterProducer),
 	"google_gke_backup_backup_plan_iam_policy":                         tpgiamresource.ResourceIamPolicy(gkebackup.GKEBackupBackupPlanIamSchema, gkebackup.GKEBackupBackupPlanIamUpdaterProducer, gkebackup.GKEBackupBackupPlanIdParseFunc),
 	"google_gke_backup_restore_plan":                                   gkebackup.ResourceGKEBackupRestorePlan(),
 	"google_gke_backup_restore_plan_iam_binding":                       tpgiamresource.ResourceIamBinding(gkebackup.GKEBackupRestorePlanIamSchema, gkebackup.GKEBackupRestorePlanIamUpdaterProducer, gkebackup.GKEBackupRestorePlanIdParseFunc),
 	"google_gke_backup_restore_plan_iam_member":                        tpgiamresource.ResourceIamMember(gkebackup.GKEBackupRestorePlanIamSchema, gkebackup.GKEBackupRestorePlanIamUpdaterProducer, gkebackup.GKEBackupRestorePlanIdParseFunc),
+	"google_gke_backup_restore_plan_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(gkebackup.GKEBackupRestorePlanIamSchema, gkebackup.GKEBackupRestorePlanIamUpdaterProducer),
 	"google_gke_backup_restore_plan_iam_policy":                        tpgiamresource.ResourceIamPolicy(gkebackup.GKEBackupRestorePlanIamSchema, gkebackup.GKEBackupRestorePlanIamUpdaterProducer, gkebackup.GKEBackupRestorePlanIdParseFunc),
 	"google_gke_hub_membership":                                        gkehub.ResourceGKEHubMembership(),
 	"google_gke_hub_membership_iam_binding":                            tpgiamresource.ResourceIamBinding(gkehub.GKEHubMembershipIamSchema, gkehub.GKEHubMembershipIamUpdaterProducer, gkehub.GKEHubMembershipIdParseFunc),
 	"google_gke_hub_membership_iam_member":                             tpgiamresource.ResourceIamMember(gkehub.GKEHubMembershipIamSchema, gkehub.GKEHubMembershipIamUpdaterProducer, gkehub.GKEHubMembershipIdParseFunc),
+	"google_gke_hub_membership_iam_member_remove":                      tpgiamresource.ResourceIamMemberRemove(gkehub.GKEHubMembershipIamSchema, gkehub.GKEHubMembershipIamUpdaterProducer),
 	"google_gke_hub_membership_iam_policy":                             tpgiamresource.ResourceIamPolicy(gkehub.GKEHubMembershipIamSchema, gkehub.GKEHubMembershipIamUpdaterProducer, gkehub.GKEHubMembershipIdParseFunc),
 	"google_gke_hub_feature":                                           gkehub2.ResourceGKEHub2Feature(),
 	"google_gke_hub_feature_iam_binding":                               tpgiamresource.ResourceIamBinding(gkehub2.GKEHub2FeatureIamSchema, gkehub2.GKEHub2FeatureIamUpdaterProducer, gkehub2.GKEHub2FeatureIdParseFunc),
 	"google_gke_hub_feature_iam_member":                                tpgiamresource.ResourceIamMember(gkehub2.GKEHub2FeatureIamSchema, gkehub2.GKEHub2FeatureIamUpdaterProducer, gkehub2.GKEHub2FeatureIdParseFunc),
+	"google_gke_hub_feature_iam_member_remove":                         tpgiamresource.ResourceIamMemberRemove(gkehub2.GKEHub2FeatureIamSchema, gkehub2.GKEHub2FeatureIamUpdaterProducer),
 	"google_gke_hub_feature_iam_policy":                                tpgiamresource.ResourceIamPolicy(gkehub2.GKEHub2FeatureIamSchema, gkehub2.GKEHub2FeatureIamUpdaterProducer, gkehub2.GKEHub2FeatureIdParseFunc),
 	"google_gke_hub_fleet":                                             gkehub2.ResourceGKEHub2Fleet(),
 	"google_gke_hub_membership_binding":                                gkehub2.ResourceGKEHub2MembershipBinding(),
@@ -917,6 +975,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_gke_hub_scope":                                             gkehub2.ResourceGKEHub2Scope(),
 	"google_gke_hub_scope_iam_binding":                                 tpgiamresource.ResourceIamBinding(gkehub2.GKEHub2ScopeIamSchema, gkehub2.GKEHub2ScopeIamUpdaterProducer, gkehub2.GKEHub2ScopeIdParseFunc),
 	"google_gke_hub_scope_iam_member":                                  tpgiamresource.ResourceIamMember(gkehub2.GKEHub2ScopeIamSchema, gkehub2.GKEHub2ScopeIamUpdaterProducer, gkehub2.GKEHub2ScopeIdParseFunc),
+	"google_gke_hub_scope_iam_member_remove":                           tpgiamresource.ResourceIamMemberRemove(gkehub2.GKEHub2ScopeIamSchema, gkehub2.GKEHub2ScopeIamUpdaterProducer),
 	"google_gke_hub_scope_iam_policy":                                  tpgiamresource.ResourceIamPolicy(gkehub2.GKEHub2ScopeIamSchema, gkehub2.GKEHub2ScopeIamUpdaterProducer, gkehub2.GKEHub2ScopeIdParseFunc),
 	"google_gke_hub_scope_rbac_role_binding":                           gkehub2.ResourceGKEHub2ScopeRBACRoleBinding(),
 	"google_gkeonprem_bare_metal_admin_cluster":                        gkeonprem.ResourceGkeonpremBareMetalAdminCluster(),
@@ -927,6 +986,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_healthcare_consent_store":                                  healthcare.ResourceHealthcareConsentStore(),
 	"google_healthcare_consent_store_iam_binding":                      tpgiamresource.ResourceIamBinding(healthcare.HealthcareConsentStoreIamSchema, healthcare.HealthcareConsentStoreIamUpdaterProducer, healthcare.HealthcareConsentStoreIdParseFunc),
 	"google_healthcare_consent_store_iam_member":                       tpgiamresource.ResourceIamMember(healthcare.HealthcareConsentStoreIamSchema, healthcare.HealthcareConsentStoreIamUpdaterProducer, healthcare.HealthcareConsentStoreIdParseFunc),
+	"google_healthcare_consent_store_iam_member_remove":                tpgiamresource.ResourceIamMemberRemove(healthcare.HealthcareConsentStoreIamSchema, healthcare.HealthcareConsentStoreIamUpdaterProducer),
 	"google_healthcare_consent_store_iam_policy":                       tpgiamresource.ResourceIamPolicy(healthcare.HealthcareConsentStoreIamSchema, healthcare.HealthcareConsentStoreIamUpdaterProducer, healthcare.HealthcareConsentStoreIdParseFunc),
 	"google_healthcare_dataset":                                        healthcare.ResourceHealthcareDataset(),
 	"google_healthcare_dicom_store":                                    healthcare.ResourceHealthcareDicomStore(),
@@ -940,36 +1000,46 @@ var generatedResources = map[string]*schema.Resource{
 	"google_iam_workforce_pool_provider":                               iamworkforcepool.ResourceIAMWorkforcePoolWorkforcePoolProvider(),
 	"google_iap_app_engine_service_iam_binding":                        tpgiamresource.ResourceIamBinding(iap.IapAppEngineServiceIamSchema, iap.IapAppEngineServiceIamUpdaterProducer, iap.IapAppEngineServiceIdParseFunc),
 	"google_iap_app_engine_service_iam_member":                         tpgiamresource.ResourceIamMember(iap.IapAppEngineServiceIamSchema, iap.IapAppEngineServiceIamUpdaterProducer, iap.IapAppEngineServiceIdParseFunc),
+	"google_iap_app_engine_service_iam_member_remove":                  tpgiamresource.ResourceIamMemberRemove(iap.IapAppEngineServiceIamSchema, iap.IapAppEngineServiceIamUpdaterProducer),
 	"google_iap_app_engine_service_iam_policy":                         tpgiamresource.ResourceIamPolicy(iap.IapAppEngineServiceIamSchema, iap.IapAppEngineServiceIamUpdaterProducer, iap.IapAppEngineServiceIdParseFunc),
 	"google_iap_app_engine_version_iam_binding":                        tpgiamresource.ResourceIamBinding(iap.IapAppEngineVersionIamSchema, iap.IapAppEngineVersionIamUpdaterProducer, iap.IapAppEngineVersionIdParseFunc),
 	"google_iap_app_engine_version_iam_member":                         tpgiamresource.ResourceIamMember(iap.IapAppEngineVersionIamSchema, iap.IapAppEngineVersionIamUpdaterProducer, iap.IapAppEngineVersionIdParseFunc),
+	"google_iap_app_engine_version_iam_member_remove":                  tpgiamresource.ResourceIamMemberRemove(iap.IapAppEngineVersionIamSchema, iap.IapAppEngineVersionIamUpdaterProducer),
 	"google_iap_app_engine_version_iam_policy":                         tpgiamresource.ResourceIamPolicy(iap.IapAppEngineVersionIamSchema, iap.IapAppEngineVersionIamUpdaterProducer, iap.IapAppEngineVersionIdParseFunc),
 	"google_iap_brand":                                                 iap.ResourceIapBrand(),
 	"google_iap_client":                                                iap.ResourceIapClient(),
 	"google_iap_tunnel_iam_binding":                                    tpgiamresource.ResourceIamBinding(iap.IapTunnelIamSchema, iap.IapTunnelIamUpdaterProducer, iap.IapTunnelIdParseFunc),
 	"google_iap_tunnel_iam_member":                                     tpgiamresource.ResourceIamMember(iap.IapTunnelIamSchema, iap.IapTunnelIamUpdaterProducer, iap.IapTunnelIdParseFunc),
+	"google_iap_tunnel_iam_member_remove":                              tpgiamresource.ResourceIamMemberRemove(iap.IapTunnelIamSchema, iap.IapTunnelIamUpdaterProducer),
 	"google_iap_tunnel_iam_policy":                                     tpgiamresource.ResourceIamPolicy(iap.IapTunnelIamSchema, iap.IapTunnelIamUpdaterProducer, iap.IapTunnelIdParseFunc),
 	"google_iap_tunnel_dest_group":                                     iap.ResourceIapTunnelDestGroup(),
 	"google_iap_tunnel_dest_group_iam_binding":                         tpgiamresource.ResourceIamBinding(iap.IapTunnelDestGroupIamSchema, iap.IapTunnelDestGroupIamUpdaterProducer, iap.IapTunnelDestGroupIdParseFunc),
 	"google_iap_tunnel_dest_group_iam_member":                          tpgiamresource.ResourceIamMember(iap.IapTunnelDestGroupIamSchema, iap.IapTunnelDestGroupIamUpdaterProducer, iap.IapTunnelDestGroupIdParseFunc),
+	"google_iap_tunnel_dest_group_iam_member_remove":                   tpgiamresource.ResourceIamMemberRemove(iap.IapTunnelDestGroupIamSchema, iap.IapTunnelDestGroupIamUpdaterProducer),
 	"google_iap_tunnel_dest_group_iam_policy":                          tpgiamresource.ResourceIamPolicy(iap.IapTunnelDestGroupIamSchema, iap.IapTunnelDestGroupIamUpdaterProducer, iap.IapTunnelDestGroupIdParseFunc),
 	"google_iap_tunnel_instance_iam_binding":                           tpgiamresource.ResourceIamBinding(iap.IapTunnelInstanceIamSchema, iap.IapTunnelInstanceIamUpdaterProducer, iap.IapTunnelInstanceIdParseFunc),
 	"google_iap_tunnel_instance_iam_member":                            tpgiamresource.ResourceIamMember(iap.IapTunnelInstanceIamSchema, iap.IapTunnelInstanceIamUpdaterProducer, iap.IapTunnelInstanceIdParseFunc),
+	"google_iap_tunnel_instance_iam_member_remove":                     tpgiamresource.ResourceIamMemberRemove(iap.IapTunnelInstanceIamSchema, iap.IapTunnelInstanceIamUpdaterProducer),
 	"google_iap_tunnel_instance_iam_policy":                            tpgiamresource.ResourceIamPolicy(iap.IapTunnelInstanceIamSchema, iap.IapTunnelInstanceIamUpdaterProducer, iap.IapTunnelInstanceIdParseFunc),
 	"google_iap_web_iam_binding":                                       tpgiamresource.ResourceIamBinding(iap.IapWebIamSchema, iap.IapWebIamUpdaterProducer, iap.IapWebIdParseFunc),
 	"google_iap_web_iam_member":                                        tpgiamresource.ResourceIamMember(iap.IapWebIamSchema, iap.IapWebIamUpdaterProducer, iap.IapWebIdParseFunc),
+	"google_iap_web_iam_member_remove":                                 tpgiamresource.ResourceIamMemberRemove(iap.IapWebIamSchema, iap.IapWebIamUpdaterProducer),
 	"google_iap_web_iam_policy":                                        tpgiamresource.ResourceIamPolicy(iap.IapWebIamSchema, iap.IapWebIamUpdaterProducer, iap.IapWebIdParseFunc),
 	"google_iap_web_backend_service_iam_binding":                       tpgiamresource.ResourceIamBinding(iap.IapWebBackendServiceIamSchema, iap.IapWebBackendServiceIamUpdaterProducer, iap.IapWebBackendServiceIdParseFunc),
 	"google_iap_web_backend_service_iam_member":                        tpgiamresource.ResourceIamMember(iap.IapWebBackendServiceIamSchema, iap.IapWebBackendServiceIamUpdaterProducer, iap.IapWebBackendServiceIdParseFunc),
+	"google_iap_web_backend_service_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(iap.IapWebBackendServiceIamSchema, iap.IapWebBackendServiceIamUpdaterProducer),
 	"google_iap_web_backend_service_iam_policy":                        tpgiamresource.ResourceIamPolicy(iap.IapWebBackendServiceIamSchema, iap.IapWebBackendServiceIamUpdaterProducer, iap.IapWebBackendServiceIdParseFunc),
 	"google_iap_web_region_backend_service_iam_binding":                tpgiamresource.ResourceIamBinding(iap.IapWebRegionBackendServiceIamSchema, iap.IapWebRegionBackendServiceIamUpdaterProducer, iap.IapWebRegionBackendServiceIdParseFunc),
 	"google_iap_web_region_backend_service_iam_member":                 tpgiamresource.ResourceIamMember(iap.IapWebRegionBackendServiceIamSchema, iap.IapWebRegionBackendServiceIamUpdaterProducer, iap.IapWebRegionBackendServiceIdParseFunc),
+	"google_iap_web_region_backend_service_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(iap.IapWebRegionBackendServiceIamSchema, iap.IapWebRegionBackendServiceIamUpdaterProducer),
 	"google_iap_web_region_backend_service_iam_policy":                 tpgiamresource.ResourceIamPolicy(iap.IapWebRegionBackendServiceIamSchema, iap.IapWebRegionBackendServiceIamUpdaterProducer, iap.IapWebRegionBackendServiceIdParseFunc),
 	"google_iap_web_type_app_engine_iam_binding":                       tpgiamresource.ResourceIamBinding(iap.IapWebTypeAppEngineIamSchema, iap.IapWebTypeAppEngineIamUpdaterProducer, iap.IapWebTypeAppEngineIdParseFunc),
 	"google_iap_web_type_app_engine_iam_member":                        tpgiamresource.ResourceIamMember(iap.IapWebTypeAppEngineIamSchema, iap.IapWebTypeAppEngineIamUpdaterProducer, iap.IapWebTypeAppEngineIdParseFunc),
+	"google_iap_web_type_app_engine_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(iap.IapWebTypeAppEngineIamSchema, iap.IapWebTypeAppEngineIamUpdaterProducer),
 	"google_iap_web_type_app_engine_iam_policy":                        tpgiamresource.ResourceIamPolicy(iap.IapWebTypeAppEngineIamSchema, iap.IapWebTypeAppEngineIamUpdaterProducer, iap.IapWebTypeAppEngineIdParseFunc),
 	"google_iap_web_type_compute_iam_binding":                          tpgiamresource.ResourceIamBinding(iap.IapWebTypeComputeIamSchema, iap.IapWebTypeComputeIamUpdaterProducer, iap.IapWebTypeComputeIdParseFunc),
 	"google_iap_web_type_compute_iam_member":                           tpgiamresource.ResourceIamMember(iap.IapWebTypeComputeIamSchema, iap.IapWebTypeComputeIamUpdaterProducer, iap.IapWebTypeComputeIdParseFunc),
+	"google_iap_web_type_compute_iam_member_remove":                    tpgiamresource.ResourceIamMemberRemove(iap.IapWebTypeComputeIamSchema, iap.IapWebTypeComputeIamUpdaterProducer),
 	"google_iap_web_type_compute_iam_policy":                           tpgiamresource.ResourceIamPolicy(iap.IapWebTypeComputeIamSchema, iap.IapWebTypeComputeIamUpdaterProducer, iap.IapWebTypeComputeIdParseFunc),
 	"google_identity_platform_config":                                  identityplatform.ResourceIdentityPlatformConfig(),
 	"google_identity_platform_default_supported_idp_config":            identityplatform.ResourceIdentityPlatformDefaultSupportedIdpConfig(),
@@ -998,6 +1068,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_logging_log_view":                                          logging.ResourceLoggingLogView(),
 	"google_logging_log_view_iam_binding":                              tpgiamresource.ResourceIamBinding(logging.LoggingLogViewIamSchema, logging.LoggingLogViewIamUpdaterProducer, logging.LoggingLogViewIdParseFunc),
 	"google_logging_log_view_iam_member":                               tpgiamresource.ResourceIamMember(logging.LoggingLogViewIamSchema, logging.LoggingLogViewIamUpdaterProducer, logging.LoggingLogViewIdParseFunc),
+	"google_logging_log_view_iam_member_remove":                        tpgiamresource.ResourceIamMemberRemove(logging.LoggingLogViewIamSchema, logging.LoggingLogViewIamUpdaterProducer),
 	"google_logging_log_view_iam_policy":                               tpgiamresource.ResourceIamPolicy(logging.LoggingLogViewIamSchema, logging.LoggingLogViewIamUpdaterProducer, logging.LoggingLogViewIdParseFunc),
 	"google_logging_metric":                                            logging.ResourceLoggingMetric(),
 	"google_logging_organization_settings":                             logging.ResourceLoggingOrganizationSettings(),
@@ -1037,6 +1108,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_network_security_gateway_security_policy_rule":             networksecurity.ResourceNetworkSecurityGatewaySecurityPolicyRule(),
 	"google_network_security_address_group_iam_binding":                tpgiamresource.ResourceIamBinding(networksecurity.NetworkSecurityProjectAddressGroupIamSchema, networksecurity.NetworkSecurityProjectAddressGroupIamUpdaterProducer, networksecurity.NetworkSecurityProjectAddressGroupIdParseFunc),
 	"google_network_security_address_group_iam_member":                 tpgiamresource.ResourceIamMember(networksecurity.NetworkSecurityProjectAddressGroupIamSchema, networksecurity.NetworkSecurityProjectAddressGroupIamUpdaterProducer, networksecurity.NetworkSecurityProjectAddressGroupIdParseFunc),
+	"google_network_security_address_group_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(networksecurity.NetworkSecurityProjectAddressGroupIamSchema, networksecurity.NetworkSecurityProjectAddressGroupIamUpdaterProducer),
 	"google_network_security_address_group_iam_policy":                 tpgiamresource.ResourceIamPolicy(networksecurity.NetworkSecurityProjectAddressGroupIamSchema, networksecurity.NetworkSecurityProjectAddressGroupIamUpdaterProducer, networksecurity.NetworkSecurityProjectAddressGroupIdParseFunc),
 	"google_network_security_security_profile":                         networksecurity.ResourceNetworkSecuritySecurityProfile(),
 	"google_network_security_security_profile_group":                   networksecurity.ResourceNetworkSecuritySecurityProfileGroup(),
@@ -1060,11 +1132,13 @@ var generatedResources = map[string]*schema.Resource{
 	"google_notebooks_instance":                                        notebooks.ResourceNotebooksInstance(),
 	"google_notebooks_instance_iam_binding":                            tpgiamresource.ResourceIamBinding(notebooks.NotebooksInstanceIamSchema, notebooks.NotebooksInstanceIamUpdaterProducer, notebooks.NotebooksInstanceIdParseFunc),
 	"google_notebooks_instance_iam_member":                             tpgiamresource.ResourceIamMember(notebooks.NotebooksInstanceIamSchema, notebooks.NotebooksInstanceIamUpdaterProducer, notebooks.NotebooksInstanceIdParseFunc),
+	"google_notebooks_instance_iam_member_remove":                      tpgiamresource.ResourceIamMemberRemove(notebooks.NotebooksInstanceIamSchema, notebooks.NotebooksInstanceIamUpdaterProducer),
 	"google_notebooks_instance_iam_policy":                             tpgiamresource.ResourceIamPolicy(notebooks.NotebooksInstanceIamSchema, notebooks.NotebooksInstanceIamUpdaterProducer, notebooks.NotebooksInstanceIdParseFunc),
 	"google_notebooks_location":                                        notebooks.ResourceNotebooksLocation(),
 	"google_notebooks_runtime":                                         notebooks.ResourceNotebooksRuntime(),
 	"google_notebooks_runtime_iam_binding":                             tpgiamresource.ResourceIamBinding(notebooks.NotebooksRuntimeIamSchema, notebooks.NotebooksRuntimeIamUpdaterProducer, notebooks.NotebooksRuntimeIdParseFunc),
 	"google_notebooks_runtime_iam_member":                              tpgiamresource.ResourceIamMember(notebooks.NotebooksRuntimeIamSchema, notebooks.NotebooksRuntimeIamUpdaterProducer, notebooks.NotebooksRuntimeIdParseFunc),
+	"google_notebooks_runtime_iam_member_remove":                       tpgiamresource.ResourceIamMemberRemove(notebooks.NotebooksRuntimeIamSchema, notebooks.NotebooksRuntimeIamUpdaterProducer),
 	"google_notebooks_runtime_iam_policy":                              tpgiamresource.ResourceIamPolicy(notebooks.NotebooksRuntimeIamSchema, notebooks.NotebooksRuntimeIamUpdaterProducer, notebooks.NotebooksRuntimeIdParseFunc),
 	"google_org_policy_custom_constraint":                              orgpolicy.ResourceOrgPolicyCustomConstraint(),
 	"google_os_config_guest_policies":                                  osconfig.ResourceOSConfigGuestPolicies(),
@@ -1074,23 +1148,27 @@ var generatedResources = map[string]*schema.Resource{
 	"google_privateca_ca_pool":                                         privateca.ResourcePrivatecaCaPool(),
 	"google_privateca_ca_pool_iam_binding":                             tpgiamresource.ResourceIamBinding(privateca.PrivatecaCaPoolIamSchema, privateca.PrivatecaCaPoolIamUpdaterProducer, privateca.PrivatecaCaPoolIdParseFunc),
 	"google_privateca_ca_pool_iam_member":                              tpgiamresource.ResourceIamMember(privateca.PrivatecaCaPoolIamSchema, privateca.PrivatecaCaPoolIamUpdaterProducer, privateca.PrivatecaCaPoolIdParseFunc),
+	"google_privateca_ca_pool_iam_member_remove":                       tpgiamresource.ResourceIamMemberRemove(privateca.PrivatecaCaPoolIamSchema, privateca.PrivatecaCaPoolIamUpdaterProducer),
 	"google_privateca_ca_pool_iam_policy":                              tpgiamresource.ResourceIamPolicy(privateca.PrivatecaCaPoolIamSchema, privateca.PrivatecaCaPoolIamUpdaterProducer, privateca.PrivatecaCaPoolIdParseFunc),
 	"google_privateca_certificate":                                     privateca.ResourcePrivatecaCertificate(),
 	"google_privateca_certificate_authority":                           privateca.ResourcePrivatecaCertificateAuthority(),
 	"google_privateca_certificate_template":                            privateca.ResourcePrivatecaCertificateTemplate(),
 	"google_privateca_certificate_template_iam_binding":                tpgiamresource.ResourceIamBinding(privateca.PrivatecaCertificateTemplateIamSchema, privateca.PrivatecaCertificateTemplateIamUpdaterProducer, privateca.PrivatecaCertificateTemplateIdParseFunc),
 	"google_privateca_certificate_template_iam_member":                 tpgiamresource.ResourceIamMember(privateca.PrivatecaCertificateTemplateIamSchema, privateca.PrivatecaCertificateTemplateIamUpdaterProducer, privateca.PrivatecaCertificateTemplateIdParseFunc),
+	"google_privateca_certificate_template_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(privateca.PrivatecaCertificateTemplateIamSchema, privateca.PrivatecaCertificateTemplateIamUpdaterProducer),
 	"google_privateca_certificate_template_iam_policy":                 tpgiamresource.ResourceIamPolicy(privateca.PrivatecaCertificateTemplateIamSchema, privateca.PrivatecaCertificateTemplateIamUpdaterProducer, privateca.PrivatecaCertificateTemplateIdParseFunc),
 	"google_privileged_access_manager_entitlement":                     privilegedaccessmanager.ResourcePrivilegedAccessManagerEntitlement(),
 	"google_public_ca_external_account_key":                            publicca.ResourcePublicCAExternalAccountKey(),
 	"google_pubsub_schema":                                             pubsub.ResourcePubsubSchema(),
 	"google_pubsub_schema_iam_binding":                                 tpgiamresource.ResourceIamBinding(pubsub.PubsubSchemaIamSchema, pubsub.PubsubSchemaIamUpdaterProducer, pubsub.PubsubSchemaIdParseFunc),
 	"google_pubsub_schema_iam_member":                                  tpgiamresource.ResourceIamMember(pubsub.PubsubSchemaIamSchema, pubsub.PubsubSchemaIamUpdaterProducer, pubsub.PubsubSchemaIdParseFunc),
+	"google_pubsub_schema_iam_member_remove":                           tpgiamresource.ResourceIamMemberRemove(pubsub.PubsubSchemaIamSchema, pubsub.PubsubSchemaIamUpdaterProducer),
 	"google_pubsub_schema_iam_policy":                                  tpgiamresource.ResourceIamPolicy(pubsub.PubsubSchemaIamSchema, pubsub.PubsubSchemaIamUpdaterProducer, pubsub.PubsubSchemaIdParseFunc),
 	"google_pubsub_subscription":                                       pubsub.ResourcePubsubSubscription(),
 	"google_pubsub_topic":                                              pubsub.ResourcePubsubTopic(),
 	"google_pubsub_topic_iam_binding":                                  tpgiamresource.ResourceIamBinding(pubsub.PubsubTopicIamSchema, pubsub.PubsubTopicIamUpdaterProducer, pubsub.PubsubTopicIdParseFunc),
 	"google_pubsub_topic_iam_member":                                   tpgiamresource.ResourceIamMember(pubsub.PubsubTopicIamSchema, pubsub.PubsubTopicIamUpdaterProducer, pubsub.PubsubTopicIdParseFunc),
+	"google_pubsub_topic_iam_member_remove":                            tpgiamresource.ResourceIamMemberRemove(pubsub.PubsubTopicIamSchema, pubsub.PubsubTopicIamUpdaterProducer),
 	"google_pubsub_topic_iam_policy":                                   tpgiamresource.ResourceIamPolicy(pubsub.PubsubTopicIamSchema, pubsub.PubsubTopicIamUpdaterProducer, pubsub.PubsubTopicIdParseFunc),
 	"google_pubsub_lite_reservation":                                   pubsublite.ResourcePubsubLiteReservation(),
 	"google_pubsub_lite_subscription":                                  pubsublite.ResourcePubsubLiteSubscription(),
@@ -1100,15 +1178,18 @@ var generatedResources = map[string]*schema.Resource{
 	"google_resource_manager_lien":                                     resourcemanager.ResourceResourceManagerLien(),
 	"google_runtimeconfig_config_iam_binding":                          tpgiamresource.ResourceIamBinding(runtimeconfig.RuntimeConfigConfigIamSchema, runtimeconfig.RuntimeConfigConfigIamUpdaterProducer, runtimeconfig.RuntimeConfigConfigIdParseFunc),
 	"google_runtimeconfig_config_iam_member":                           tpgiamresource.ResourceIamMember(runtimeconfig.RuntimeConfigConfigIamSchema, runtimeconfig.RuntimeConfigConfigIamUpdaterProducer, runtimeconfig.RuntimeConfigConfigIdParseFunc),
+	"google_runtimeconfig_config_iam_member_remove":                    tpgiamresource.ResourceIamMemberRemove(runtimeconfig.RuntimeConfigConfigIamSchema, runtimeconfig.RuntimeConfigConfigIamUpdaterProducer),
 	"google_runtimeconfig_config_iam_policy":                           tpgiamresource.ResourceIamPolicy(runtimeconfig.RuntimeConfigConfigIamSchema, runtimeconfig.RuntimeConfigConfigIamUpdaterProducer, runtimeconfig.RuntimeConfigConfigIdParseFunc),
 	"google_secret_manager_secret":                                     secretmanager.ResourceSecretManagerSecret(),
 	"google_secret_manager_secret_iam_binding":                         tpgiamresource.ResourceIamBinding(secretmanager.SecretManagerSecretIamSchema, secretmanager.SecretManagerSecretIamUpdaterProducer, secretmanager.SecretManagerSecretIdParseFunc),
 	"google_secret_manager_secret_iam_member":                          tpgiamresource.ResourceIamMember(secretmanager.SecretManagerSecretIamSchema, secretmanager.SecretManagerSecretIamUpdaterProducer, secretmanager.SecretManagerSecretIdParseFunc),
+	"google_secret_manager_secret_iam_member_remove":                   tpgiamresource.ResourceIamMemberRemove(secretmanager.SecretManagerSecretIamSchema, secretmanager.SecretManagerSecretIamUpdaterProducer),
 	"google_secret_manager_secret_iam_policy":                          tpgiamresource.ResourceIamPolicy(secretmanager.SecretManagerSecretIamSchema, secretmanager.SecretManagerSecretIamUpdaterProducer, secretmanager.SecretManagerSecretIdParseFunc),
 	"google_secret_manager_secret_version":                             secretmanager.ResourceSecretManagerSecretVersion(),
 	"google_secure_source_manager_instance":                            securesourcemanager.ResourceSecureSourceManagerInstance(),
 	"google_secure_source_manager_instance_iam_binding":                tpgiamresource.ResourceIamBinding(securesourcemanager.SecureSourceManagerInstanceIamSchema, securesourcemanager.SecureSourceManagerInstanceIamUpdaterProducer, securesourcemanager.SecureSourceManagerInstanceIdParseFunc),
 	"google_secure_source_manager_instance_iam_member":                 tpgiamresource.ResourceIamMember(securesourcemanager.SecureSourceManagerInstanceIamSchema, securesourcemanager.SecureSourceManagerInstanceIamUpdaterProducer, securesourcemanager.SecureSourceManagerInstanceIdParseFunc),
+	"google_secure_source_manager_instance_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(securesourcemanager.SecureSourceManagerInstanceIamSchema, securesourcemanager.SecureSourceManagerInstanceIamUpdaterProducer),
 	"google_secure_source_manager_instance_iam_policy":                 tpgiamresource.ResourceIamPolicy(securesourcemanager.SecureSourceManagerInstanceIamSchema, securesourcemanager.SecureSourceManagerInstanceIamUpdaterProducer, securesourcemanager.SecureSourceManagerInstanceIdParseFunc),
 	"google_scc_event_threat_detection_custom_module":                  securitycenter.ResourceSecurityCenterEventThreatDetectionCustomModule(),
 	"google_scc_folder_custom_module":                                  securitycenter.ResourceSecurityCenterFolderCustomModule(),
@@ -1119,6 +1200,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_scc_source":                                                securitycenter.ResourceSecurityCenterSource(),
 	"google_scc_source_iam_binding":                                    tpgiamresource.ResourceIamBinding(securitycenter.SecurityCenterSourceIamSchema, securitycenter.SecurityCenterSourceIamUpdaterProducer, securitycenter.SecurityCenterSourceIdParseFunc),
 	"google_scc_source_iam_member":                                     tpgiamresource.ResourceIamMember(securitycenter.SecurityCenterSourceIamSchema, securitycenter.SecurityCenterSourceIamUpdaterProducer, securitycenter.SecurityCenterSourceIdParseFunc),
+	"google_scc_source_iam_member_remove":                              tpgiamresource.ResourceIamMemberRemove(securitycenter.SecurityCenterSourceIamSchema, securitycenter.SecurityCenterSourceIamUpdaterProducer),
 	"google_scc_source_iam_policy":                                     tpgiamresource.ResourceIamPolicy(securitycenter.SecurityCenterSourceIamSchema, securitycenter.SecurityCenterSourceIamUpdaterProducer, securitycenter.SecurityCenterSourceIdParseFunc),
 	"google_securityposture_posture":                                   securityposture.ResourceSecurityposturePosture(),
 	"google_securityposture_posture_deployment":                        securityposture.ResourceSecurityposturePostureDeployment(),
@@ -1127,21 +1209,26 @@ var generatedResources = map[string]*schema.Resource{
 	"google_service_directory_namespace":                               servicedirectory.ResourceServiceDirectoryNamespace(),
 	"google_service_directory_namespace_iam_binding":                   tpgiamresource.ResourceIamBinding(servicedirectory.ServiceDirectoryNamespaceIamSchema, servicedirectory.ServiceDirectoryNamespaceIamUpdaterProducer, servicedirectory.ServiceDirectoryNamespaceIdParseFunc),
 	"google_service_directory_namespace_iam_member":                    tpgiamresource.ResourceIamMember(servicedirectory.ServiceDirectoryNamespaceIamSchema, servicedirectory.ServiceDirectoryNamespaceIamUpdaterProducer, servicedirectory.ServiceDirectoryNamespaceIdParseFunc),
+	"google_service_directory_namespace_iam_member_remove":             tpgiamresource.ResourceIamMemberRemove(servicedirectory.ServiceDirectoryNamespaceIamSchema, servicedirectory.ServiceDirectoryNamespaceIamUpdaterProducer),
 	"google_service_directory_namespace_iam_policy":                    tpgiamresource.ResourceIamPolicy(servicedirectory.ServiceDirectoryNamespaceIamSchema, servicedirectory.ServiceDirectoryNamespaceIamUpdaterProducer, servicedirectory.ServiceDirectoryNamespaceIdParseFunc),
 	"google_service_directory_service":                                 servicedirectory.ResourceServiceDirectoryService(),
 	"google_service_directory_service_iam_binding":                     tpgiamresource.ResourceIamBinding(servicedirectory.ServiceDirectoryServiceIamSchema, servicedirectory.ServiceDirectoryServiceIamUpdaterProducer, servicedirectory.ServiceDirectoryServiceIdParseFunc),
 	"google_service_directory_service_iam_member":                      tpgiamresource.ResourceIamMember(servicedirectory.ServiceDirectoryServiceIamSchema, servicedirectory.ServiceDirectoryServiceIamUpdaterProducer, servicedirectory.ServiceDirectoryServiceIdParseFunc),
+	"google_service_directory_service_iam_member_remove":               tpgiamresource.ResourceIamMemberRemove(servicedirectory.ServiceDirectoryServiceIamSchema, servicedirectory.ServiceDirectoryServiceIamUpdaterProducer),
 	"google_service_directory_service_iam_policy":                      tpgiamresource.ResourceIamPolicy(servicedirectory.ServiceDirectoryServiceIamSchema, servicedirectory.ServiceDirectoryServiceIamUpdaterProducer, servicedirectory.ServiceDirectoryServiceIdParseFunc),
 	"google_endpoints_service_iam_binding":                             tpgiamresource.ResourceIamBinding(servicemanagement.ServiceManagementServiceIamSchema, servicemanagement.ServiceManagementServiceIamUpdaterProducer, servicemanagement.ServiceManagementServiceIdParseFunc),
 	"google_endpoints_service_iam_member":                              tpgiamresource.ResourceIamMember(servicemanagement.ServiceManagementServiceIamSchema, servicemanagement.ServiceManagementServiceIamUpdaterProducer, servicemanagement.ServiceManagementServiceIdParseFunc),
+	"google_endpoints_service_iam_member_remove":                       tpgiamresource.ResourceIamMemberRemove(servicemanagement.ServiceManagementServiceIamSchema, servicemanagement.ServiceManagementServiceIamUpdaterProducer),
 	"google_endpoints_service_iam_policy":                              tpgiamresource.ResourceIamPolicy(servicemanagement.ServiceManagementServiceIamSchema, servicemanagement.ServiceManagementServiceIamUpdaterProducer, servicemanagement.ServiceManagementServiceIdParseFunc),
 	"google_endpoints_service_consumers_iam_binding":                   tpgiamresource.ResourceIamBinding(servicemanagement.ServiceManagementServiceConsumersIamSchema, servicemanagement.ServiceManagementServiceConsumersIamUpdaterProducer, servicemanagement.ServiceManagementServiceConsumersIdParseFunc),
 	"google_endpoints_service_consumers_iam_member":                    tpgiamresource.ResourceIamMember(servicemanagement.ServiceManagementServiceConsumersIamSchema, servicemanagement.ServiceManagementServiceConsumersIamUpdaterProducer, servicemanagement.ServiceManagementServiceConsumersIdParseFunc),
+	"google_endpoints_service_consumers_iam_member_remove":             tpgiamresource.ResourceIamMemberRemove(servicemanagement.ServiceManagementServiceConsumersIamSchema, servicemanagement.ServiceManagementServiceConsumersIamUpdaterProducer),
 	"google_endpoints_service_consumers_iam_policy":                    tpgiamresource.ResourceIamPolicy(servicemanagement.ServiceManagementServiceConsumersIamSchema, servicemanagement.ServiceManagementServiceConsumersIamUpdaterProducer, servicemanagement.ServiceManagementServiceConsumersIdParseFunc),
 	"google_service_usage_consumer_quota_override":                     serviceusage.ResourceServiceUsageConsumerQuotaOverride(),
 	"google_sourcerepo_repository":                                     sourcerepo.ResourceSourceRepoRepository(),
 	"google_sourcerepo_repository_iam_binding":                         tpgiamresource.ResourceIamBinding(sourcerepo.SourceRepoRepositoryIamSchema, sourcerepo.SourceRepoRepositoryIamUpdaterProducer, sourcerepo.SourceRepoRepositoryIdParseFunc),
 	"google_sourcerepo_repository_iam_member":                          tpgiamresource.ResourceIamMember(sourcerepo.SourceRepoRepositoryIamSchema, sourcerepo.SourceRepoRepositoryIamUpdaterProducer, sourcerepo.SourceRepoRepositoryIdParseFunc),
+	"google_sourcerepo_repository_iam_member_remove":                   tpgiamresource.ResourceIamMemberRemove(sourcerepo.SourceRepoRepositoryIamSchema, sourcerepo.SourceRepoRepositoryIamUpdaterProducer),
 	"google_sourcerepo_repository_iam_policy":                          tpgiamresource.ResourceIamPolicy(sourcerepo.SourceRepoRepositoryIamSchema, sourcerepo.SourceRepoRepositoryIamUpdaterProducer, sourcerepo.SourceRepoRepositoryIdParseFunc),
 	"google_spanner_database":                                          spanner.ResourceSpannerDatabase(),
 	"google_spanner_instance":                                          spanner.ResourceSpannerInstance(),
@@ -1149,10 +1236,17 @@ var generatedResources = map[string]*schema.Resource{
 	"google_sql_source_representation_instance":                        sql.ResourceSQLSourceRepresentationInstance(),
 	"google_storage_bucket_iam_binding":                                tpgiamresource.ResourceIamBinding(storage.StorageBucketIamSchema, storage.StorageBucketIamUpdaterProducer, storage.StorageBucketIdParseFunc),
 	"google_storage_bucket_iam_member":                                 tpgiamresource.ResourceIamMember(storage.StorageBucketIamSchema, storage.StorageBucketIamUpdaterProducer, storage.StorageBucketIdParseFunc),
+	"google_storage_bucket_iam_member_remove":                          tpgiamresource.ResourceIamMemberRemove(storage.StorageBucketIamSchema, storage.StorageBucketIamUpdaterProducer),
 	"google_storage_bucket_iam_policy":                                 tpgiamresource.ResourceIamPolicy(storage.StorageBucketIamSchema, storage.StorageBucketIamUpdaterProducer, storage.StorageBucketIdParseFunc),
 	"google_storage_bucket_access_control":                             storage.ResourceStorageBucketAccessControl(),
 	"google_storage_default_object_access_control":                     storage.ResourceStorageDefaultObjectAccessControl(),
+	"google_storage_folder":                                            storage.ResourceStorageFolder(),
 	"google_storage_hmac_key":                                          storage.ResourceStorageHmacKey(),
+	"google_storage_managed_folder":                                    storage.ResourceStorageManagedFolder(),
+	"google_storage_managed_folder_iam_binding":                        tpgiamresource.ResourceIamBinding(storage.StorageManagedFolderIamSchema, storage.StorageManagedFolderIamUpdaterProducer, storage.StorageManagedFolderIdParseFunc),
+	"google_storage_managed_folder_iam_member":                         tpgiamresource.ResourceIamMember(storage.StorageManagedFolderIamSchema, storage.StorageManagedFolderIamUpdaterProducer, storage.StorageManagedFolderIdParseFunc),
+	"google_storage_managed_folder_iam_member_remove":                  tpgiamresource.ResourceIamMemberRemove(storage.StorageManagedFolderIamSchema, storage.StorageManagedFolderIamUpdaterProducer),
+	"google_storage_managed_folder_iam_policy":                         tpgiamresource.ResourceIamPolicy(storage.StorageManagedFolderIamSchema, storage.StorageManagedFolderIamUpdaterProducer, storage.StorageManagedFolderIdParseFunc),
 	"google_storage_object_access_control":                             storage.ResourceStorageObjectAccessControl(),
 	"google_storage_insights_report_config":                            storageinsights.ResourceStorageInsightsReportConfig(),
 	"google_storage_transfer_agent_pool":                               storagetransfer.ResourceStorageTransferAgentPool(),
@@ -1160,10 +1254,12 @@ var generatedResources = map[string]*schema.Resource{
 	"google_tags_tag_key":                                              tags.ResourceTagsTagKey(),
 	"google_tags_tag_key_iam_binding":                                  tpgiamresource.ResourceIamBinding(tags.TagsTagKeyIamSchema, tags.TagsTagKeyIamUpdaterProducer, tags.TagsTagKeyIdParseFunc),
 	"google_tags_tag_key_iam_member":                                   tpgiamresource.ResourceIamMember(tags.TagsTagKeyIamSchema, tags.TagsTagKeyIamUpdaterProducer, tags.TagsTagKeyIdParseFunc),
+	"google_tags_tag_key_iam_member_remove":                            tpgiamresource.ResourceIamMemberRemove(tags.TagsTagKeyIamSchema, tags.TagsTagKeyIamUpdaterProducer),
 	"google_tags_tag_key_iam_policy":                                   tpgiamresource.ResourceIamPolicy(tags.TagsTagKeyIamSchema, tags.TagsTagKeyIamUpdaterProducer, tags.TagsTagKeyIdParseFunc),
 	"google_tags_tag_value":                                            tags.ResourceTagsTagValue(),
 	"google_tags_tag_value_iam_binding":                                tpgiamresource.ResourceIamBinding(tags.TagsTagValueIamSchema, tags.TagsTagValueIamUpdaterProducer, tags.TagsTagValueIdParseFunc),
 	"google_tags_tag_value_iam_member":                                 tpgiamresource.ResourceIamMember(tags.TagsTagValueIamSchema, tags.TagsTagValueIamUpdaterProducer, tags.TagsTagValueIdParseFunc),
+	"google_tags_tag_value_iam_member_remove":                          tpgiamresource.ResourceIamMemberRemove(tags.TagsTagValueIamSchema, tags.TagsTagValueIamUpdaterProducer),
 	"google_tags_tag_value_iam_policy":                                 tpgiamresource.ResourceIamPolicy(tags.TagsTagValueIamSchema, tags.TagsTagValueIamUpdaterProducer, tags.TagsTagValueIdParseFunc),
 	"google_tpu_node":                                                  tpu.ResourceTPUNode(),
 	"google_tpu_v2_vm":                                                 tpuv2.ResourceTpuV2Vm(),
@@ -1172,6 +1268,7 @@ var generatedResources = map[string]*schema.Resource{
 	"google_vertex_ai_endpoint":                                        vertexai.ResourceVertexAIEndpoint(),
 	"google_vertex_ai_endpoint_iam_binding":                            tpgiamresource.ResourceIamBinding(vertexai.VertexAIEndpointIamSchema, vertexai.VertexAIEndpointIamUpdaterProducer, vertexai.VertexAIEndpointIdParseFunc),
 	"google_vertex_ai_endpoint_iam_member":                             tpgiamresource.ResourceIamMember(vertexai.VertexAIEndpointIamSchema, vertexai.VertexAIEndpointIamUpdaterProducer, vertexai.VertexAIEndpointIdParseFunc),
+	"google_vertex_ai_endpoint_iam_member_remove":                      tpgiamresource.ResourceIamMemberRemove(vertexai.VertexAIEndpointIamSchema, vertexai.VertexAIEndpointIamUpdaterProducer),
 	"google_vertex_ai_endpoint_iam_policy":                             tpgiamresource.ResourceIamPolicy(vertexai.VertexAIEndpointIamSchema, vertexai.VertexAIEndpointIamUpdaterProducer, vertexai.VertexAIEndpointIdParseFunc),
 	"google_vertex_ai_feature_group":                                   vertexai.ResourceVertexAIFeatureGroup(),
 	"google_vertex_ai_feature_group_feature":                           vertexai.ResourceVertexAIFeatureGroupFeature(),
@@ -1180,10 +1277,12 @@ var generatedResources = map[string]*schema.Resource{
 	"google_vertex_ai_featurestore":                                    vertexai.ResourceVertexAIFeaturestore(),
 	"google_vertex_ai_featurestore_iam_binding":                        tpgiamresource.ResourceIamBinding(vertexai.VertexAIFeaturestoreIamSchema, vertexai.VertexAIFeaturestoreIamUpdaterProducer, vertexai.VertexAIFeaturestoreIdParseFunc),
 	"google_vertex_ai_featurestore_iam_member":                         tpgiamresource.ResourceIamMember(vertexai.VertexAIFeaturestoreIamSchema, vertexai.VertexAIFeaturestoreIamUpdaterProducer, vertexai.VertexAIFeaturestoreIdParseFunc),
+	"google_vertex_ai_featurestore_iam_member_remove":                  tpgiamresource.ResourceIamMemberRemove(vertexai.VertexAIFeaturestoreIamSchema, vertexai.VertexAIFeaturestoreIamUpdaterProducer),
 	"google_vertex_ai_featurestore_iam_policy":                         tpgiamresource.ResourceIamPolicy(vertexai.VertexAIFeaturestoreIamSchema, vertexai.VertexAIFeaturestoreIamUpdaterProducer, vertexai.VertexAIFeaturestoreIdParseFunc),
 	"google_vertex_ai_featurestore_entitytype":                         vertexai.ResourceVertexAIFeaturestoreEntitytype(),
 	"google_vertex_ai_featurestore_entitytype_iam_binding":             tpgiamresource.ResourceIamBinding(vertexai.VertexAIFeaturestoreEntitytypeIamSchema, vertexai.VertexAIFeaturestoreEntitytypeIamUpdaterProducer, vertexai.VertexAIFeaturestoreEntitytypeIdParseFunc),
 	"google_vertex_ai_featurestore_entitytype_iam_member":              tpgiamresource.ResourceIamMember(vertexai.VertexAIFeaturestoreEntitytypeIamSchema, vertexai.VertexAIFeaturestoreEntitytypeIamUpdaterProducer, vertexai.VertexAIFeaturestoreEntitytypeIdParseFunc),
+	"google_vertex_ai_featurestore_entitytype_iam_member_remove":       tpgiamresource.ResourceIamMemberRemove(vertexai.VertexAIFeaturestoreEntitytypeIamSchema, vertexai.VertexAIFeaturestoreEntitytypeIamUpdaterProducer),
 	"google_vertex_ai_featurestore_entitytype_iam_policy":              tpgiamresource.ResourceIamPolicy(vertexai.VertexAIFeaturestoreEntitytypeIamSchema, vertexai.VertexAIFeaturestoreEntitytypeIamUpdaterProducer, vertexai.VertexAIFeaturestoreEntitytypeIdParseFunc),
 	"google_vertex_ai_featurestore_entitytype_feature":                 vertexai.ResourceVertexAIFeaturestoreEntitytypeFeature(),
 	"google_vertex_ai_index":                                           vertexai.ResourceVertexAIIndex(),
@@ -1202,16 +1301,19 @@ var generatedResources = map[string]*schema.Resource{
 	"google_workbench_instance":                                        workbench.ResourceWorkbenchInstance(),
 	"google_workbench_instance_iam_binding":                            tpgiamresource.ResourceIamBinding(workbench.WorkbenchInstanceIamSchema, workbench.WorkbenchInstanceIamUpdaterProducer, workbench.WorkbenchInstanceIdParseFunc),
 	"google_workbench_instance_iam_member":                             tpgiamresource.ResourceIamMember(workbench.WorkbenchInstanceIamSchema, workbench.WorkbenchInstanceIamUpdaterProducer, workbench.WorkbenchInstanceIdParseFunc),
+	"google_workbench_instance_iam_member_remove":                      tpgiamresource.ResourceIamMemberRemove(workbench.WorkbenchInstanceIamSchema, workbench.WorkbenchInstanceIamUpdaterProducer),
 	"google_workbench_instance_iam_policy":                             tpgiamresource.ResourceIamPolicy(workbench.WorkbenchInstanceIamSchema, workbench.WorkbenchInstanceIamUpdaterProducer, workbench.WorkbenchInstanceIdParseFunc),
 	"google_workflows_workflow":                                        workflows.ResourceWorkflowsWorkflow(),
 	"google_workstations_workstation":                                  workstations.ResourceWorkstationsWorkstation(),
 	"google_workstations_workstation_iam_binding":                      tpgiamresource.ResourceIamBinding(workstations.WorkstationsWorkstationIamSchema, workstations.WorkstationsWorkstationIamUpdaterProducer, workstations.WorkstationsWorkstationIdParseFunc),
 	"google_workstations_workstation_iam_member":                       tpgiamresource.ResourceIamMember(workstations.WorkstationsWorkstationIamSchema, workstations.WorkstationsWorkstationIamUpdaterProducer, workstations.WorkstationsWorkstationIdParseFunc),
+	"google_workstations_workstation_iam_member_remove":                tpgiamresource.ResourceIamMemberRemove(workstations.WorkstationsWorkstationIamSchema, workstations.WorkstationsWorkstationIamUpdaterProducer),
 	"google_workstations_workstation_iam_policy":                       tpgiamresource.ResourceIamPolicy(workstations.WorkstationsWorkstationIamSchema, workstations.WorkstationsWorkstationIamUpdaterProducer, workstations.WorkstationsWorkstationIdParseFunc),
 	"google_workstations_workstation_cluster":                          workstations.ResourceWorkstationsWorkstationCluster(),
 	"google_workstations_workstation_config":                           workstations.ResourceWorkstationsWorkstationConfig(),
 	"google_workstations_workstation_config_iam_binding":               tpgiamresource.ResourceIamBinding(workstations.WorkstationsWorkstationConfigIamSchema, workstations.WorkstationsWorkstationConfigIamUpdaterProducer, workstations.WorkstationsWorkstationConfigIdParseFunc),
 	"google_workstations_workstation_config_iam_member":                tpgiamresource.ResourceIamMember(workstations.WorkstationsWorkstationConfigIamSchema, workstations.WorkstationsWorkstationConfigIamUpdaterProducer, workstations.WorkstationsWorkstationConfigIdParseFunc),
+	"google_workstations_workstation_config_iam_member_remove":         tpgiamresource.ResourceIamMemberRemove(workstations.WorkstationsWorkstationConfigIamSchema, workstations.WorkstationsWorkstationConfigIamUpdaterProducer),
 	"google_workstations_workstation_config_iam_policy":                tpgiamresource.ResourceIamPolicy(workstations.WorkstationsWorkstationConfigIamSchema, workstations.WorkstationsWorkstationConfigIamUpdaterProducer, workstations.WorkstationsWorkstationConfigIdParseFunc),
 }
 
@@ -1280,6 +1382,7 @@ var handwrittenResources = map[string]*schema.Resource{
 	"google_project_service_identity":               resourcemanager.ResourceProjectServiceIdentity(),
 	"google_service_networking_connection":          servicenetworking.ResourceServiceNetworkingConnection(),
 	"google_sql_database_instance":                  sql.ResourceSqlDatabaseInstance(),
+	"google_sql_database_instance_switchover":       sql.ResourceSqlDatabaseInstanceSwitchover(),
 	"google_sql_ssl_cert":                           sql.ResourceSqlSslCert(),
 	"google_sql_user":                               sql.ResourceSqlUser(),
 	"google_organization_iam_custom_role":           resourcemanager.ResourceGoogleOrganizationIamCustomRole(),
@@ -1288,7 +1391,6 @@ var handwrittenResources = map[string]*schema.Resource{
 	"google_project_default_service_accounts":       resourcemanager.ResourceGoogleProjectDefaultServiceAccounts(),
 	"google_project_service":                        resourcemanager.ResourceGoogleProjectService(),
 	"google_project_iam_custom_role":                resourcemanager.ResourceGoogleProjectIamCustomRole(),
-	"google_project_iam_member_remove":              resourcemanager.ResourceGoogleProjectIamMemberRemove(),
 	"google_project_organization_policy":            resourcemanager.ResourceGoogleProjectOrganizationPolicy(),
 	"google_project_usage_export_bucket":            compute.ResourceProjectUsageBucket(),
 	"google_runtimeconfig_config":                   runtimeconfig.ResourceRuntimeconfigConfig(),
@@ -1299,6 +1401,7 @@ var handwrittenResources = map[string]*schema.Resource{
 	"google_storage_bucket":                         storage.ResourceStorageBucket(),
 	"google_storage_bucket_acl":                     storage.ResourceStorageBucketAcl(),
 	"google_storage_bucket_object":                  storage.ResourceStorageBucketObject(),
+	"google_storage_bucket_objects_sync":            storage.ResourceStorageBucketObjectsSync(),
 	"google_storage_object_acl":                     storage.ResourceStorageObjectAcl(),
 	"google_storage_default_object_acl":             storage.ResourceStorageDefaultObjectAcl(),
 	"google_storage_notification":                   storage.ResourceStorageNotification(),
@@ -1309,65 +1412,84 @@ var handwrittenResources = map[string]*schema.Resource{
 
 var handwrittenIAMResources = map[string]*schema.Resource{
 	// ####### START non-generated IAM resources ###########
-	"google_bigtable_instance_iam_binding":       tpgiamresource.ResourceIamBinding(bigtable.IamBigtableInstanceSchema, bigtable.NewBigtableInstanceUpdater, bigtable.BigtableInstanceIdParseFunc),
-	"google_bigtable_instance_iam_member":        tpgiamresource.ResourceIamMember(bigtable.IamBigtableInstanceSchema, bigtable.NewBigtableInstanceUpdater, bigtable.BigtableInstanceIdParseFunc),
-	"google_bigtable_instance_iam_policy":        tpgiamresource.ResourceIamPolicy(bigtable.IamBigtableInstanceSchema, bigtable.NewBigtableInstanceUpdater, bigtable.BigtableInstanceIdParseFunc),
-	"google_bigtable_table_iam_binding":          tpgiamresource.ResourceIamBinding(bigtable.IamBigtableTableSchema, bigtable.NewBigtableTableUpdater, bigtable.BigtableTableIdParseFunc),
-	"google_bigtable_table_iam_member":           tpgiamresource.ResourceIamMember(bigtable.IamBigtableTableSchema, bigtable.NewBigtableTableUpdater, bigtable.BigtableTableIdParseFunc),
-	"google_bigtable_table_iam_policy":           tpgiamresource.ResourceIamPolicy(bigtable.IamBigtableTableSchema, bigtable.NewBigtableTableUpdater, bigtable.BigtableTableIdParseFunc),
-	"google_bigquery_dataset_iam_binding":        tpgiamresource.ResourceIamBinding(bigquery.IamBigqueryDatasetSchema, bigquery.NewBigqueryDatasetIamUpdater, bigquery.BigqueryDatasetIdParseFunc),
-	"google_bigquery_dataset_iam_member":         tpgiamresource.ResourceIamMember(bigquery.IamBigqueryDatasetSchema, bigquery.NewBigqueryDatasetIamUpdater, bigquery.BigqueryDatasetIdParseFunc),
-	"google_bigquery_dataset_iam_policy":         tpgiamresource.ResourceIamPolicy(bigquery.IamBigqueryDatasetSchema, bigquery.NewBigqueryDatasetIamUpdater, bigquery.BigqueryDatasetIdParseFunc),
-	"google_billing_account_iam_binding":         tpgiamresource.ResourceIamBinding(billing.IamBillingAccountSchema, billing.NewBillingAccountIamUpdater, billing.BillingAccountIdParseFunc),
-	"google_billing_account_iam_member":          tpgiamresource.ResourceIamMember(billing.IamBillingAccountSchema, billing.NewBillingAccountIamUpdater, billing.BillingAccountIdParseFunc),
-	"google_billing_account_iam_policy":          tpgiamresource.ResourceIamPolicy(billing.IamBillingAccountSchema, billing.NewBillingAccountIamUpdater, billing.BillingAccountIdParseFunc),
-	"google_dataproc_cluster_iam_binding":        tpgiamresource.ResourceIamBinding(dataproc.IamDataprocClusterSchema, dataproc.NewDataprocClusterUpdater, dataproc.DataprocClusterIdParseFunc),
-	"google_dataproc_cluster_iam_member":         tpgiamresource.ResourceIamMember(dataproc.IamDataprocClusterSchema, dataproc.NewDataprocClusterUpdater, dataproc.DataprocClusterIdParseFunc),
-	"google_dataproc_cluster_iam_policy":         tpgiamresource.ResourceIamPolicy(dataproc.IamDataprocClusterSchema, dataproc.NewDataprocClusterUpdater, dataproc.DataprocClusterIdParseFunc),
-	"google_dataproc_job_iam_binding":            tpgiamresource.ResourceIamBinding(dataproc.IamDataprocJobSchema, dataproc.NewDataprocJobUpdater, dataproc.DataprocJobIdParseFunc),
-	"google_dataproc_job_iam_member":             tpgiamresource.ResourceIamMember(dataproc.IamDataprocJobSchema, dataproc.NewDataprocJobUpdater, dataproc.DataprocJobIdParseFunc),
-	"google_dataproc_job_iam_policy":             tpgiamresource.ResourceIamPolicy(dataproc.IamDataprocJobSchema, dataproc.NewDataprocJobUpdater, dataproc.DataprocJobIdParseFunc),
-	"google_folder_iam_binding":                  tpgiamresource.ResourceIamBinding(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater, resourcemanager.FolderIdParseFunc),
-	"google_folder_iam_member":                   tpgiamresource.ResourceIamMember(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater, resourcemanager.FolderIdParseFunc),
-	"google_folder_iam_policy":                   tpgiamresource.ResourceIamPolicy(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater, resourcemanager.FolderIdParseFunc),
-	"google_folder_iam_audit_config":             tpgiamresource.ResourceIamAuditConfig(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater, resourcemanager.FolderIdParseFunc),
-	"google_healthcare_dataset_iam_binding":      tpgiamresource.ResourceIamBinding(healthcare.IamHealthcareDatasetSchema, healthcare.NewHealthcareDatasetIamUpdater, healthcare.DatasetIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_healthcare_dataset_iam_member":       tpgiamresource.ResourceIamMember(healthcare.IamHealthcareDatasetSchema, healthcare.NewHealthcareDatasetIamUpdater, healthcare.DatasetIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_healthcare_dataset_iam_policy":       tpgiamresource.ResourceIamPolicy(healthcare.IamHealthcareDatasetSchema, healthcare.NewHealthcareDatasetIamUpdater, healthcare.DatasetIdParseFunc),
-	"google_healthcare_dicom_store_iam_binding":  tpgiamresource.ResourceIamBinding(healthcare.IamHealthcareDicomStoreSchema, healthcare.NewHealthcareDicomStoreIamUpdater, healthcare.DicomStoreIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_healthcare_dicom_store_iam_member":   tpgiamresource.ResourceIamMember(healthcare.IamHealthcareDicomStoreSchema, healthcare.NewHealthcareDicomStoreIamUpdater, healthcare.DicomStoreIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_healthcare_dicom_store_iam_policy":   tpgiamresource.ResourceIamPolicy(healthcare.IamHealthcareDicomStoreSchema, healthcare.NewHealthcareDicomStoreIamUpdater, healthcare.DicomStoreIdParseFunc),
-	"google_healthcare_fhir_store_iam_binding":   tpgiamresource.ResourceIamBinding(healthcare.IamHealthcareFhirStoreSchema, healthcare.NewHealthcareFhirStoreIamUpdater, healthcare.FhirStoreIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_healthcare_fhir_store_iam_member":    tpgiamresource.ResourceIamMember(healthcare.IamHealthcareFhirStoreSchema, healthcare.NewHealthcareFhirStoreIamUpdater, healthcare.FhirStoreIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_healthcare_fhir_store_iam_policy":    tpgiamresource.ResourceIamPolicy(healthcare.IamHealthcareFhirStoreSchema, healthcare.NewHealthcareFhirStoreIamUpdater, healthcare.FhirStoreIdParseFunc),
-	"google_healthcare_hl7_v2_store_iam_binding": tpgiamresource.ResourceIamBinding(healthcare.IamHealthcareHl7V2StoreSchema, healthcare.NewHealthcareHl7V2StoreIamUpdater, healthcare.Hl7V2StoreIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_healthcare_hl7_v2_store_iam_member":  tpgiamresource.ResourceIamMember(healthcare.IamHealthcareHl7V2StoreSchema, healthcare.NewHealthcareHl7V2StoreIamUpdater, healthcare.Hl7V2StoreIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_healthcare_hl7_v2_store_iam_policy":  tpgiamresource.ResourceIamPolicy(healthcare.IamHealthcareHl7V2StoreSchema, healthcare.NewHealthcareHl7V2StoreIamUpdater, healthcare.Hl7V2StoreIdParseFunc),
-	"google_kms_key_ring_iam_binding":            tpgiamresource.ResourceIamBinding(kms.IamKmsKeyRingSchema, kms.NewKmsKeyRingIamUpdater, kms.KeyRingIdParseFunc),
-	"google_kms_key_ring_iam_member":             tpgiamresource.ResourceIamMember(kms.IamKmsKeyRingSchema, kms.NewKmsKeyRingIamUpdater, kms.KeyRingIdParseFunc),
-	"google_kms_key_ring_iam_policy":             tpgiamresource.ResourceIamPolicy(kms.IamKmsKeyRingSchema, kms.NewKmsKeyRingIamUpdater, kms.KeyRingIdParseFunc),
-	"google_kms_crypto_key_iam_binding":          tpgiamresource.ResourceIamBinding(kms.IamKmsCryptoKeySchema, kms.NewKmsCryptoKeyIamUpdater, kms.CryptoIdParseFunc),
-	"google_kms_crypto_key_iam_member":           tpgiamresource.ResourceIamMember(kms.IamKmsCryptoKeySchema, kms.NewKmsCryptoKeyIamUpdater, kms.CryptoIdParseFunc),
-	"google_kms_crypto_key_iam_policy":           tpgiamresource.ResourceIamPolicy(kms.IamKmsCryptoKeySchema, kms.NewKmsCryptoKeyIamUpdater, kms.CryptoIdParseFunc),
-	"google_spanner_instance_iam_binding":        tpgiamresource.ResourceIamBinding(spanner.IamSpannerInstanceSchema, spanner.NewSpannerInstanceIamUpdater, spanner.SpannerInstanceIdParseFunc),
-	"google_spanner_instance_iam_member":         tpgiamresource.ResourceIamMember(spanner.IamSpannerInstanceSchema, spanner.NewSpannerInstanceIamUpdater, spanner.SpannerInstanceIdParseFunc),
-	"google_spanner_instance_iam_policy":         tpgiamresource.ResourceIamPolicy(spanner.IamSpannerInstanceSchema, spanner.NewSpannerInstanceIamUpdater, spanner.SpannerInstanceIdParseFunc),
-	"google_spanner_database_iam_binding":        tpgiamresource.ResourceIamBinding(spanner.IamSpannerDatabaseSchema, spanner.NewSpannerDatabaseIamUpdater, spanner.SpannerDatabaseIdParseFunc),
-	"google_spanner_database_iam_member":         tpgiamresource.ResourceIamMember(spanner.IamSpannerDatabaseSchema, spanner.NewSpannerDatabaseIamUpdater, spanner.SpannerDatabaseIdParseFunc),
-	"google_spanner_database_iam_policy":         tpgiamresource.ResourceIamPolicy(spanner.IamSpannerDatabaseSchema, spanner.NewSpannerDatabaseIamUpdater, spanner.SpannerDatabaseIdParseFunc),
-	"google_organization_iam_binding":            tpgiamresource.ResourceIamBinding(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater, resourcemanager.OrgIdParseFunc),
-	"google_organization_iam_member":             tpgiamresource.ResourceIamMember(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater, resourcemanager.OrgIdParseFunc),
-	"google_organization_iam_policy":             tpgiamresource.ResourceIamPolicy(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater, resourcemanager.OrgIdParseFunc),
-	"google_organization_iam_audit_config":       tpgiamresource.ResourceIamAuditConfig(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater, resourcemanager.OrgIdParseFunc),
-	"google_project_iam_policy":                  tpgiamresource.ResourceIamPolicy(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, resourcemanager.ProjectIdParseFunc),
-	"google_project_iam_binding":                 tpgiamresource.ResourceIamBinding(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, resourcemanager.ProjectIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_project_iam_member":                  tpgiamresource.ResourceIamMember(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, resourcemanager.ProjectIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_project_iam_audit_config":            tpgiamresource.ResourceIamAuditConfig(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, resourcemanager.ProjectIdParseFunc, tpgiamresource.IamWithBatching),
-	"google_pubsub_subscription_iam_binding":     tpgiamresource.ResourceIamBinding(pubsub.IamPubsubSubscriptionSchema, pubsub.NewPubsubSubscriptionIamUpdater, pubsub.PubsubSubscriptionIdParseFunc),
-	"google_pubsub_subscription_iam_member":      tpgiamresource.ResourceIamMember(pubsub.IamPubsubSubscriptionSchema, pubsub.NewPubsubSubscriptionIamUpdater, pubsub.PubsubSubscriptionIdParseFunc),
-	"google_pubsub_subscription_iam_policy":      tpgiamresource.ResourceIamPolicy(pubsub.IamPubsubSubscriptionSchema, pubsub.NewPubsubSubscriptionIamUpdater, pubsub.PubsubSubscriptionIdParseFunc),
-	"google_service_account_iam_binding":         tpgiamresource.ResourceIamBinding(resourcemanager.IamServiceAccountSchema, resourcemanager.NewServiceAccountIamUpdater, resourcemanager.ServiceAccountIdParseFunc),
-	"google_service_account_iam_member":          tpgiamresource.ResourceIamMember(resourcemanager.IamServiceAccountSchema, resourcemanager.NewServiceAccountIamUpdater, resourcemanager.ServiceAccountIdParseFunc),
-	"google_service_account_iam_policy":          tpgiamresource.ResourceIamPolicy(resourcemanager.IamServiceAccountSchema, resourcemanager.NewServiceAccountIamUpdater, resourcemanager.ServiceAccountIdParseFunc),
+	"google_bigtable_instance_iam_binding":             tpgiamresource.ResourceIamBinding(bigtable.IamBigtableInstanceSchema, bigtable.NewBigtableInstanceUpdater, bigtable.BigtableInstanceIdParseFunc),
+	"google_bigtable_instance_iam_member":              tpgiamresource.ResourceIamMember(bigtable.IamBigtableInstanceSchema, bigtable.NewBigtableInstanceUpdater, bigtable.BigtableInstanceIdParseFunc),
+	"google_bigtable_instance_iam_member_remove":       tpgiamresource.ResourceIamMemberRemove(bigtable.IamBigtableInstanceSchema, bigtable.NewBigtableInstanceUpdater),
+	"google_bigtable_instance_iam_policy":              tpgiamresource.ResourceIamPolicy(bigtable.IamBigtableInstanceSchema, bigtable.NewBigtableInstanceUpdater, bigtable.BigtableInstanceIdParseFunc),
+	"google_bigtable_table_iam_binding":                tpgiamresource.ResourceIamBinding(bigtable.IamBigtableTableSchema, bigtable.NewBigtableTableUpdater, bigtable.BigtableTableIdParseFunc),
+	"google_bigtable_table_iam_member":                 tpgiamresource.ResourceIamMember(bigtable.IamBigtableTableSchema, bigtable.NewBigtableTableUpdater, bigtable.BigtableTableIdParseFunc),
+	"google_bigtable_table_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(bigtable.IamBigtableTableSchema, bigtable.NewBigtableTableUpdater),
+	"google_bigtable_table_iam_policy":                 tpgiamresource.ResourceIamPolicy(bigtable.IamBigtableTableSchema, bigtable.NewBigtableTableUpdater, bigtable.BigtableTableIdParseFunc),
+	"google_bigquery_dataset_iam_binding":              tpgiamresource.ResourceIamBinding(bigquery.IamBigqueryDatasetSchema, bigquery.NewBigqueryDatasetIamUpdater, bigquery.BigqueryDatasetIdParseFunc),
+	"google_bigquery_dataset_iam_member":               tpgiamresource.ResourceIamMember(bigquery.IamBigqueryDatasetSchema, bigquery.NewBigqueryDatasetIamUpdater, bigquery.BigqueryDatasetIdParseFunc),
+	"google_bigquery_dataset_iam_member_remove":        tpgiamresource.ResourceIamMemberRemove(bigquery.IamBigqueryDatasetSchema, bigquery.NewBigqueryDatasetIamUpdater),
+	"google_bigquery_dataset_iam_policy":               tpgiamresource.ResourceIamPolicy(bigquery.IamBigqueryDatasetSchema, bigquery.NewBigqueryDatasetIamUpdater, bigquery.BigqueryDatasetIdParseFunc),
+	"google_billing_account_iam_binding":               tpgiamresource.ResourceIamBinding(billing.IamBillingAccountSchema, billing.NewBillingAccountIamUpdater, billing.BillingAccountIdParseFunc),
+	"google_billing_account_iam_member":                tpgiamresource.ResourceIamMember(billing.IamBillingAccountSchema, billing.NewBillingAccountIamUpdater, billing.BillingAccountIdParseFunc),
+	"google_billing_account_iam_member_remove":         tpgiamresource.ResourceIamMemberRemove(billing.IamBillingAccountSchema, billing.NewBillingAccountIamUpdater),
+	"google_billing_account_iam_policy":                tpgiamresource.ResourceIamPolicy(billing.IamBillingAccountSchema, billing.NewBillingAccountIamUpdater, billing.BillingAccountIdParseFunc),
+	"google_dataproc_cluster_iam_binding":              tpgiamresource.ResourceIamBinding(dataproc.IamDataprocClusterSchema, dataproc.NewDataprocClusterUpdater, dataproc.DataprocClusterIdParseFunc),
+	"google_dataproc_cluster_iam_member":               tpgiamresource.ResourceIamMember(dataproc.IamDataprocClusterSchema, dataproc.NewDataprocClusterUpdater, dataproc.DataprocClusterIdParseFunc),
+	"google_dataproc_cluster_iam_member_remove":        tpgiamresource.ResourceIamMemberRemove(dataproc.IamDataprocClusterSchema, dataproc.NewDataprocClusterUpdater),
+	"google_dataproc_cluster_iam_policy":               tpgiamresource.ResourceIamPolicy(dataproc.IamDataprocClusterSchema, dataproc.NewDataprocClusterUpdater, dataproc.DataprocClusterIdParseFunc),
+	"google_dataproc_job_iam_binding":                  tpgiamresource.ResourceIamBinding(dataproc.IamDataprocJobSchema, dataproc.NewDataprocJobUpdater, dataproc.DataprocJobIdParseFunc),
+	"google_dataproc_job_iam_member":                   tpgiamresource.ResourceIamMember(dataproc.IamDataprocJobSchema, dataproc.NewDataprocJobUpdater, dataproc.DataprocJobIdParseFunc),
+	"google_dataproc_job_iam_member_remove":            tpgiamresource.ResourceIamMemberRemove(dataproc.IamDataprocJobSchema, dataproc.NewDataprocJobUpdater),
+	"google_dataproc_job_iam_policy":                   tpgiamresource.ResourceIamPolicy(dataproc.IamDataprocJobSchema, dataproc.NewDataprocJobUpdater, dataproc.DataprocJobIdParseFunc),
+	"google_folder_iam_binding":                        tpgiamresource.ResourceIamBinding(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater, resourcemanager.FolderIdParseFunc),
+	"google_folder_iam_member":                         tpgiamresource.ResourceIamMember(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater, resourcemanager.FolderIdParseFunc),
+	"google_folder_iam_member_remove":                  tpgiamresource.ResourceIamMemberRemove(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater),
+	"google_folder_iam_policy":                         tpgiamresource.ResourceIamPolicy(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater, resourcemanager.FolderIdParseFunc),
+	"google_folder_iam_audit_config":                   tpgiamresource.ResourceIamAuditConfig(resourcemanager.IamFolderSchema, resourcemanager.NewFolderIamUpdater, resourcemanager.FolderIdParseFunc),
+	"google_healthcare_dataset_iam_binding":            tpgiamresource.ResourceIamBinding(healthcare.IamHealthcareDatasetSchema, healthcare.NewHealthcareDatasetIamUpdater, healthcare.DatasetIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_healthcare_dataset_iam_member":             tpgiamresource.ResourceIamMember(healthcare.IamHealthcareDatasetSchema, healthcare.NewHealthcareDatasetIamUpdater, healthcare.DatasetIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_healthcare_dataset_iam_member_remove":      tpgiamresource.ResourceIamMemberRemove(healthcare.IamHealthcareDatasetSchema, healthcare.NewHealthcareDatasetIamUpdater, tpgiamresource.IamWithBatching),
+	"google_healthcare_dataset_iam_policy":             tpgiamresource.ResourceIamPolicy(healthcare.IamHealthcareDatasetSchema, healthcare.NewHealthcareDatasetIamUpdater, healthcare.DatasetIdParseFunc),
+	"google_healthcare_dicom_store_iam_binding":        tpgiamresource.ResourceIamBinding(healthcare.IamHealthcareDicomStoreSchema, healthcare.NewHealthcareDicomStoreIamUpdater, healthcare.DicomStoreIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_healthcare_dicom_store_iam_member":         tpgiamresource.ResourceIamMember(healthcare.IamHealthcareDicomStoreSchema, healthcare.NewHealthcareDicomStoreIamUpdater, healthcare.DicomStoreIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_healthcare_dicom_store_iam_member_remove":  tpgiamresource.ResourceIamMemberRemove(healthcare.IamHealthcareDicomStoreSchema, healthcare.NewHealthcareDicomStoreIamUpdater, tpgiamresource.IamWithBatching),
+	"google_healthcare_dicom_store_iam_policy":         tpgiamresource.ResourceIamPolicy(healthcare.IamHealthcareDicomStoreSchema, healthcare.NewHealthcareDicomStoreIamUpdater, healthcare.DicomStoreIdParseFunc),
+	"google_healthcare_fhir_store_iam_binding":         tpgiamresource.ResourceIamBinding(healthcare.IamHealthcareFhirStoreSchema, healthcare.NewHealthcareFhirStoreIamUpdater, healthcare.FhirStoreIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_healthcare_fhir_store_iam_member":          tpgiamresource.ResourceIamMember(healthcare.IamHealthcareFhirStoreSchema, healthcare.NewHealthcareFhirStoreIamUpdater, healthcare.FhirStoreIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_healthcare_fhir_store_iam_member_remove":   tpgiamresource.ResourceIamMemberRemove(healthcare.IamHealthcareFhirStoreSchema, healthcare.NewHealthcareFhirStoreIamUpdater, tpgiamresource.IamWithBatching),
+	"google_healthcare_fhir_store_iam_policy":          tpgiamresource.ResourceIamPolicy(healthcare.IamHealthcareFhirStoreSchema, healthcare.NewHealthcareFhirStoreIamUpdater, healthcare.FhirStoreIdParseFunc),
+	"google_healthcare_hl7_v2_store_iam_binding":       tpgiamresource.ResourceIamBinding(healthcare.IamHealthcareHl7V2StoreSchema, healthcare.NewHealthcareHl7V2StoreIamUpdater, healthcare.Hl7V2StoreIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_healthcare_hl7_v2_store_iam_member":        tpgiamresource.ResourceIamMember(healthcare.IamHealthcareHl7V2StoreSchema, healthcare.NewHealthcareHl7V2StoreIamUpdater, healthcare.Hl7V2StoreIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_healthcare_hl7_v2_store_iam_member_remove": tpgiamresource.ResourceIamMemberRemove(healthcare.IamHealthcareHl7V2StoreSchema, healthcare.NewHealthcareHl7V2StoreIamUpdater, tpgiamresource.IamWithBatching),
+	"google_healthcare_hl7_v2_store_iam_policy":        tpgiamresource.ResourceIamPolicy(healthcare.IamHealthcareHl7V2StoreSchema, healthcare.NewHealthcareHl7V2StoreIamUpdater, healthcare.Hl7V2StoreIdParseFunc),
+	"google_kms_key_ring_iam_binding":                  tpgiamresource.ResourceIamBinding(kms.IamKmsKeyRingSchema, kms.NewKmsKeyRingIamUpdater, kms.KeyRingIdParseFunc),
+	"google_kms_key_ring_iam_member":                   tpgiamresource.ResourceIamMember(kms.IamKmsKeyRingSchema, kms.NewKmsKeyRingIamUpdater, kms.KeyRingIdParseFunc),
+	"google_kms_key_ring_iam_member_remove":            tpgiamresource.ResourceIamMemberRemove(kms.IamKmsKeyRingSchema, kms.NewKmsKeyRingIamUpdater),
+	"google_kms_key_ring_iam_policy":                   tpgiamresource.ResourceIamPolicy(kms.IamKmsKeyRingSchema, kms.NewKmsKeyRingIamUpdater, kms.KeyRingIdParseFunc),
+	"google_kms_crypto_key_iam_binding":                tpgiamresource.ResourceIamBinding(kms.IamKmsCryptoKeySchema, kms.NewKmsCryptoKeyIamUpdater, kms.CryptoIdParseFunc),
+	"google_kms_crypto_key_iam_member":                 tpgiamresource.ResourceIamMember(kms.IamKmsCryptoKeySchema, kms.NewKmsCryptoKeyIamUpdater, kms.CryptoIdParseFunc),
+	"google_kms_crypto_key_iam_member_remove":          tpgiamresource.ResourceIamMemberRemove(kms.IamKmsCryptoKeySchema, kms.NewKmsCryptoKeyIamUpdater),
+	"google_kms_crypto_key_iam_policy":                 tpgiamresource.ResourceIamPolicy(kms.IamKmsCryptoKeySchema, kms.NewKmsCryptoKeyIamUpdater, kms.CryptoIdParseFunc),
+	"google_spanner_instance_iam_binding":              tpgiamresource.ResourceIamBinding(spanner.IamSpannerInstanceSchema, spanner.NewSpannerInstanceIamUpdater, spanner.SpannerInstanceIdParseFunc),
+	"google_spanner_instance_iam_member":               tpgiamresource.ResourceIamMember(spanner.IamSpannerInstanceSchema, spanner.NewSpannerInstanceIamUpdater, spanner.SpannerInstanceIdParseFunc),
+	"google_spanner_instance_iam_member_remove":        tpgiamresource.ResourceIamMemberRemove(spanner.IamSpannerInstanceSchema, spanner.NewSpannerInstanceIamUpdater),
+	"google_spanner_instance_iam_policy":               tpgiamresource.ResourceIamPolicy(spanner.IamSpannerInstanceSchema, spanner.NewSpannerInstanceIamUpdater, spanner.SpannerInstanceIdParseFunc),
+	"google_spanner_database_iam_binding":              tpgiamresource.ResourceIamBinding(spanner.IamSpannerDatabaseSchema, spanner.NewSpannerDatabaseIamUpdater, spanner.SpannerDatabaseIdParseFunc),
+	"google_spanner_database_iam_member":               tpgiamresource.ResourceIamMember(spanner.IamSpannerDatabaseSchema, spanner.NewSpannerDatabaseIamUpdater, spanner.SpannerDatabaseIdParseFunc),
+	"google_spanner_database_iam_member_remove":        tpgiamresource.ResourceIamMemberRemove(spanner.IamSpannerDatabaseSchema, spanner.NewSpannerDatabaseIamUpdater),
+	"google_spanner_database_iam_policy":               tpgiamresource.ResourceIamPolicy(spanner.IamSpannerDatabaseSchema, spanner.NewSpannerDatabaseIamUpdater, spanner.SpannerDatabaseIdParseFunc),
+	"google_organization_iam_binding":                  tpgiamresource.ResourceIamBinding(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater, resourcemanager.OrgIdParseFunc),
+	"google_organization_iam_member":                   tpgiamresource.ResourceIamMember(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater, resourcemanager.OrgIdParseFunc),
+	"google_organization_iam_member_remove":            tpgiamresource.ResourceIamMemberRemove(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater),
+	"google_organization_iam_policy":                   tpgiamresource.ResourceIamPolicy(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater, resourcemanager.OrgIdParseFunc),
+	"google_organization_iam_audit_config":             tpgiamresource.ResourceIamAuditConfig(resourcemanager.IamOrganizationSchema, resourcemanager.NewOrganizationIamUpdater, resourcemanager.OrgIdParseFunc),
+	"google_project_iam_policy":                        tpgiamresource.ResourceIamPolicy(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, resourcemanager.ProjectIdParseFunc),
+	"google_project_iam_binding":                       tpgiamresource.ResourceIamBinding(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, resourcemanager.ProjectIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_project_iam_member":                        tpgiamresource.ResourceIamMember(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, resourcemanager.ProjectIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_project_iam_member_remove":                 tpgiamresource.ResourceIamMemberRemove(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, tpgiamresource.IamWithBatching),
+	"google_project_iam_audit_config":                  tpgiamresource.ResourceIamAuditConfig(resourcemanager.IamProjectSchema, resourcemanager.NewProjectIamUpdater, resourcemanager.ProjectIdParseFunc, tpgiamresource.IamWithBatching),
+	"google_pubsub_subscription_iam_binding":           tpgiamresource.ResourceIamBinding(pubsub.IamPubsubSubscriptionSchema, pubsub.NewPubsubSubscriptionIamUpdater, pubsub.PubsubSubscriptionIdParseFunc),
+	"google_pubsub_subscription_iam_member":            tpgiamresource.ResourceIamMember(pubsub.IamPubsubSubscriptionSchema, pubsub.NewPubsubSubscriptionIamUpdater, pubsub.PubsubSubscriptionIdParseFunc),
+	"google_pubsub_subscription_iam_member_remove":     tpgiamresource.ResourceIamMemberRemove(pubsub.IamPubsubSubscriptionSchema, pubsub.NewPubsubSubscriptionIamUpdater),
+	"google_pubsub_subscription_iam_policy":            tpgiamresource.ResourceIamPolicy(pubsub.IamPubsubSubscriptionSchema, pubsub.NewPubsubSubscriptionIamUpdater, pubsub.PubsubSubscriptionIdParseFunc),
+	"google_service_account_iam_binding":               tpgiamresource.ResourceIamBinding(resourcemanager.IamServiceAccountSchema, resourcemanager.NewServiceAccountIamUpdater, resourcemanager.ServiceAccountIdParseFunc),
+	"google_service_account_iam_member":                tpgiamresource.ResourceIamMember(resourcemanager.IamServiceAccountSchema, resourcemanager.NewServiceAccountIamUpdater, resourcemanager.ServiceAccountIdParseFunc),
+	"google_service_account_iam_member_remove":         tpgiamresource.ResourceIamMemberRemove(resourcemanager.IamServiceAccountSchema, resourcemanager.NewServiceAccountIamUpdater),
+	"google_service_account_iam_policy":                tpgiamresource.ResourceIamPolicy(resourcemanager.IamServiceAccountSchema, resourcemanager.NewServiceAccountIamUpdater, resourcemanager.ServiceAccountIdParseFunc),
 	// ####### END non-generated IAM resources ###########
 }
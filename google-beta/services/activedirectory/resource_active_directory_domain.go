@@ -51,7 +51,7 @@ func ResourceActiveDirectoryDomain() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_active_directory_domain"),
 			tpgresource.DefaultProviderProject,
 		),
 
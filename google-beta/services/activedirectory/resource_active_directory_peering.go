@@ -45,7 +45,7 @@ func ResourceActiveDirectoryPeering() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_active_directory_peering"),
 			tpgresource.DefaultProviderProject,
 		),
 
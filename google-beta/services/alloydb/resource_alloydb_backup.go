@@ -34,7 +34,7 @@ import (
 )
 
 func ResourceAlloydbBackup() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceAlloydbBackupCreate,
 		Read:   resourceAlloydbBackupRead,
 		Update: resourceAlloydbBackupUpdate,
@@ -50,8 +50,10 @@ func ResourceAlloydbBackup() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_alloydb_backup"),
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -233,6 +235,13 @@ This can happen due to user-triggered updates or system actions like failover or
 				Computed:    true,
 				Description: `Output only. The current state of the backup.`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -260,6 +269,10 @@ Examples: "2014-10-02T15:01:23Z" and "2014-10-02T15:01:23.045123456Z".`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceAlloydbBackupCreate(d *schema.ResourceData, meta interface{}) error {
@@ -485,6 +498,9 @@ func resourceAlloydbBackupRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("effective_annotations", flattenAlloydbBackupEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Backup: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenAlloydbBackupTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Backup: %s", err)
+	}
 
 	return nil
 }
@@ -900,6 +916,10 @@ func flattenAlloydbBackupEffectiveAnnotations(v interface{}, d *schema.ResourceD
 	return v
 }
 
+func flattenAlloydbBackupTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandAlloydbBackupDisplayName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
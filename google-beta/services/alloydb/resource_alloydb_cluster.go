@@ -34,7 +34,7 @@ import (
 )
 
 func ResourceAlloydbCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceAlloydbClusterCreate,
 		Read:   resourceAlloydbClusterRead,
 		Update: resourceAlloydbClusterUpdate,
@@ -50,8 +50,10 @@ func ResourceAlloydbCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_alloydb_cluster"),
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -630,6 +632,13 @@ This can happen due to user-triggered updates or system actions like failover or
 				Computed:    true,
 				Description: `Output only. The current serving state of the cluster.`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -659,6 +668,10 @@ Deleting a Secondary cluster with a secondary instance REQUIRES setting deletion
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceAlloydbClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -1015,6 +1028,9 @@ func resourceAlloydbClusterRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("effective_annotations", flattenAlloydbClusterEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Cluster: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenAlloydbClusterTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
 
 	return nil
 }
@@ -2070,6 +2086,10 @@ func flattenAlloydbClusterEffectiveAnnotations(v interface{}, d *schema.Resource
 	return v
 }
 
+func flattenAlloydbClusterTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandAlloydbClusterEncryptionConfig(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	l := v.([]interface{})
 	if len(l) == 0 || l[0] == nil {
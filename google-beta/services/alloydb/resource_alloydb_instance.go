@@ -34,7 +34,7 @@ import (
 )
 
 func ResourceAlloydbInstance() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceAlloydbInstanceCreate,
 		Read:   resourceAlloydbInstanceRead,
 		Update: resourceAlloydbInstanceUpdate,
@@ -50,8 +50,10 @@ func ResourceAlloydbInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_alloydb_instance"),
 			tpgresource.SetAnnotationsDiff,
 		),
 
@@ -338,6 +340,13 @@ endpoint for an end-user application.`,
 				Computed:    true,
 				Description: `The current state of the alloydb instance.`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -358,6 +367,10 @@ endpoint for an end-user application.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceAlloydbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
@@ -663,6 +676,9 @@ func resourceAlloydbInstanceRead(d *schema.ResourceData, meta interface{}) error
 	if err := d.Set("effective_annotations", flattenAlloydbInstanceEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Instance: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenAlloydbInstanceTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Instance: %s", err)
+	}
 
 	return nil
 }
@@ -1267,6 +1283,10 @@ func flattenAlloydbInstanceEffectiveAnnotations(v interface{}, d *schema.Resourc
 	return v
 }
 
+func flattenAlloydbInstanceTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandAlloydbInstanceDisplayName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -50,7 +50,7 @@ func ResourceApiGatewayApi() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_api_gateway_api"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -52,7 +52,7 @@ func ResourceApiGatewayApiConfig() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_api_gateway_api_config"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceApiGatewayGateway() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_api_gateway_gateway"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -85,7 +85,7 @@ func ResourceArtifactRegistryRepository() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_artifact_registry_repository"),
 			tpgresource.DefaultProviderProject,
 		),
 
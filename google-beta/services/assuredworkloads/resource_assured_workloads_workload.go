@@ -52,7 +52,7 @@ func ResourceAssuredWorkloadsWorkload() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_assured_workloads_workload"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +50,7 @@ func ResourceBeyondcorpAppConnection() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_beyondcorp_app_connection"),
 			tpgresource.DefaultProviderProject,
 		),
 
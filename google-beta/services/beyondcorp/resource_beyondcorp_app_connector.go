@@ -50,7 +50,7 @@ func ResourceBeyondcorpAppConnector() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_beyondcorp_app_connector"),
 			tpgresource.DefaultProviderProject,
 		),
 
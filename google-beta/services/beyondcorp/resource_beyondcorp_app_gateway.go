@@ -60,7 +60,7 @@ func ResourceBeyondcorpAppGateway() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_beyondcorp_app_gateway"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -78,7 +78,7 @@ func ResourceBigQueryDataset() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_bigquery_dataset"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -69,7 +69,7 @@ func ResourceBigQueryJob() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_bigquery_job"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -1081,7 +1081,7 @@ func resourceBigQueryJobCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(id)
 
-	err = transport_tpg.PollingWaitTime(resourceBigQueryJobPollRead(d, meta), transport_tpg.PollCheckForExistence, "Creating Job", d.Timeout(schema.TimeoutCreate), 1)
+	err = transport_tpg.PollingWaitTimeContext(config.Context, resourceBigQueryJobPollRead(d, meta), transport_tpg.PollCheckForExistence, "Creating Job", d.Timeout(schema.TimeoutCreate), 1)
 	if err != nil {
 		return fmt.Errorf("Error waiting to create Job: %s", err)
 	}
@@ -445,7 +445,7 @@ func ResourceBigQueryTable() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			resourceBigQueryTableSchemaCustomizeDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_bigquery_table"),
 		),
 		Schema: map[string]*schema.Schema{
 			// TableId: [Required] The ID of the table. The ID must contain only
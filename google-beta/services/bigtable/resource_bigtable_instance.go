@@ -40,7 +40,7 @@ func ResourceBigtableInstance() *schema.Resource {
 			tpgresource.DefaultProviderProject,
 			resourceBigtableInstanceClusterReorderTypeList,
 			resourceBigtableInstanceUniqueClusterID,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_bigtable_instance"),
 		),
 
 		SchemaVersion: 1,
@@ -50,7 +50,7 @@ func ResourceBlockchainNodeEngineBlockchainNodes() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_blockchain_node_engine_blockchain_nodes"),
 			tpgresource.DefaultProviderProject,
 		),
 
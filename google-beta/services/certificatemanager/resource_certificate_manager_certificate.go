@@ -67,7 +67,7 @@ func ResourceCertificateManagerCertificate() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_certificate_manager_certificate"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -60,7 +60,7 @@ func ResourceCertificateManagerCertificateIssuanceConfig() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_certificate_manager_certificate_issuance_config"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceCertificateManagerCertificateMap() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_certificate_manager_certificate_map"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceCertificateManagerCertificateMapEntry() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_certificate_manager_certificate_map_entry"),
 			tpgresource.DefaultProviderProject,
 		),
 
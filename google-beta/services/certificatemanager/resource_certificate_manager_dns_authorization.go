@@ -61,7 +61,7 @@ func ResourceCertificateManagerDnsAuthorization() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_certificate_manager_dns_authorization"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -49,7 +49,7 @@ func ResourceCertificateManagerTrustConfig() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_certificate_manager_trust_config"),
 			tpgresource.DefaultProviderProject,
 		),
 
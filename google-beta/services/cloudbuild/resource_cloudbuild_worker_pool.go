@@ -36,7 +36,7 @@ import (
 )
 
 func ResourceCloudbuildWorkerPool() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceCloudbuildWorkerPoolCreate,
 		Read:   resourceCloudbuildWorkerPoolRead,
 		Update: resourceCloudbuildWorkerPoolUpdate,
@@ -51,6 +51,8 @@ func ResourceCloudbuildWorkerPool() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.SetAnnotationsDiff,
@@ -83,6 +85,13 @@ func ResourceCloudbuildWorkerPool() *schema.Resource {
 				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
 			},
 
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"network_config": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -148,6 +157,10 @@ func ResourceCloudbuildWorkerPool() *schema.Resource {
 			},
 		},
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func CloudbuildWorkerPoolNetworkConfigSchema() *schema.Resource {
@@ -301,6 +314,9 @@ func resourceCloudbuildWorkerPoolRead(d *schema.ResourceData, meta interface{})
 	if err = d.Set("effective_annotations", res.Annotations); err != nil {
 		return fmt.Errorf("error setting effective_annotations in state: %s", err)
 	}
+	if err = d.Set("terraform_annotations", tpgresource.FlattenTerraformAnnotations(res.Annotations, d, config, "")); err != nil {
+		return fmt.Errorf("error setting terraform_annotations in state: %s", err)
+	}
 	if err = d.Set("network_config", flattenCloudbuildWorkerPoolNetworkConfig(res.NetworkConfig)); err != nil {
 		return fmt.Errorf("error setting network_config in state: %s", err)
 	}
@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceCloudbuildv2Connection() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceCloudbuildv2ConnectionCreate,
 		Read:   resourceCloudbuildv2ConnectionRead,
 		Update: resourceCloudbuildv2ConnectionUpdate,
@@ -49,6 +49,8 @@ func ResourceCloudbuildv2Connection() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -285,6 +287,13 @@ Please refer to the field 'effective_annotations' for all of the annotations pre
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"etag": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -333,6 +342,10 @@ Please refer to the field 'effective_annotations' for all of the annotations pre
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceCloudbuildv2ConnectionCreate(d *schema.ResourceData, meta interface{}) error {
@@ -511,6 +524,9 @@ func resourceCloudbuildv2ConnectionRead(d *schema.ResourceData, meta interface{}
 	if err := d.Set("effective_annotations", flattenCloudbuildv2ConnectionEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Connection: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenCloudbuildv2ConnectionTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Connection: %s", err)
+	}
 
 	return nil
 }
@@ -1011,6 +1027,10 @@ func flattenCloudbuildv2ConnectionEffectiveAnnotations(v interface{}, d *schema.
 	return v
 }
 
+func flattenCloudbuildv2ConnectionTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandCloudbuildv2ConnectionGithubConfig(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	l := v.([]interface{})
 	if len(l) == 0 || l[0] == nil {
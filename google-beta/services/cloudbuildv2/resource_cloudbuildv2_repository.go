@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceCloudbuildv2Repository() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceCloudbuildv2RepositoryCreate,
 		Read:   resourceCloudbuildv2RepositoryRead,
 		Delete: resourceCloudbuildv2RepositoryDelete,
@@ -47,6 +47,8 @@ func ResourceCloudbuildv2Repository() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -101,6 +103,14 @@ Please refer to the field 'effective_annotations' for all of the annotations pre
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				ForceNew: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"etag": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -120,6 +130,10 @@ Please refer to the field 'effective_annotations' for all of the annotations pre
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceCloudbuildv2RepositoryCreate(d *schema.ResourceData, meta interface{}) error {
@@ -270,6 +284,9 @@ func resourceCloudbuildv2RepositoryRead(d *schema.ResourceData, meta interface{}
 	if err := d.Set("effective_annotations", flattenCloudbuildv2RepositoryEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Repository: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenCloudbuildv2RepositoryTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Repository: %s", err)
+	}
 
 	return nil
 }
@@ -385,6 +402,10 @@ func flattenCloudbuildv2RepositoryEffectiveAnnotations(v interface{}, d *schema.
 	return v
 }
 
+func flattenCloudbuildv2RepositoryTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandCloudbuildv2RepositoryName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceClouddeployAutomation() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceClouddeployAutomationCreate,
 		Read:   resourceClouddeployAutomationRead,
 		Update: resourceClouddeployAutomationUpdate,
@@ -49,9 +49,11 @@ func ResourceClouddeployAutomation() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_clouddeploy_automation"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -227,6 +229,13 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Computed:    true,
 				Description: `Optional. The weak etag of the 'Automation' resource. This checksum is computed by the server based on the value of other fields, and may be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -253,6 +262,10 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceClouddeployAutomationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -440,6 +453,9 @@ func resourceClouddeployAutomationRead(d *schema.ResourceData, meta interface{})
 	if err := d.Set("effective_annotations", flattenClouddeployAutomationEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Automation: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenClouddeployAutomationTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Automation: %s", err)
+	}
 	if err := d.Set("terraform_labels", flattenClouddeployAutomationTerraformLabels(res["labels"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Automation: %s", err)
 	}
@@ -849,6 +865,10 @@ func flattenClouddeployAutomationEffectiveAnnotations(v interface{}, d *schema.R
 	return v
 }
 
+func flattenClouddeployAutomationTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func flattenClouddeployAutomationTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return v
@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceClouddeployCustomTargetType() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceClouddeployCustomTargetTypeCreate,
 		Read:   resourceClouddeployCustomTargetTypeRead,
 		Update: resourceClouddeployCustomTargetTypeUpdate,
@@ -49,9 +49,11 @@ func ResourceClouddeployCustomTargetType() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_clouddeploy_custom_target_type"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -228,6 +230,13 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 				Computed:    true,
 				Description: `The weak etag of the 'CustomTargetType' resource. This checksum is computed by the server based on the value of other fields, and may be sent on update and delete requests to ensure the client has an up-to-date value before proceeding.`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -254,6 +263,10 @@ Please refer to the field 'effective_labels' for all of the labels present on th
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceClouddeployCustomTargetTypeCreate(d *schema.ResourceData, meta interface{}) error {
@@ -417,6 +430,9 @@ func resourceClouddeployCustomTargetTypeRead(d *schema.ResourceData, meta interf
 	if err := d.Set("effective_annotations", flattenClouddeployCustomTargetTypeEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading CustomTargetType: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenClouddeployCustomTargetTypeTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading CustomTargetType: %s", err)
+	}
 	if err := d.Set("terraform_labels", flattenClouddeployCustomTargetTypeTerraformLabels(res["labels"], d, config)); err != nil {
 		return fmt.Errorf("Error reading CustomTargetType: %s", err)
 	}
@@ -800,6 +816,10 @@ func flattenClouddeployCustomTargetTypeEffectiveAnnotations(v interface{}, d *sc
 	return v
 }
 
+func flattenClouddeployCustomTargetTypeTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func flattenClouddeployCustomTargetTypeTerraformLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
 	if v == nil {
 		return v
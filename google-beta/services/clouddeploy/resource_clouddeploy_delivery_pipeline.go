@@ -36,7 +36,7 @@ import (
 )
 
 func ResourceClouddeployDeliveryPipeline() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceClouddeployDeliveryPipelineCreate,
 		Read:   resourceClouddeployDeliveryPipelineRead,
 		Update: resourceClouddeployDeliveryPipelineUpdate,
@@ -51,9 +51,11 @@ func ResourceClouddeployDeliveryPipeline() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_clouddeploy_delivery_pipeline"),
 			tpgresource.SetAnnotationsDiff,
 		),
 
@@ -146,6 +148,13 @@ func ResourceClouddeployDeliveryPipeline() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"terraform_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -165,6 +174,10 @@ func ResourceClouddeployDeliveryPipeline() *schema.Resource {
 			},
 		},
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func ClouddeployDeliveryPipelineSerialPipelineSchema() *schema.Resource {
@@ -819,6 +832,9 @@ func resourceClouddeployDeliveryPipelineRead(d *schema.ResourceData, meta interf
 	if err = d.Set("effective_annotations", res.Annotations); err != nil {
 		return fmt.Errorf("error setting effective_annotations in state: %s", err)
 	}
+	if err = d.Set("terraform_annotations", tpgresource.FlattenTerraformAnnotations(res.Annotations, d, config, "")); err != nil {
+		return fmt.Errorf("error setting terraform_annotations in state: %s", err)
+	}
 	if err = d.Set("effective_labels", res.Labels); err != nil {
 		return fmt.Errorf("error setting effective_labels in state: %s", err)
 	}
@@ -36,7 +36,7 @@ import (
 )
 
 func ResourceClouddeployTarget() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceClouddeployTargetCreate,
 		Read:   resourceClouddeployTargetRead,
 		Update: resourceClouddeployTargetUpdate,
@@ -51,9 +51,11 @@ func ResourceClouddeployTarget() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_clouddeploy_target"),
 			tpgresource.SetAnnotationsDiff,
 		),
 
@@ -197,6 +199,13 @@ func ResourceClouddeployTarget() *schema.Resource {
 				Description: "Output only. Resource id of the `Target`.",
 			},
 
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"terraform_labels": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -216,6 +225,10 @@ func ResourceClouddeployTarget() *schema.Resource {
 			},
 		},
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func ClouddeployTargetAnthosClusterSchema() *schema.Resource {
@@ -457,6 +470,9 @@ func resourceClouddeployTargetRead(d *schema.ResourceData, meta interface{}) err
 	if err = d.Set("effective_annotations", res.Annotations); err != nil {
 		return fmt.Errorf("error setting effective_annotations in state: %s", err)
 	}
+	if err = d.Set("terraform_annotations", tpgresource.FlattenTerraformAnnotations(res.Annotations, d, config, "")); err != nil {
+		return fmt.Errorf("error setting terraform_annotations in state: %s", err)
+	}
 	if err = d.Set("effective_labels", res.Labels); err != nil {
 		return fmt.Errorf("error setting effective_labels in state: %s", err)
 	}
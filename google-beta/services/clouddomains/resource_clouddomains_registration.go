@@ -71,7 +71,7 @@ func ResourceClouddomainsRegistration() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_clouddomains_registration"),
 			tpgresource.DefaultProviderProject,
 		),
 
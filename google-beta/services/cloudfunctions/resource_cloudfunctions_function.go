@@ -147,7 +147,7 @@ func ResourceCloudFunctionsFunction() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.DefaultProviderRegion,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_cloudfunctions_function"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -51,7 +51,7 @@ func ResourceCloudfunctions2function() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_cloudfunctions2_function"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -150,39 +150,19 @@ func dataSourceGoogleCloudQuotasQuotaInfosRead(d *schema.ResourceData, meta inte
 		return fmt.Errorf("error setting api endpoint")
 	}
 
-	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+	fetchedQuotaInfos, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
 		Config:    config,
-		Method:    "GET",
-		RawURL:    url,
 		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "quotaInfos",
 	})
 	if err != nil {
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("CloudQuotasQuotaInfo %q", d.Id()))
 	}
 
 	var quotaInfos []map[string]interface{}
-	for {
-		fetchedQuotaInfos := res["quotaInfos"].([]interface{})
-		for _, rawQuotaInfo := range fetchedQuotaInfos {
-			quotaInfos = append(quotaInfos, flattenCloudQuotasQuotaInfo(rawQuotaInfo.(map[string]interface{}), d, config))
-		}
-
-		if res["nextPageToken"] == nil || res["nextPageToken"].(string) == "" {
-			break
-		}
-		url, err = tpgresource.ReplaceVars(d, config, "{{CloudQuotasBasePath}}{{parent}}/locations/global/services/{{service}}/quotaInfos?pageToken="+res["nextPageToken"].(string))
-		if err != nil {
-			return fmt.Errorf("error setting api endpoint")
-		}
-		res, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
-		if err != nil {
-			return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("CloudQuotasQuotaInfo %q", d.Id()), url)
-		}
+	for _, rawQuotaInfo := range fetchedQuotaInfos {
+		quotaInfos = append(quotaInfos, flattenCloudQuotasQuotaInfo(rawQuotaInfo.(map[string]interface{}), d, config))
 	}
 
 	if err := d.Set("quota_infos", quotaInfos); err != nil {
@@ -45,7 +45,7 @@ func hasMetadata(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 }
 
 func ResourceCloudRunDomainMapping() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceCloudRunDomainMappingCreate,
 		Read:   resourceCloudRunDomainMappingRead,
 		Delete: resourceCloudRunDomainMappingDelete,
@@ -59,7 +59,7 @@ func ResourceCloudRunDomainMapping() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -70,7 +70,7 @@ func ResourceCloudRunDomainMapping() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			hasMetadata,
-			tpgresource.SetMetadataLabelsDiff,
+			tpgresource.SetMetadataLabelsDiffFor("google_cloud_run_domain_mapping"),
 			tpgresource.SetMetadataAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -205,6 +205,14 @@ https://git.k8s.io/community/contributors/devel/api-conventions.md#concurrency-c
 							Computed:    true,
 							Description: `SelfLink is a URL representing this object.`,
 						},
+						"terraform_annotations": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							ForceNew: true,
+							Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
 						"terraform_labels": {
 							Type:     schema.TypeMap,
 							Computed: true,
@@ -312,6 +320,10 @@ was last processed by the controller.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 1, "metadata"))
+
+	return r
 }
 
 func resourceCloudRunDomainMappingCreate(d *schema.ResourceData, meta interface{}) error {
@@ -382,7 +394,7 @@ func resourceCloudRunDomainMappingCreate(d *schema.ResourceData, meta interface{
 	}
 	d.SetId(id)
 
-	err = transport_tpg.PollingWaitTime(resourceCloudRunDomainMappingPollRead(d, meta), PollCheckKnativeStatusFunc(res), "Creating DomainMapping", d.Timeout(schema.TimeoutCreate), 1)
+	err = transport_tpg.PollingWaitTimeContext(config.Context, resourceCloudRunDomainMappingPollRead(d, meta), PollCheckKnativeStatusFunc(res), "Creating DomainMapping", d.Timeout(schema.TimeoutCreate), 1)
 	if err != nil {
 		return fmt.Errorf("Error waiting to create DomainMapping: %s", err)
 	}
@@ -748,6 +760,8 @@ func flattenCloudRunDomainMappingMetadata(v interface{}, d *schema.ResourceData,
 		flattenCloudRunDomainMappingMetadataEffectiveLabels(original["labels"], d, config)
 	transformed["effective_annotations"] =
 		flattenCloudRunDomainMappingMetadataEffectiveAnnotations(original["annotations"], d, config)
+	transformed["terraform_annotations"] =
+		flattenCloudRunDomainMappingMetadataTerraformAnnotations(original["annotations"], d, config)
 	return []interface{}{transformed}
 }
 func flattenCloudRunDomainMappingMetadataLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -836,6 +850,10 @@ func flattenCloudRunDomainMappingMetadataEffectiveAnnotations(v interface{}, d *
 	return v
 }
 
+func flattenCloudRunDomainMappingMetadataTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "metadata.0.")
+}
+
 func expandCloudRunDomainMappingSpec(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	l := v.([]interface{})
 	if len(l) == 0 || l[0] == nil {
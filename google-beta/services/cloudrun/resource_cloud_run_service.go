@@ -85,7 +85,7 @@ func cloudrunTemplateLabelDiffSuppress(k, old, new string, d *schema.ResourceDat
 }
 
 func ResourceCloudRunService() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceCloudRunServiceCreate,
 		Read:   resourceCloudRunServiceRead,
 		Update: resourceCloudRunServiceUpdate,
@@ -101,7 +101,7 @@ func ResourceCloudRunService() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
-		SchemaVersion: 2,
+		SchemaVersion: 3,
 
 		StateUpgraders: []schema.StateUpgrader{
 			{
@@ -112,7 +112,7 @@ func ResourceCloudRunService() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			revisionNameCustomizeDiff,
-			tpgresource.SetMetadataLabelsDiff,
+			tpgresource.SetMetadataLabelsDiffFor("google_cloud_run_service"),
 			tpgresource.SetMetadataAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -1054,6 +1054,13 @@ particular resource or set of resources.`,
 							Computed:    true,
 							Description: `SelfLink is a URL representing this object.`,
 						},
+						"terraform_annotations": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+							Elem: &schema.Schema{Type: schema.TypeString},
+						},
 						"terraform_labels": {
 							Type:     schema.TypeMap,
 							Computed: true,
@@ -1197,6 +1204,10 @@ this field is set to false, the revision name will still autogenerate.)`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 2, "metadata"))
+
+	return r
 }
 
 func cloudrunServiceSpecTemplateSpecContainersContainersEnvSchema() *schema.Resource {
@@ -1319,7 +1330,7 @@ func resourceCloudRunServiceCreate(d *schema.ResourceData, meta interface{}) err
 	}
 	d.SetId(id)
 
-	err = transport_tpg.PollingWaitTime(resourceCloudRunServicePollRead(d, meta), PollCheckKnativeStatusFunc(res), "Creating Service", d.Timeout(schema.TimeoutCreate), 1)
+	err = transport_tpg.PollingWaitTimeContext(config.Context, resourceCloudRunServicePollRead(d, meta), PollCheckKnativeStatusFunc(res), "Creating Service", d.Timeout(schema.TimeoutCreate), 1)
 	if err != nil {
 		return fmt.Errorf("Error waiting to create Service: %s", err)
 	}
@@ -1531,7 +1542,7 @@ func resourceCloudRunServiceUpdate(d *schema.ResourceData, meta interface{}) err
 		log.Printf("[DEBUG] Finished updating Service %q: %#v", d.Id(), res)
 	}
 
-	err = transport_tpg.PollingWaitTime(resourceCloudRunServicePollRead(d, meta), PollCheckKnativeStatusFunc(res), "Updating Service", d.Timeout(schema.TimeoutUpdate), 1)
+	err = transport_tpg.PollingWaitTimeContext(config.Context, resourceCloudRunServicePollRead(d, meta), PollCheckKnativeStatusFunc(res), "Updating Service", d.Timeout(schema.TimeoutUpdate), 1)
 	if err != nil {
 		return err
 	}
@@ -2903,6 +2914,8 @@ func flattenCloudRunServiceMetadata(v interface{}, d *schema.ResourceData, confi
 		flattenCloudRunServiceMetadataEffectiveLabels(original["labels"], d, config)
 	transformed["effective_annotations"] =
 		flattenCloudRunServiceMetadataEffectiveAnnotations(original["annotations"], d, config)
+	transformed["terraform_annotations"] =
+		flattenCloudRunServiceMetadataTerraformAnnotations(original["annotations"], d, config)
 	return []interface{}{transformed}
 }
 func flattenCloudRunServiceMetadataLabels(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
@@ -2991,6 +3004,10 @@ func flattenCloudRunServiceMetadataEffectiveAnnotations(v interface{}, d *schema
 	return v
 }
 
+func flattenCloudRunServiceMetadataTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "metadata.0.")
+}
+
 func expandCloudRunServiceSpec(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	transformed := make(map[string]interface{})
 	transformedTraffic, err := expandCloudRunServiceSpecTraffic(d.Get("traffic"), d, config)
@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceCloudRunV2Job() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceCloudRunV2JobCreate,
 		Read:   resourceCloudRunV2JobRead,
 		Update: resourceCloudRunV2JobUpdate,
@@ -49,8 +49,10 @@ func ResourceCloudRunV2Job() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_cloud_run_v2_job"),
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -767,6 +769,13 @@ A timestamp in RFC3339 UTC "Zulu" format, with nanosecond resolution and up to n
 					},
 				},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -793,6 +802,10 @@ A timestamp in RFC3339 UTC "Zulu" format, with nanosecond resolution and up to n
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceCloudRunV2JobCreate(d *schema.ResourceData, meta interface{}) error {
@@ -1026,6 +1039,9 @@ func resourceCloudRunV2JobRead(d *schema.ResourceData, meta interface{}) error {
 	if err := d.Set("effective_annotations", flattenCloudRunV2JobEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Job: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenCloudRunV2JobTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Job: %s", err)
+	}
 
 	return nil
 }
@@ -2076,6 +2092,10 @@ func flattenCloudRunV2JobEffectiveAnnotations(v interface{}, d *schema.ResourceD
 	return v
 }
 
+func flattenCloudRunV2JobTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandCloudRunV2JobClient(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
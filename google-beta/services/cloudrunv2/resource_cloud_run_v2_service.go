@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceCloudRunV2Service() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceCloudRunV2ServiceCreate,
 		Read:   resourceCloudRunV2ServiceRead,
 		Update: resourceCloudRunV2ServiceUpdate,
@@ -49,8 +49,10 @@ func ResourceCloudRunV2Service() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_cloud_run_v2_service"),
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -1082,6 +1084,13 @@ If reconciliation failed, trafficStatuses, observedGeneration, and latestReadyRe
 					},
 				},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -1147,6 +1156,10 @@ If reconciliation failed, trafficStatuses, observedGeneration, and latestReadyRe
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceCloudRunV2ServiceCreate(d *schema.ResourceData, meta interface{}) error {
@@ -1440,6 +1453,9 @@ func resourceCloudRunV2ServiceRead(d *schema.ResourceData, meta interface{}) err
 	if err := d.Set("effective_annotations", flattenCloudRunV2ServiceEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Service: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenCloudRunV2ServiceTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Service: %s", err)
+	}
 
 	return nil
 }
@@ -3110,6 +3126,10 @@ func flattenCloudRunV2ServiceEffectiveAnnotations(v interface{}, d *schema.Resou
 	return v
 }
 
+func flattenCloudRunV2ServiceTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandCloudRunV2ServiceDescription(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
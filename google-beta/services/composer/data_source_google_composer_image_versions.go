@@ -68,10 +68,17 @@ func dataSourceGoogleComposerImageVersionsRead(d *schema.ResourceData, meta inte
 		return err
 	}
 
-	versions, err := tpgresource.PaginatedListRequest(project, url, userAgent, config, flattenGoogleComposerImageVersions)
+	verObjList, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:    config,
+		Project:   project,
+		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "imageVersions",
+	})
 	if err != nil {
 		return fmt.Errorf("Error listing Composer image versions: %s", err)
 	}
+	versions := flattenGoogleComposerImageVersions(verObjList)
 
 	log.Printf("[DEBUG] Received Composer Image Versions: %q", versions)
 
@@ -89,8 +96,7 @@ func dataSourceGoogleComposerImageVersionsRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func flattenGoogleComposerImageVersions(resp map[string]interface{}) []interface{} {
-	verObjList := resp["imageVersions"].([]interface{})
+func flattenGoogleComposerImageVersions(verObjList []interface{}) []interface{} {
 	versions := make([]interface{}, len(verObjList))
 	for i, v := range verObjList {
 		verObj := v.(map[string]interface{})
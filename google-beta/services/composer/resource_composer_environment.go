@@ -160,7 +160,7 @@ func ResourceComposerEnvironment() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.DefaultProviderRegion,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_composer_environment"),
 			customdiff.Sequence(
 				customdiff.ValidateChange("config.0.software_config.0.image_version", imageVersionChangeValidationFunc),
 				versionValidationCustomizeDiffFunc,
@@ -3,10 +3,16 @@
 package compute
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 	compute "google.golang.org/api/compute/v0.beta"
 )
 
@@ -304,3 +310,42 @@ func TestComputeOperationError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeOperationWaitTime_contextCanceled(t *testing.T) {
+	var polls int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&polls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"op","zone":"us-central1-a","status":"RUNNING"}`))
+	}))
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	config := &transport_tpg.Config{
+		Context:         ctx,
+		Client:          ts.Client(),
+		ComputeBasePath: ts.URL + "/compute/beta/",
+		PollInterval:    10 * time.Millisecond,
+	}
+	op := &compute.Operation{Name: "op", Zone: "us-central1-a", Status: "RUNNING"}
+
+	errc := make(chan error)
+	go func() {
+		errc <- ComputeOperationWaitTime(config, op, "project", "Creating Instance", "test", time.Hour)
+	}()
+
+	// Cancel once polling is under way
+	for atomic.LoadInt32(&polls) == 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	cancel()
+
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Errorf("expected an error once the context was canceled")
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatalf("expected waiting for the operation to stop once the context was canceled")
+	}
+}
@@ -12,7 +12,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	compute "google.golang.org/api/compute/v0.beta"
 )
 
 func DataSourceGoogleComputeAddresses() *schema.Resource {
@@ -124,38 +123,33 @@ func dataSourceGoogleComputeAddressesRead(context context.Context, d *schema.Res
 		return diag.FromErr(err)
 	}
 
-	allAddresses := make([]map[string]interface{}, 0)
-
-	client := config.NewComputeClient(userAgent).Addresses
+	opts := transport_tpg.ListRequestOptions{
+		Config:    config,
+		Project:   project,
+		UserAgent: userAgent,
+		Context:   context,
+	}
+	if filter, has_filter := d.GetOk("filter"); has_filter {
+		opts.Filter = filter.(string)
+	}
 	if region, has_region := d.GetOk("region"); has_region {
-		request := client.List(project, region.(string))
-		if filter, has_filter := d.GetOk("filter"); has_filter {
-			request = request.Filter(filter.(string))
-		}
-		err = request.Pages(context, func(addresses *compute.AddressList) error {
-			for _, address := range addresses.Items {
-				allAddresses = append(allAddresses, generateTfAddress(address))
-			}
-			return nil
-		})
+		opts.RawURL = fmt.Sprintf("%sprojects/%s/regions/%s/addresses", config.ComputeBasePath, project, region.(string))
 	} else {
-		request := client.AggregatedList(project)
-		if filter, has_filter := d.GetOk("filter"); has_filter {
-			request = request.Filter(filter.(string))
-		}
-		err = request.Pages(context, func(addresses *compute.AddressAggregatedList) error {
-			for _, items := range addresses.Items {
-				for _, address := range items.Addresses {
-					allAddresses = append(allAddresses, generateTfAddress(address))
-				}
-			}
-			return nil
-		})
+		opts.RawURL = fmt.Sprintf("%sprojects/%s/aggregated/addresses", config.ComputeBasePath, project)
+		opts.Aggregated = true
+		opts.AggregatedItemsKey = "addresses"
 	}
+
+	items, err := transport_tpg.ListAll(opts)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
+	allAddresses := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		allAddresses = append(allAddresses, generateTfAddress(item.(map[string]interface{})))
+	}
+
 	if err := d.Set("addresses", allAddresses); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting addresses: %s", err))
 	}
@@ -167,16 +161,17 @@ func dataSourceGoogleComputeAddressesRead(context context.Context, d *schema.Res
 	return nil
 }
 
-func generateTfAddress(address *compute.Address) map[string]interface{} {
+func generateTfAddress(address map[string]interface{}) map[string]interface{} {
+	region, _ := address["region"].(string)
 	return map[string]interface{}{
-		"name":         address.Name,
-		"address":      address.Address,
-		"address_type": address.AddressType,
-		"description":  address.Description,
-		"region":       regionFromUrl(address.Region),
-		"status":       address.Status,
-		"self_link":    address.SelfLink,
-		"labels":       address.Labels,
+		"name":         address["name"],
+		"address":      address["address"],
+		"address_type": address["addressType"],
+		"description":  address["description"],
+		"region":       regionFromUrl(region),
+		"status":       address["status"],
+		"self_link":    address["selfLink"],
+		"labels":       address["labels"],
 	}
 }
 
@@ -50,7 +50,7 @@ func ResourceComputeAddress() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_address"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -314,7 +314,7 @@ func ResourceComputeDisk() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.ForceNewIfChange("size", IsDiskShrinkage),
 			hyperDiskIopsUpdateDiffSupress,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_disk"),
 			tpgresource.DefaultProviderProject,
 		),
 
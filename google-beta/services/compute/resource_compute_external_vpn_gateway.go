@@ -50,7 +50,7 @@ func ResourceComputeExternalVpnGateway() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_external_vpn_gateway"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -70,7 +70,7 @@ func ResourceComputeForwardingRule() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			forwardingRuleCustomizeDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_forwarding_rule"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceComputeGlobalAddress() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_global_address"),
 			tpgresource.DefaultProviderProject,
 		),
 
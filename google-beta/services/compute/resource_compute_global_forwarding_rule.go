@@ -51,7 +51,7 @@ func ResourceComputeGlobalForwardingRule() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_global_forwarding_rule"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -51,7 +51,7 @@ func ResourceComputeImage() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_image"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -657,6 +657,13 @@ func ResourceComputeInstance() *schema.Resource {
 				},
 			},
 
+			"effective_resource_manager_tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: `The combination of resource manager tags configured in params and default resource manager tags configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"labels": {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -1156,7 +1163,8 @@ be from 0 to 999,999,999 inclusive.`,
 			),
 			desiredStatusDiff,
 			forceNewIfNetworkIPNotUpdatable,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_instance"),
+			tpgresource.SetResourceManagerTagsDiff("params.0.resource_manager_tags"),
 		),
 		UseJSONNumber: true,
 	}
@@ -1182,6 +1190,37 @@ func getInstance(config *transport_tpg.Config, d *schema.ResourceData) (*compute
 	return instance, nil
 }
 
+// setInstanceEffectiveResourceManagerTags sets "effective_resource_manager_tags"
+// from the tags bound to the instance, as the params the tags are passed in
+// aren't returned by the API. They're only read for instances Terraform manages
+// tags of, so tags bound outside of Terraform don't cause a diff otherwise, and
+// are kept from state if the provider isn't allowed to read them.
+func setInstanceEffectiveResourceManagerTags(d *schema.ResourceData, config *transport_tpg.Config, project string, instance *compute.Instance) error {
+	if _, ok := d.GetOk("effective_resource_manager_tags"); !ok && len(config.DefaultResourceManagerTags) == 0 {
+		return nil
+	}
+
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+	zone := tpgresource.GetResourceNameFromSelfLink(instance.Zone)
+	parent := fmt.Sprintf("//compute.googleapis.com/projects/%s/zones/%s/instances/%d", project, zone, instance.Id)
+	tags, err := tpgresource.ReadEffectiveResourceManagerTags(config, userAgent, zone, parent)
+	if err != nil {
+		if transport_tpg.IsGoogleApiErrorWithCode(err, 403) {
+			log.Printf("[WARN] Keeping effective_resource_manager_tags of instance %q from state: %s", instance.Name, err)
+			return nil
+		}
+		return err
+	}
+
+	if err := d.Set("effective_resource_manager_tags", tags); err != nil {
+		return fmt.Errorf("Error setting effective_resource_manager_tags: %s", err)
+	}
+	return nil
+}
+
 func getDisk(diskUri string, d *schema.ResourceData, config *transport_tpg.Config) (*compute.Disk, error) {
 	source, err := tpgresource.ParseDiskFieldValue(diskUri, d, config)
 	if err != nil {
@@ -1512,6 +1551,10 @@ func resourceComputeInstanceRead(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	if err := setInstanceEffectiveResourceManagerTags(d, config, project, instance); err != nil {
+		return err
+	}
+
 	if instance.LabelFingerprint != "" {
 		if err := d.Set("label_fingerprint", instance.LabelFingerprint); err != nil {
 			return fmt.Errorf("Error setting label_fingerprint: %s", err)
@@ -1822,7 +1865,7 @@ func resourceComputeInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if d.HasChange("params.0.resource_manager_tags") {
+	if d.HasChange("effective_resource_manager_tags") {
 		err = transport_tpg.Retry(transport_tpg.RetryOptions{
 			RetryFunc: func() error {
 				instance, err := config.NewComputeClient(userAgent).Instances.Get(project, zone, instance.Name).Do()
@@ -2769,8 +2812,8 @@ func resourceComputeInstanceImportState(d *schema.ResourceData, meta interface{}
 func expandParams(d *schema.ResourceData) (*compute.InstanceParams, error) {
 	params := &compute.InstanceParams{}
 
-	if _, ok := d.GetOk("params.0.resource_manager_tags"); ok {
-		params.ResourceManagerTags = tpgresource.ExpandStringMap(d, "params.0.resource_manager_tags")
+	if _, ok := d.GetOk("effective_resource_manager_tags"); ok {
+		params.ResourceManagerTags = tpgresource.ExpandStringMap(d, "effective_resource_manager_tags")
 	}
 
 	return params, nil
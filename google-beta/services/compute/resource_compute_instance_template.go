@@ -62,7 +62,8 @@ func ResourceComputeInstanceTemplate() *schema.Resource {
 			resourceComputeInstanceTemplateSourceImageCustomizeDiff,
 			resourceComputeInstanceTemplateScratchDiskCustomizeDiff,
 			resourceComputeInstanceTemplateBootDiskCustomizeDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_instance_template"),
+			tpgresource.SetResourceManagerTagsDiff("resource_manager_tags"),
 		),
 		MigrateState: resourceComputeInstanceTemplateMigrateState,
 
@@ -628,6 +629,14 @@ Google Cloud KMS.`,
 				The field is ignored (both PUT & PATCH) when empty.`,
 			},
 
+			"effective_resource_manager_tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The combination of resource manager tags configured directly on the resource and default resource manager tags configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"scheduling": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -1392,8 +1401,8 @@ func resourceComputeInstanceTemplateCreate(d *schema.ResourceData, meta interfac
 		instanceProperties.Labels = tpgresource.ExpandEffectiveLabels(d)
 	}
 
-	if _, ok := d.GetOk("resource_manager_tags"); ok {
-		instanceProperties.ResourceManagerTags = tpgresource.ExpandStringMap(d, "resource_manager_tags")
+	if _, ok := d.GetOk("effective_resource_manager_tags"); ok {
+		instanceProperties.ResourceManagerTags = tpgresource.ExpandStringMap(d, "effective_resource_manager_tags")
 	}
 
 	var itName string
@@ -1731,6 +1740,9 @@ func resourceComputeInstanceTemplateRead(d *schema.ResourceData, meta interface{
 	if err := d.Set("effective_labels", instanceTemplate.Properties.Labels); err != nil {
 		return fmt.Errorf("Error setting effective_labels: %s", err)
 	}
+	if err := d.Set("effective_resource_manager_tags", instanceTemplate.Properties.ResourceManagerTags); err != nil {
+		return fmt.Errorf("Error setting effective_resource_manager_tags: %s", err)
+	}
 	if err = d.Set("self_link", instanceTemplate.SelfLink); err != nil {
 		return fmt.Errorf("Error setting self_link: %s", err)
 	}
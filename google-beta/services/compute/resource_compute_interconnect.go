@@ -50,7 +50,7 @@ func ResourceComputeInterconnect() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_interconnect"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -36,7 +36,8 @@ func ResourceComputeRegionInstanceTemplate() *schema.Resource {
 			resourceComputeInstanceTemplateSourceImageCustomizeDiff,
 			resourceComputeInstanceTemplateScratchDiskCustomizeDiff,
 			resourceComputeInstanceTemplateBootDiskCustomizeDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_region_instance_template"),
+			tpgresource.SetResourceManagerTagsDiff("resource_manager_tags"),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -591,6 +592,14 @@ Google Cloud KMS.`,
 				Resource manager tag keys and values have the same definition as resource manager tags. Keys must be in the format tagKeys/{tag_key_id}, and values are in the format tagValues/456. The field is ignored (both PUT & PATCH) when empty.`,
 			},
 
+			"effective_resource_manager_tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The combination of resource manager tags configured directly on the resource and default resource manager tags configured on the provider.`,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"scheduling": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -1091,8 +1100,8 @@ func resourceComputeRegionInstanceTemplateCreate(d *schema.ResourceData, meta in
 		instanceProperties.Labels = tpgresource.ExpandEffectiveLabels(d)
 	}
 
-	if _, ok := d.GetOk("resource_manager_tags"); ok {
-		instanceProperties.ResourceManagerTags = tpgresource.ExpandStringMap(d, "resource_manager_tags")
+	if _, ok := d.GetOk("effective_resource_manager_tags"); ok {
+		instanceProperties.ResourceManagerTags = tpgresource.ExpandStringMap(d, "effective_resource_manager_tags")
 	}
 
 	var itName string
@@ -1233,6 +1242,9 @@ func resourceComputeRegionInstanceTemplateRead(d *schema.ResourceData, meta inte
 	if err := d.Set("effective_labels", instanceProperties.Labels); err != nil {
 		return fmt.Errorf("Error setting effective_labels: %s", err)
 	}
+	if err := d.Set("effective_resource_manager_tags", instanceProperties.ResourceManagerTags); err != nil {
+		return fmt.Errorf("Error setting effective_resource_manager_tags: %s", err)
+	}
 	if err = d.Set("self_link", instanceTemplate["selfLink"]); err != nil {
 		return fmt.Errorf("Error setting self_link: %s", err)
 	}
@@ -51,7 +51,7 @@ func ResourceComputeSnapshot() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_snapshot"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -153,7 +153,7 @@ func ResourceComputeVpnTunnel() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_compute_vpn_tunnel"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -124,5 +124,5 @@ func ContainerOperationWait(config *transport_tpg.Config, op *container.Operatio
 		return err
 	}
 
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWaitContext(config.Context, w, activity, timeout, config.PollInterval)
 }
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package container
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+const (
+	kubeconfigEndpointTypePublic  = "PUBLIC"
+	kubeconfigEndpointTypePrivate = "PRIVATE"
+	kubeconfigEndpointTypeDNS     = "DNS"
+)
+
+func DataSourceGoogleContainerClusterKubeconfig() *schema.Resource {
+	dsSchema := KubeconfigSchema()
+	dsSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: `The name of the cluster.`,
+	}
+	dsSchema["location"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: `The location (region or zone) of the cluster. If it is not provided, the provider location is used.`,
+	}
+	dsSchema["project"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: `The ID of the project in which the cluster belongs. If it is not provided, the provider project is used.`,
+	}
+	dsSchema["endpoint_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      kubeconfigEndpointTypePublic,
+		ValidateFunc: validation.StringInSlice([]string{kubeconfigEndpointTypePublic, kubeconfigEndpointTypePrivate, kubeconfigEndpointTypeDNS}, false),
+		Description:  `The endpoint of the control plane the kubeconfig connects to. PUBLIC uses the external IP endpoint, PRIVATE the internal IP endpoint of a private cluster, and DNS the DNS-based endpoint.`,
+	}
+	dsSchema["cluster_ca_certificate"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: `The base64 encoded public certificate of the root of trust of the cluster, used for the IP endpoints.`,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleContainerClusterKubeconfigRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleContainerClusterKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	location, err := tpgresource.GetLocation(d, config)
+	if err != nil {
+		return err
+	}
+	name := d.Get("name").(string)
+
+	// The cluster is read as JSON since the client library doesn't expose the DNS-based endpoint
+	url := config.ContainerBasePath + containerClusterFullName(project, location, name)
+	cluster, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   project,
+		RawURL:    url,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Cluster %q", name), url)
+	}
+
+	endpointType := d.Get("endpoint_type").(string)
+	server, ca, err := kubeconfigClusterEndpoint(cluster, endpointType)
+	if err != nil {
+		return fmt.Errorf("Error reading the %s endpoint of cluster %q: %s", endpointType, name, err)
+	}
+
+	contextName := d.Get("context_name").(string)
+	if contextName == "" {
+		// Matches the context name of gcloud container clusters get-credentials
+		contextName = fmt.Sprintf("gke_%s_%s_%s", project, location, name)
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("location", location); err != nil {
+		return fmt.Errorf("Error setting location: %s", err)
+	}
+	if err := d.Set("cluster_ca_certificate", ca); err != nil {
+		return fmt.Errorf("Error setting cluster_ca_certificate: %s", err)
+	}
+	if err := SetKubeconfig(d, config.TokenSource(), &Kubeconfig{
+		ContextName:              contextName,
+		Server:                   server,
+		CertificateAuthorityData: ca,
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(containerClusterFullName(project, location, name))
+	return nil
+}
+
+// kubeconfigClusterEndpoint returns the URL of the endpoint of the given type of a
+// cluster, and the CA certificate to verify it with. The DNS-based endpoint is
+// served with a publicly trusted certificate, so it has no CA certificate.
+func kubeconfigClusterEndpoint(cluster map[string]interface{}, endpointType string) (string, string, error) {
+	var host, ca string
+	switch endpointType {
+	case kubeconfigEndpointTypePublic:
+		host, _ = cluster["endpoint"].(string)
+		ca = kubeconfigClusterCaCertificate(cluster)
+	case kubeconfigEndpointTypePrivate:
+		if privateClusterConfig, ok := cluster["privateClusterConfig"].(map[string]interface{}); ok {
+			host, _ = privateClusterConfig["privateEndpoint"].(string)
+		}
+		ca = kubeconfigClusterCaCertificate(cluster)
+	case kubeconfigEndpointTypeDNS:
+		if endpointsConfig, ok := cluster["controlPlaneEndpointsConfig"].(map[string]interface{}); ok {
+			if dnsEndpointConfig, ok := endpointsConfig["dnsEndpointConfig"].(map[string]interface{}); ok {
+				host, _ = dnsEndpointConfig["endpoint"].(string)
+			}
+		}
+	default:
+		return "", "", fmt.Errorf("unknown endpoint type %q", endpointType)
+	}
+
+	if host == "" {
+		return "", "", fmt.Errorf("the cluster has no such endpoint")
+	}
+	return "https://" + host, ca, nil
+}
+
+func kubeconfigClusterCaCertificate(cluster map[string]interface{}) string {
+	if masterAuth, ok := cluster["masterAuth"].(map[string]interface{}); ok {
+		ca, _ := masterAuth["clusterCaCertificate"].(string)
+		return ca
+	}
+	return ""
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package container_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+)
+
+func TestAccContainerClusterKubeconfigDatasource_basic(t *testing.T) {
+	t.Parallel()
+
+	networkName := acctest.BootstrapSharedTestNetwork(t, "gke-cluster")
+	subnetworkName := acctest.BootstrapSubnet(t, "gke-cluster", networkName)
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerClusterKubeconfigDatasource_basic(acctest.RandString(t, 10), networkName, subnetworkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.google_container_cluster_kubeconfig.exec", "cluster_ca_certificate", "google_container_cluster.kubes", "master_auth.0.cluster_ca_certificate"),
+					resource.TestMatchResourceAttr("data.google_container_cluster_kubeconfig.exec", "endpoint", regexp.MustCompile(`^https://`)),
+					resource.TestMatchResourceAttr("data.google_container_cluster_kubeconfig.exec", "context_name", regexp.MustCompile(`^gke_.+_us-central1-a_tf-test-cluster-`)),
+					resource.TestMatchResourceAttr("data.google_container_cluster_kubeconfig.exec", "kubeconfig_raw", regexp.MustCompile(`command: gke-gcloud-auth-plugin`)),
+					resource.TestCheckResourceAttr("data.google_container_cluster_kubeconfig.exec", "token", ""),
+					resource.TestCheckResourceAttr("data.google_container_cluster_kubeconfig.token", "context_name", "kubes"),
+					resource.TestCheckResourceAttrSet("data.google_container_cluster_kubeconfig.token", "token"),
+					resource.TestCheckResourceAttrSet("data.google_container_cluster_kubeconfig.token", "token_expiry"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerClusterKubeconfigDatasource_basic(suffix, networkName, subnetworkName string) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "kubes" {
+  name                = "tf-test-cluster-%s"
+  location            = "us-central1-a"
+  initial_node_count  = 1
+  deletion_protection = false
+  network             = "%s"
+  subnetwork          = "%s"
+}
+
+data "google_container_cluster_kubeconfig" "exec" {
+  name     = google_container_cluster.kubes.name
+  location = google_container_cluster.kubes.location
+}
+
+data "google_container_cluster_kubeconfig" "token" {
+  name         = google_container_cluster.kubes.name
+  location     = google_container_cluster.kubes.location
+  auth_type    = "TOKEN"
+  context_name = "kubes"
+}
+`, suffix, networkName, subnetworkName)
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package container
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// KubeconfigAuthTypeExec authenticates with the gke-gcloud-auth-plugin credential plugin
+	KubeconfigAuthTypeExec = "EXEC"
+	// KubeconfigAuthTypeToken authenticates with a short-lived OAuth access token of the provider's credentials
+	KubeconfigAuthTypeToken = "TOKEN"
+)
+
+// Strings are rendered as JSON strings, which are valid YAML double-quoted scalars.
+var kubeconfigTemplate = template.Must(template.New("kubeconfig").Funcs(template.FuncMap{
+	"quote": func(s string) (string, error) {
+		b, err := json.Marshal(s)
+		return string(b), err
+	},
+}).Parse(`apiVersion: v1
+kind: Config
+clusters:
+- name: {{quote .ContextName}}
+  cluster:
+    server: {{quote .Server}}
+{{- if .CertificateAuthorityData}}
+    certificate-authority-data: {{quote .CertificateAuthorityData}}
+{{- end}}
+contexts:
+- name: {{quote .ContextName}}
+  context:
+    cluster: {{quote .ContextName}}
+    user: {{quote .ContextName}}
+current-context: {{quote .ContextName}}
+users:
+- name: {{quote .ContextName}}
+  user:
+{{- if .Token}}
+    token: {{quote .Token}}
+{{- else}}
+    exec:
+      apiVersion: client.authentication.k8s.io/v1beta1
+      command: gke-gcloud-auth-plugin
+      installHint: "Install gke-gcloud-auth-plugin for use with kubectl by following https://cloud.google.com/kubernetes-engine/docs/how-to/cluster-access-for-kubectl#install_plugin"
+      provideClusterInfo: true
+{{- end}}
+`))
+
+// Kubeconfig is a kubeconfig with a single cluster, context and user, all named
+// after the context.
+type Kubeconfig struct {
+	ContextName string
+	Server      string
+	// CertificateAuthorityData is the base64 encoded CA certificate of the server.
+	// If empty, the server's certificate is verified with the system's CAs.
+	CertificateAuthorityData string
+	// Token is an OAuth access token. If empty, the gke-gcloud-auth-plugin is used.
+	Token string
+}
+
+func (k *Kubeconfig) Render() (string, error) {
+	var buf bytes.Buffer
+	if err := kubeconfigTemplate.Execute(&buf, k); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// KubeconfigSchema returns the fields shared by the data sources that render kubeconfigs
+func KubeconfigSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"auth_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      KubeconfigAuthTypeExec,
+			ValidateFunc: validation.StringInSlice([]string{KubeconfigAuthTypeExec, KubeconfigAuthTypeToken}, false),
+			Description:  `How the kubeconfig authenticates. EXEC uses the gke-gcloud-auth-plugin credential plugin, which must be installed where the kubeconfig is used. TOKEN embeds a short-lived OAuth access token of the provider's credentials.`,
+		},
+		"context_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: `The name of the cluster, context and user of the kubeconfig.`,
+		},
+		"endpoint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `The URL of the Kubernetes API server.`,
+		},
+		"token": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: `For the TOKEN auth type, the short-lived OAuth access token.`,
+		},
+		"token_expiry": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: `For the TOKEN auth type, the time the token expires in RFC 3339 format.`,
+		},
+		"kubeconfig_raw": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: `The kubeconfig in YAML.`,
+		},
+	}
+}
+
+// SetKubeconfig renders a kubeconfig, authenticated as configured by auth_type, and
+// sets the fields of KubeconfigSchema
+func SetKubeconfig(d *schema.ResourceData, tokenSource oauth2.TokenSource, k *Kubeconfig) error {
+	if d.Get("auth_type").(string) == KubeconfigAuthTypeToken {
+		if tokenSource == nil {
+			return fmt.Errorf("Error generating a token: the provider has no credentials")
+		}
+		token, err := tokenSource.Token()
+		if err != nil {
+			return fmt.Errorf("Error generating a token: %s", err)
+		}
+		k.Token = token.AccessToken
+
+		if err := d.Set("token", token.AccessToken); err != nil {
+			return fmt.Errorf("Error setting token: %s", err)
+		}
+		expiry := ""
+		if !token.Expiry.IsZero() {
+			expiry = token.Expiry.UTC().Format(time.RFC3339)
+		}
+		if err := d.Set("token_expiry", expiry); err != nil {
+			return fmt.Errorf("Error setting token_expiry: %s", err)
+		}
+	}
+
+	raw, err := k.Render()
+	if err != nil {
+		return fmt.Errorf("Error rendering kubeconfig: %s", err)
+	}
+
+	if err := d.Set("context_name", k.ContextName); err != nil {
+		return fmt.Errorf("Error setting context_name: %s", err)
+	}
+	if err := d.Set("endpoint", k.Server); err != nil {
+		return fmt.Errorf("Error setting endpoint: %s", err)
+	}
+	if err := d.Set("kubeconfig_raw", raw); err != nil {
+		return fmt.Errorf("Error setting kubeconfig_raw: %s", err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package container
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/oauth2"
+)
+
+func TestKubeconfigRender(t *testing.T) {
+	cases := map[string]struct {
+		Kubeconfig Kubeconfig
+		Contains   []string
+		Excludes   []string
+	}{
+		"exec": {
+			Kubeconfig: Kubeconfig{
+				ContextName:              "gke_project_us-central1_cluster",
+				Server:                   "https://10.0.0.1",
+				CertificateAuthorityData: "Y2E=",
+			},
+			Contains: []string{
+				`current-context: "gke_project_us-central1_cluster"`,
+				`server: "https://10.0.0.1"`,
+				`certificate-authority-data: "Y2E="`,
+				`command: gke-gcloud-auth-plugin`,
+			},
+			Excludes: []string{"token:"},
+		},
+		"token without CA": {
+			Kubeconfig: Kubeconfig{
+				ContextName: "context",
+				Server:      "https://gke-1234.us-central1.gke.goog",
+				Token:       "ya29.token",
+			},
+			Contains: []string{`token: "ya29.token"`},
+			Excludes: []string{"certificate-authority-data", "exec:"},
+		},
+		"quoted": {
+			Kubeconfig: Kubeconfig{
+				ContextName: "a: \"b\"\n",
+				Server:      "https://10.0.0.1",
+			},
+			Contains: []string{`current-context: "a: \"b\"\n"`},
+		},
+	}
+
+	for tn, tc := range cases {
+		raw, err := tc.Kubeconfig.Render()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		for _, s := range tc.Contains {
+			if !strings.Contains(raw, s) {
+				t.Errorf("%s: expected kubeconfig to contain %q, got:\n%s", tn, s, raw)
+			}
+		}
+		for _, s := range tc.Excludes {
+			if strings.Contains(raw, s) {
+				t.Errorf("%s: expected kubeconfig not to contain %q, got:\n%s", tn, s, raw)
+			}
+		}
+	}
+}
+
+func TestSetKubeconfig(t *testing.T) {
+	expiry := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	tokenSource := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "ya29.token", Expiry: expiry})
+
+	d := schema.TestResourceDataRaw(t, KubeconfigSchema(), map[string]interface{}{
+		"auth_type": KubeconfigAuthTypeToken,
+	})
+	if err := SetKubeconfig(d, tokenSource, &Kubeconfig{ContextName: "context", Server: "https://10.0.0.1"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := d.Get("token").(string); got != "ya29.token" {
+		t.Errorf("expected token %q, got %q", "ya29.token", got)
+	}
+	if got := d.Get("token_expiry").(string); got != "2024-06-01T12:00:00Z" {
+		t.Errorf("expected token_expiry %q, got %q", "2024-06-01T12:00:00Z", got)
+	}
+	if got := d.Get("endpoint").(string); got != "https://10.0.0.1" {
+		t.Errorf("expected endpoint %q, got %q", "https://10.0.0.1", got)
+	}
+	if got := d.Get("kubeconfig_raw").(string); !strings.Contains(got, `token: "ya29.token"`) {
+		t.Errorf("expected kubeconfig_raw to embed the token, got:\n%s", got)
+	}
+
+	d = schema.TestResourceDataRaw(t, KubeconfigSchema(), map[string]interface{}{})
+	if err := SetKubeconfig(d, nil, &Kubeconfig{ContextName: "context", Server: "https://10.0.0.1"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := d.Get("token").(string); got != "" {
+		t.Errorf("expected no token for the EXEC auth type, got %q", got)
+	}
+
+	d = schema.TestResourceDataRaw(t, KubeconfigSchema(), map[string]interface{}{
+		"auth_type": KubeconfigAuthTypeToken,
+	})
+	if err := SetKubeconfig(d, nil, &Kubeconfig{ContextName: "context", Server: "https://10.0.0.1"}); err == nil {
+		t.Errorf("expected an error for the TOKEN auth type without credentials")
+	}
+}
+
+func TestKubeconfigClusterEndpoint(t *testing.T) {
+	cluster := map[string]interface{}{
+		"endpoint": "34.1.2.3",
+		"masterAuth": map[string]interface{}{
+			"clusterCaCertificate": "Y2E=",
+		},
+		"privateClusterConfig": map[string]interface{}{
+			"privateEndpoint": "10.0.0.2",
+		},
+		"controlPlaneEndpointsConfig": map[string]interface{}{
+			"dnsEndpointConfig": map[string]interface{}{
+				"endpoint": "gke-1234.us-central1.gke.goog",
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		Cluster      map[string]interface{}
+		EndpointType string
+		Server       string
+		CA           string
+		ExpectError  bool
+	}{
+		"public": {
+			Cluster:      cluster,
+			EndpointType: kubeconfigEndpointTypePublic,
+			Server:       "https://34.1.2.3",
+			CA:           "Y2E=",
+		},
+		"private": {
+			Cluster:      cluster,
+			EndpointType: kubeconfigEndpointTypePrivate,
+			Server:       "https://10.0.0.2",
+			CA:           "Y2E=",
+		},
+		"dns": {
+			Cluster:      cluster,
+			EndpointType: kubeconfigEndpointTypeDNS,
+			Server:       "https://gke-1234.us-central1.gke.goog",
+		},
+		"private endpoint of a public cluster": {
+			Cluster:      map[string]interface{}{"endpoint": "34.1.2.3"},
+			EndpointType: kubeconfigEndpointTypePrivate,
+			ExpectError:  true,
+		},
+		"dns endpoint disabled": {
+			Cluster:      map[string]interface{}{"endpoint": "34.1.2.3"},
+			EndpointType: kubeconfigEndpointTypeDNS,
+			ExpectError:  true,
+		},
+	}
+
+	for tn, tc := range cases {
+		server, ca, err := kubeconfigClusterEndpoint(tc.Cluster, tc.EndpointType)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("%s: expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if server != tc.Server || ca != tc.CA {
+			t.Errorf("%s: expected (%q, %q), got (%q, %q)", tn, tc.Server, tc.CA, server, ca)
+		}
+	}
+}
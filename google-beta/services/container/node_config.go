@@ -3,6 +3,8 @@
 package container
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -761,6 +763,87 @@ func schemaNodeConfig() *schema.Schema {
 	}
 }
 
+// schemaNodeConfigWithoutForceNew returns the node config schema with every field
+// updatable, for resources that decide in their CustomizeDiff whether changes to
+// the fields of schemaNodeConfig that are ForceNew replace the resource.
+func schemaNodeConfigWithoutForceNew() *schema.Schema {
+	return schemaWithoutForceNew(schemaNodeConfig())
+}
+
+func schemaWithoutForceNew(s *schema.Schema) *schema.Schema {
+	copied := *s
+	copied.ForceNew = false
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		elem := &schema.Resource{Schema: make(map[string]*schema.Schema, len(r.Schema))}
+		for k, v := range r.Schema {
+			elem.Schema[k] = schemaWithoutForceNew(v)
+		}
+		copied.Elem = elem
+	}
+	return &copied
+}
+
+func schemaHasForceNew(s *schema.Schema) bool {
+	if s.ForceNew {
+		return true
+	}
+	if r, ok := s.Elem.(*schema.Resource); ok {
+		for _, v := range r.Schema {
+			if schemaHasForceNew(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type nodeConfigChange interface {
+	HasChange(string) bool
+	GetChange(string) (interface{}, interface{})
+}
+
+// nodeConfigForceNewChanges returns the keys of the changed node config fields that
+// are ForceNew in schemaNodeConfig, i.e. that can't be updated in place.
+func nodeConfigForceNewChanges(d nodeConfigChange, key string) []string {
+	keys := forceNewChanges(d, key, schemaNodeConfig())
+	sort.Strings(keys)
+	return keys
+}
+
+func forceNewChanges(d nodeConfigChange, key string, s *schema.Schema) []string {
+	if !d.HasChange(key) {
+		return nil
+	}
+
+	r, ok := s.Elem.(*schema.Resource)
+	if !ok {
+		// Elements of lists, sets and maps of primitives are ForceNew with their field
+		if s.ForceNew {
+			return []string{key}
+		}
+		return nil
+	}
+
+	// Like the SDK, a ForceNew block only forces a new resource when blocks are added
+	// or removed, and its fields otherwise decide for themselves. Set elements
+	// are replaced when they change, so only their count is compared.
+	o, n := d.GetChange(key + ".#")
+	if o.(int) != n.(int) || s.Type == schema.TypeSet {
+		if (o.(int) != n.(int) && s.ForceNew) || schemaHasForceNew(&schema.Schema{Elem: r}) {
+			return []string{key}
+		}
+		return nil
+	}
+
+	var keys []string
+	for i := 0; i < n.(int); i++ {
+		for k, v := range r.Schema {
+			keys = append(keys, forceNewChanges(d, fmt.Sprintf("%s.%d.%s", key, i, k), v)...)
+		}
+	}
+	return keys
+}
+
 func expandNodeConfigDefaults(configured interface{}) *container.NodeConfigDefaults {
 	configs := configured.([]interface{})
 	if len(configs) == 0 || configs[0] == nil {
@@ -0,0 +1,321 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package container
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"golang.org/x/oauth2"
+
+	container "google.golang.org/api/container/v1beta1"
+)
+
+// The label GKE sets on the nodes of a node pool
+const gkeNodePoolLabel = "cloud.google.com/gke-nodepool"
+
+// kubernetesClient calls the Kubernetes API of a cluster with the provider's
+// credentials, for the operations of node pools GKE doesn't expose, like draining
+// nodes. Only the few fields the provider needs are decoded.
+type kubernetesClient struct {
+	client    *http.Client
+	endpoint  string
+	userAgent string
+	// pollInterval is how often nodes and pods are listed while waiting for them
+	pollInterval time.Duration
+}
+
+type kubernetesObjectMeta struct {
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+	OwnerReferences []struct {
+		Kind string `json:"kind"`
+	} `json:"ownerReferences,omitempty"`
+}
+
+type kubernetesNode struct {
+	Metadata kubernetesObjectMeta `json:"metadata"`
+	Status   struct {
+		Conditions []struct {
+			Type   string `json:"type"`
+			Status string `json:"status"`
+		} `json:"conditions"`
+	} `json:"status"`
+}
+
+func (n *kubernetesNode) ready() bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == "Ready" {
+			return c.Status == "True"
+		}
+	}
+	return false
+}
+
+type kubernetesPod struct {
+	Metadata kubernetesObjectMeta `json:"metadata"`
+	Status   struct {
+		Phase string `json:"phase"`
+	} `json:"status"`
+}
+
+// evictable returns whether draining a node evicts the pod, like kubectl drain
+// --ignore-daemonsets: DaemonSet pods are recreated on the node anyway, static pods
+// can't be evicted and finished pods don't need to be.
+func (p *kubernetesPod) evictable() bool {
+	if _, ok := p.Metadata.Annotations["kubernetes.io/config.mirror"]; ok {
+		return false
+	}
+	for _, o := range p.Metadata.OwnerReferences {
+		if o.Kind == "DaemonSet" {
+			return false
+		}
+	}
+	return p.Status.Phase != "Succeeded" && p.Status.Phase != "Failed"
+}
+
+type kubernetesError struct {
+	Code    int
+	Message string
+}
+
+func (e *kubernetesError) Error() string {
+	return fmt.Sprintf("Kubernetes API error %d: %s", e.Code, e.Message)
+}
+
+func isKubernetesErrorWithCode(err error, code int) bool {
+	kerr, ok := err.(*kubernetesError)
+	return ok && kerr.Code == code
+}
+
+// newKubernetesClient returns a client of the Kubernetes API of a cluster at its
+// endpoint, which is the private endpoint for clusters without public endpoint.
+func newKubernetesClient(config *transport_tpg.Config, cluster *container.Cluster, userAgent string) (*kubernetesClient, error) {
+	if config.TokenSource() == nil {
+		return nil, fmt.Errorf("the provider has no credentials to call the Kubernetes API with")
+	}
+	if cluster.Endpoint == "" || cluster.MasterAuth == nil {
+		return nil, fmt.Errorf("cluster %q has no endpoint", cluster.Name)
+	}
+
+	ca, err := base64.StdEncoding.DecodeString(cluster.MasterAuth.ClusterCaCertificate)
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding the CA certificate of cluster %q: %s", cluster.Name, err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("Error parsing the CA certificate of cluster %q", cluster.Name)
+	}
+
+	return &kubernetesClient{
+		client: &http.Client{
+			Transport: &oauth2.Transport{
+				Source: config.TokenSource(),
+				Base: &http.Transport{
+					Proxy:           http.ProxyFromEnvironment,
+					TLSClientConfig: &tls.Config{RootCAs: pool},
+				},
+			},
+		},
+		endpoint:     "https://" + cluster.Endpoint,
+		userAgent:    userAgent,
+		pollInterval: 10 * time.Second,
+	}, nil
+}
+
+func (c *kubernetesClient) do(ctx context.Context, method, path, contentType string, body, out interface{}) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", c.userAgent)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		status := struct {
+			Message string `json:"message"`
+		}{}
+		if err := json.Unmarshal(resBody, &status); err != nil || status.Message == "" {
+			status.Message = res.Status
+		}
+		return &kubernetesError{Code: res.StatusCode, Message: status.Message}
+	}
+
+	if out != nil {
+		return json.Unmarshal(resBody, out)
+	}
+	return nil
+}
+
+func (c *kubernetesClient) listNodes(ctx context.Context, nodePool string) ([]kubernetesNode, error) {
+	list := struct {
+		Items []kubernetesNode `json:"items"`
+	}{}
+	query := url.Values{"labelSelector": {gkeNodePoolLabel + "=" + nodePool}}
+	if err := c.do(ctx, "GET", "/api/v1/nodes?"+query.Encode(), "", nil, &list); err != nil {
+		return nil, fmt.Errorf("Error listing the nodes of node pool %q: %s", nodePool, err)
+	}
+	return list.Items, nil
+}
+
+func (c *kubernetesClient) listPods(ctx context.Context, node string) ([]kubernetesPod, error) {
+	list := struct {
+		Items []kubernetesPod `json:"items"`
+	}{}
+	query := url.Values{"fieldSelector": {"spec.nodeName=" + node}}
+	if err := c.do(ctx, "GET", "/api/v1/pods?"+query.Encode(), "", nil, &list); err != nil {
+		return nil, fmt.Errorf("Error listing the pods of node %q: %s", node, err)
+	}
+	return list.Items, nil
+}
+
+func (c *kubernetesClient) cordonNode(ctx context.Context, node string) error {
+	patch := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"unschedulable": true,
+		},
+	}
+	if err := c.do(ctx, "PATCH", "/api/v1/nodes/"+url.PathEscape(node), "application/merge-patch+json", patch, nil); err != nil {
+		return fmt.Errorf("Error cordoning node %q: %s", node, err)
+	}
+	return nil
+}
+
+// evictPod evicts a pod through the eviction API, which refuses with 429 Too Many
+// Requests evictions that would violate a PodDisruptionBudget.
+func (c *kubernetesClient) evictPod(ctx context.Context, pod kubernetesPod) error {
+	eviction := map[string]interface{}{
+		"apiVersion": "policy/v1",
+		"kind":       "Eviction",
+		"metadata": kubernetesObjectMeta{
+			Name:      pod.Metadata.Name,
+			Namespace: pod.Metadata.Namespace,
+		},
+	}
+	path := fmt.Sprintf("/api/v1/namespaces/%s/pods/%s/eviction", url.PathEscape(pod.Metadata.Namespace), url.PathEscape(pod.Metadata.Name))
+	return c.do(ctx, "POST", path, "application/json", eviction, nil)
+}
+
+// waitForNodePoolNodes waits until the expected number of nodes of a node pool are
+// registered and Ready.
+func (c *kubernetesClient) waitForNodePoolNodes(ctx context.Context, nodePool string, expected int, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		nodes, err := c.listNodes(ctx, nodePool)
+		if err != nil {
+			return err
+		}
+		ready := 0
+		for _, n := range nodes {
+			if n.ready() {
+				ready++
+			}
+		}
+		log.Printf("[DEBUG] %d of %d nodes of node pool %q are Ready", ready, expected, nodePool)
+		if ready >= expected {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timeout waiting for the nodes of node pool %q to be Ready: %d of %d are", nodePool, ready, expected)
+		}
+		if err := c.sleep(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// drainNodePool cordons the nodes of a node pool and evicts their pods. Evictions
+// refused because of PodDisruptionBudgets are retried until the soak duration
+// elapses, after which the pods that are left are logged and left to the deletion
+// of the node pool. It returns the number of pods that weren't evicted.
+func (c *kubernetesClient) drainNodePool(ctx context.Context, nodePool string, soakDuration time.Duration) (int, error) {
+	nodes, err := c.listNodes(ctx, nodePool)
+	if err != nil {
+		return 0, err
+	}
+	for _, n := range nodes {
+		if err := c.cordonNode(ctx, n.Metadata.Name); err != nil {
+			return 0, err
+		}
+	}
+
+	deadline := time.Now().Add(soakDuration)
+	for {
+		remaining := 0
+		for _, n := range nodes {
+			pods, err := c.listPods(ctx, n.Metadata.Name)
+			if err != nil {
+				return 0, err
+			}
+			for _, p := range pods {
+				if !p.evictable() {
+					continue
+				}
+				remaining++
+				err := c.evictPod(ctx, p)
+				switch {
+				case err == nil, isKubernetesErrorWithCode(err, 404):
+				case isKubernetesErrorWithCode(err, 429):
+					log.Printf("[DEBUG] eviction of pod %s/%s is blocked by a PodDisruptionBudget, retrying", p.Metadata.Namespace, p.Metadata.Name)
+				default:
+					return 0, fmt.Errorf("Error evicting pod %s/%s: %s", p.Metadata.Namespace, p.Metadata.Name, err)
+				}
+			}
+		}
+
+		if remaining == 0 {
+			return 0, nil
+		}
+		if time.Now().After(deadline) {
+			log.Printf("[WARN] %d pods of node pool %q weren't evicted within %s, they're left to the deletion of the node pool", remaining, nodePool, soakDuration)
+			return remaining, nil
+		}
+		if err := c.sleep(ctx); err != nil {
+			return 0, err
+		}
+	}
+}
+
+func (c *kubernetesClient) sleep(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(c.pollInterval):
+		return nil
+	}
+}
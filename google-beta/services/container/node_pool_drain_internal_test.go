@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package container
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeKubernetesServer serves the nodes and pods of a node pool. Evictions of the
+// pods in pdbBlocked are refused pdbBlocked times before they succeed.
+type fakeKubernetesServer struct {
+	mu         sync.Mutex
+	nodes      map[string]bool
+	pods       map[string][]map[string]interface{}
+	pdbBlocked map[string]int
+	cordoned   []string
+	evicted    []string
+}
+
+func (f *fakeKubernetesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == "GET" && r.URL.Path == "/api/v1/nodes":
+		if r.URL.Query().Get("labelSelector") != gkeNodePoolLabel+"=pool" {
+			http.Error(w, "unexpected label selector", http.StatusBadRequest)
+			return
+		}
+		items := []interface{}{}
+		for name, ready := range f.nodes {
+			status := "False"
+			if ready {
+				status = "True"
+			}
+			items = append(items, map[string]interface{}{
+				"metadata": map[string]interface{}{"name": name},
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": status}},
+				},
+			})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+	case r.Method == "PATCH" && strings.HasPrefix(r.URL.Path, "/api/v1/nodes/"):
+		if r.Header.Get("Content-Type") != "application/merge-patch+json" {
+			http.Error(w, "unexpected content type", http.StatusUnsupportedMediaType)
+			return
+		}
+		f.cordoned = append(f.cordoned, strings.TrimPrefix(r.URL.Path, "/api/v1/nodes/"))
+		w.Write([]byte(`{}`))
+	case r.Method == "GET" && r.URL.Path == "/api/v1/pods":
+		node := strings.TrimPrefix(r.URL.Query().Get("fieldSelector"), "spec.nodeName=")
+		json.NewEncoder(w).Encode(map[string]interface{}{"items": f.pods[node]})
+	case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/eviction"):
+		// /api/v1/namespaces/{namespace}/pods/{name}/eviction
+		parts := strings.Split(r.URL.Path, "/")
+		namespace, name := parts[4], parts[6]
+		if f.pdbBlocked[name] > 0 {
+			f.pdbBlocked[name]--
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"message":"Cannot evict pod as it would violate the pod's disruption budget."}`))
+			return
+		}
+		f.evicted = append(f.evicted, namespace+"/"+name)
+		for node, pods := range f.pods {
+			for i, p := range pods {
+				if p["metadata"].(map[string]interface{})["name"] == name {
+					f.pods[node] = append(pods[:i], pods[i+1:]...)
+					break
+				}
+			}
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{}`))
+	default:
+		http.Error(w, "not found", http.StatusNotFound)
+	}
+}
+
+func fakeKubernetesPod(name string, ownerKind string) map[string]interface{} {
+	metadata := map[string]interface{}{"name": name, "namespace": "default"}
+	if ownerKind != "" {
+		metadata["ownerReferences"] = []interface{}{map[string]interface{}{"kind": ownerKind}}
+	}
+	return map[string]interface{}{
+		"metadata": metadata,
+		"status":   map[string]interface{}{"phase": "Running"},
+	}
+}
+
+func newFakeKubernetesClient(t *testing.T, f *fakeKubernetesServer) *kubernetesClient {
+	s := httptest.NewTLSServer(f)
+	t.Cleanup(s.Close)
+	return &kubernetesClient{
+		client:       s.Client(),
+		endpoint:     s.URL,
+		userAgent:    "test",
+		pollInterval: time.Millisecond,
+	}
+}
+
+func TestKubernetesClientWaitForNodePoolNodes(t *testing.T) {
+	f := &fakeKubernetesServer{nodes: map[string]bool{"node-a": true, "node-b": false}}
+	c := newFakeKubernetesClient(t, f)
+
+	if err := c.waitForNodePoolNodes(context.Background(), "pool", 1, time.Second); err != nil {
+		t.Errorf("unexpected error waiting for 1 Ready node: %s", err)
+	}
+	if err := c.waitForNodePoolNodes(context.Background(), "pool", 2, 50*time.Millisecond); err == nil {
+		t.Errorf("expected a timeout waiting for 2 Ready nodes")
+	}
+}
+
+func TestKubernetesClientDrainNodePool(t *testing.T) {
+	f := &fakeKubernetesServer{
+		nodes: map[string]bool{"node-a": true},
+		pods: map[string][]map[string]interface{}{
+			"node-a": {
+				fakeKubernetesPod("web", "ReplicaSet"),
+				fakeKubernetesPod("db", "StatefulSet"),
+				fakeKubernetesPod("fluentbit", "DaemonSet"),
+			},
+		},
+		pdbBlocked: map[string]int{"db": 3},
+	}
+	c := newFakeKubernetesClient(t, f)
+
+	remaining, err := c.drainNodePool(context.Background(), "pool", time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if remaining != 0 {
+		t.Errorf("expected all pods to be evicted, %d weren't", remaining)
+	}
+	if len(f.cordoned) != 1 || f.cordoned[0] != "node-a" {
+		t.Errorf("expected node-a to be cordoned, got %v", f.cordoned)
+	}
+	if strings.Join(f.evicted, ",") != "default/web,default/db" {
+		t.Errorf("expected web and then db, once its PodDisruptionBudget allowed it, to be evicted, got %v", f.evicted)
+	}
+}
+
+func TestKubernetesClientDrainNodePool_soakDurationElapsed(t *testing.T) {
+	f := &fakeKubernetesServer{
+		nodes: map[string]bool{"node-a": true},
+		pods: map[string][]map[string]interface{}{
+			"node-a": {fakeKubernetesPod("db", "StatefulSet")},
+		},
+		pdbBlocked: map[string]int{"db": 1 << 30},
+	}
+	c := newFakeKubernetesClient(t, f)
+
+	remaining, err := c.drainNodePool(context.Background(), "pool", 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if remaining != 1 {
+		t.Errorf("expected the pod blocked by its PodDisruptionBudget to be left, got %d", remaining)
+	}
+}
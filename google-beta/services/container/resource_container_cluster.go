@@ -199,6 +199,7 @@ func ResourceContainerCluster() *schema.Resource {
 			containerClusterSurgeSettingsCustomizeDiff,
 			containerClusterEnableK8sBetaApisCustomizeDiff,
 			containerClusterNodeVersionCustomizeDiff,
+			containerClusterResourceManagerTagsCustomizeDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -1451,6 +1452,20 @@ func ResourceContainerCluster() *schema.Resource {
 				},
 			},
 
+			"effective_resource_manager_tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The Resource Manager tags bound to the nodes of the default node pool: the tags of node_config merged with the default_resource_manager_tags of the provider.`,
+			},
+
+			"effective_node_pool_auto_config_resource_manager_tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The Resource Manager tags bound to the nodes of auto-provisioned node pools: the tags of node_pool_auto_config merged with the default_resource_manager_tags of the provider.`,
+			},
+
 			"node_version": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -2347,6 +2362,17 @@ func resourceContainerClusterCreate(d *schema.ResourceData, meta interface{}) er
 		cluster.NodeConfig = expandNodeConfig(v)
 	}
 
+	if v, ok := d.GetOk("effective_resource_manager_tags"); ok && cluster.NodeConfig != nil {
+		cluster.NodeConfig.ResourceManagerTags = expandResourceManagerTags(v)
+	}
+
+	if v, ok := d.GetOk("effective_node_pool_auto_config_resource_manager_tags"); ok {
+		if cluster.NodePoolAutoConfig == nil {
+			cluster.NodePoolAutoConfig = &container.NodePoolAutoConfig{}
+		}
+		cluster.NodePoolAutoConfig.ResourceManagerTags = expandResourceManagerTags(v)
+	}
+
 	if v, ok := d.GetOk("authenticator_groups_config"); ok {
 		cluster.AuthenticatorGroupsConfig = expandAuthenticatorGroupsConfig(v)
 	}
@@ -2805,7 +2831,14 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 			return fmt.Errorf("Error setting default_max_pods_per_node: %s", err)
 		}
 	}
-	if err := d.Set("node_config", flattenNodeConfig(cluster.NodeConfig, d.Get("node_config"))); err != nil {
+	nodeConfig := flattenNodeConfig(cluster.NodeConfig, d.Get("node_config"))
+	if len(nodeConfig) > 0 {
+		if err := d.Set("effective_resource_manager_tags", nodeConfig[0]["resource_manager_tags"]); err != nil {
+			return fmt.Errorf("Error setting effective_resource_manager_tags: %s", err)
+		}
+		nodeConfig[0]["resource_manager_tags"] = tpgresource.WithoutDefaultResourceManagerTags(config, nodeConfig[0]["resource_manager_tags"].(map[string]interface{}), d.Get("node_config.0.resource_manager_tags").(map[string]interface{}))
+	}
+	if err := d.Set("node_config", nodeConfig); err != nil {
 		return err
 	}
 	if err := d.Set("project", project); err != nil {
@@ -2896,7 +2929,17 @@ func resourceContainerClusterRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if err := d.Set("node_pool_auto_config", flattenNodePoolAutoConfig(cluster.NodePoolAutoConfig)); err != nil {
+	nodePoolAutoConfig := flattenNodePoolAutoConfig(cluster.NodePoolAutoConfig)
+	if len(nodePoolAutoConfig) > 0 {
+		tags, _ := nodePoolAutoConfig[0]["resource_manager_tags"].(map[string]interface{})
+		if err := d.Set("effective_node_pool_auto_config_resource_manager_tags", tags); err != nil {
+			return fmt.Errorf("Error setting effective_node_pool_auto_config_resource_manager_tags: %s", err)
+		}
+		if tags != nil {
+			nodePoolAutoConfig[0]["resource_manager_tags"] = tpgresource.WithoutDefaultResourceManagerTags(config, tags, d.Get("node_pool_auto_config.0.resource_manager_tags").(map[string]interface{}))
+		}
+	}
+	if err := d.Set("node_pool_auto_config", nodePoolAutoConfig); err != nil {
 		return err
 	}
 
@@ -4171,8 +4214,8 @@ func resourceContainerClusterUpdate(d *schema.ResourceData, meta interface{}) er
 		log.Printf("[INFO] GKE cluster %s node pool auto config network tags have been updated", d.Id())
 	}
 
-	if d.HasChange("node_pool_auto_config.0.resource_manager_tags") {
-		rmtags := d.Get("node_pool_auto_config.0.resource_manager_tags")
+	if d.HasChange("effective_node_pool_auto_config_resource_manager_tags") {
+		rmtags := d.Get("effective_node_pool_auto_config_resource_manager_tags")
 
 		req := &container.UpdateClusterRequest{
 			Update: &container.ClusterUpdate{
@@ -6577,6 +6620,34 @@ func containerClusterEnableK8sBetaApisCustomizeDiffFunc(d tpgresource.TerraformR
 	return nil
 }
 
+// containerClusterResourceManagerTagsCustomizeDiff merges the provider's default
+// Resource Manager tags into the tags of the nodes of the default node pool and
+// of auto-provisioned node pools. The tags of the default node pool can't be
+// updated, so changes of the default tags only apply to it in new clusters.
+func containerClusterResourceManagerTagsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	autopilot := d.Get("enable_autopilot").(bool)
+
+	// Autopilot clusters, and clusters created with node_pool blocks, have no
+	// default node pool
+	defaultNodePool := !autopilot && len(d.Get("node_pool").([]interface{})) == 0
+	if defaultNodePool && (d.Id() == "" || d.HasChange("node_config.0.resource_manager_tags")) {
+		if err := tpgresource.SetEffectiveResourceManagerTagsDiff("node_config.0.resource_manager_tags", "effective_resource_manager_tags")(ctx, d, meta); err != nil {
+			return err
+		}
+	}
+
+	// Node pools are only auto-provisioned in Autopilot clusters and clusters
+	// with node auto-provisioning, the configured tags are used as is otherwise
+	key := "node_pool_auto_config.0.resource_manager_tags"
+	if autopilot || d.Get("cluster_autoscaling.0.enabled").(bool) {
+		return tpgresource.SetEffectiveResourceManagerTagsDiff(key, "effective_node_pool_auto_config_resource_manager_tags")(ctx, d, meta)
+	}
+	if !d.NewValueKnown(key) {
+		return d.SetNewComputed("effective_node_pool_auto_config_resource_manager_tags")
+	}
+	return d.SetNew("effective_node_pool_auto_config_resource_manager_tags", d.Get(key))
+}
+
 func containerClusterNodeVersionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	// separate func to allow unit testing
 	return containerClusterNodeVersionCustomizeDiffFunc(diff)
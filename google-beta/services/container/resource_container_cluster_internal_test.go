@@ -3,10 +3,15 @@
 package container
 
 import (
+	"context"
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 	container "google.golang.org/api/container/v1beta1"
 )
 
@@ -290,3 +295,94 @@ func TestContainerCluster_NodeVersionCustomizeDiff(t *testing.T) {
 		}
 	}
 }
+
+func TestContainerClusterResourceManagerTagsCustomizeDiff(t *testing.T) {
+	t.Parallel()
+
+	config := &transport_tpg.Config{
+		Project:                    "project",
+		DefaultResourceManagerTags: map[string]string{"tagKeys/1": "tagValues/1"},
+	}
+	tags := map[string]interface{}{"tagKeys/2": "tagValues/2"}
+	merged := map[string]string{"tagKeys/1": "tagValues/1", "tagKeys/2": "tagValues/2"}
+
+	cases := map[string]struct {
+		Raw                   map[string]interface{}
+		ExpectNodeConfig      map[string]string
+		ExpectNodePoolAuto    map[string]string
+		NodeConfigTagsUnknown bool
+	}{
+		"standard cluster": {
+			Raw: map[string]interface{}{
+				"node_config":           []interface{}{map[string]interface{}{"resource_manager_tags": tags}},
+				"node_pool_auto_config": []interface{}{map[string]interface{}{"resource_manager_tags": tags}},
+			},
+			ExpectNodeConfig:   merged,
+			ExpectNodePoolAuto: map[string]string{"tagKeys/2": "tagValues/2"},
+		},
+		"node auto-provisioning": {
+			Raw: map[string]interface{}{
+				"cluster_autoscaling":   []interface{}{map[string]interface{}{"enabled": true}},
+				"node_pool_auto_config": []interface{}{map[string]interface{}{"resource_manager_tags": tags}},
+			},
+			ExpectNodeConfig:   map[string]string{"tagKeys/1": "tagValues/1"},
+			ExpectNodePoolAuto: merged,
+		},
+		"autopilot": {
+			Raw: map[string]interface{}{
+				"enable_autopilot":      true,
+				"node_pool_auto_config": []interface{}{map[string]interface{}{"resource_manager_tags": tags}},
+			},
+			ExpectNodePoolAuto:    merged,
+			NodeConfigTagsUnknown: true,
+		},
+		"node pools": {
+			Raw: map[string]interface{}{
+				"node_pool": []interface{}{map[string]interface{}{"name": "pool"}},
+			},
+			ExpectNodePoolAuto:    map[string]string{},
+			NodeConfigTagsUnknown: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		raw := map[string]interface{}{
+			"name":     "cluster",
+			"location": "us-central1",
+		}
+		for k, v := range tc.Raw {
+			raw[k] = v
+		}
+
+		r := ResourceContainerCluster()
+		r.CustomizeDiff = containerClusterResourceManagerTagsCustomizeDiff
+		diff, err := r.Diff(context.Background(), &terraform.InstanceState{}, terraform.NewResourceConfigRaw(raw), config)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+
+		if got := testClusterDiffMap(diff, "effective_node_pool_auto_config_resource_manager_tags"); !reflect.DeepEqual(got, tc.ExpectNodePoolAuto) {
+			t.Errorf("%s: expected effective_node_pool_auto_config_resource_manager_tags %v, got %v", tn, tc.ExpectNodePoolAuto, got)
+		}
+		if tc.NodeConfigTagsUnknown {
+			if attr := diff.Attributes["effective_resource_manager_tags.%"]; attr == nil || !attr.NewComputed {
+				t.Errorf("%s: expected effective_resource_manager_tags to be unknown, got %v", tn, attr)
+			}
+			continue
+		}
+		if got := testClusterDiffMap(diff, "effective_resource_manager_tags"); !reflect.DeepEqual(got, tc.ExpectNodeConfig) {
+			t.Errorf("%s: expected effective_resource_manager_tags %v, got %v", tn, tc.ExpectNodeConfig, got)
+		}
+	}
+}
+
+// testClusterDiffMap returns the planned value of a map attribute of a diff
+func testClusterDiffMap(diff *terraform.InstanceDiff, key string) map[string]string {
+	result := make(map[string]string)
+	for k, attr := range diff.Attributes {
+		if strings.HasPrefix(k, key+".") && k != key+".%" {
+			result[strings.TrimPrefix(k, key+".")] = attr.New
+		}
+	}
+	return result
+}
@@ -3,6 +3,7 @@
 package container
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -47,6 +48,7 @@ func ResourceContainerNodePool() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			resourceNodeConfigEmptyGuestAccelerator,
+			resourceContainerNodePoolReplacementDiff,
 		),
 
 		UseJSONNumber: true,
@@ -78,10 +80,65 @@ func ResourceContainerNodePool() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				// The successor of a node pool replaced in Update has a generated name
+				// and ID, which are unknown until then. Changes to the name force a new
+				// node pool in CustomizeDiff, as ForceNew would also apply to a name
+				// that is only unknown.
+				"id": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: `An identifier for the resource with format {{project}}/{{location}}/{{cluster}}/{{name}}.`,
+				},
+				"name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+					Description: `The name of the node pool. If left blank, Terraform will auto-generate a unique name.`,
+				},
+				// Changes to the fields of node_config that can't be updated in place
+				// replace the node pool as configured by replacement_strategy
+				"node_config": schemaNodeConfigWithoutForceNew(),
+				"replacement_strategy": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{nodePoolReplacementDestroyBeforeCreate, nodePoolReplacementCreateBeforeDestroy}, false),
+					Description:  `How the node pool is replaced when fields of node_config that can't be updated in place change. "destroy_before_create", the default, destroys the node pool and then creates it again. "create_before_destroy" creates a successor node pool with a generated name, waits until its nodes are Ready, drains the node pool and then deletes it. Requires the name to be generated, so it can't be used with name.`,
+				},
+				"replacement_settings": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Description: `Settings of the create_before_destroy replacement strategy.`,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"drain_soak_duration": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          "3600s",
+								ValidateFunc:     verify.ValidateDuration(),
+								DiffSuppressFunc: tpgresource.DurationDiffSuppress,
+								Description:      `How long evictions of the pods of the replaced node pool that are blocked by PodDisruptionBudgets are retried. The pods that are left are evicted by the deletion of the node pool. Shortened to the time left before the update timeout.`,
+							},
+							"node_pool_soak_duration": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Default:          "0s",
+								ValidateFunc:     verify.ValidateDuration(),
+								DiffSuppressFunc: tpgresource.DurationDiffSuppress,
+								Description:      `Time to wait after draining the replaced node pool before deleting it. Shortened to the time left before the update timeout.`,
+							},
+						},
+					},
+				},
 			}),
 	}
 }
 
+const (
+	nodePoolReplacementDestroyBeforeCreate = "destroy_before_create"
+	nodePoolReplacementCreateBeforeDestroy = "create_before_destroy"
+)
+
 var schemaBlueGreenSettings = &schema.Schema{
 	Type:     schema.TypeList,
 	Optional: true,
@@ -747,6 +804,10 @@ func resourceContainerNodePoolUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
+	if d.Get("replacement_strategy").(string) == nodePoolReplacementCreateBeforeDestroy && len(nodeConfigForceNewChanges(d, "node_config")) > 0 {
+		return resourceContainerNodePoolReplace(d, meta, nodePoolInfo, userAgent)
+	}
+
 	d.Partial(true)
 	if err := nodePoolUpdate(d, meta, nodePoolInfo, "", d.Timeout(schema.TimeoutUpdate)); err != nil {
 		return err
@@ -766,6 +827,237 @@ func resourceContainerNodePoolUpdate(d *schema.ResourceData, meta interface{}) e
 	return resourceContainerNodePoolRead(d, meta)
 }
 
+// resourceContainerNodePoolReplacementDiff forces a new node pool when fields of
+// node_config that can't be updated in place change, unless the node pool is
+// replaced by a successor in Update.
+func resourceContainerNodePoolReplacementDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	replacementStrategy := d.Get("replacement_strategy").(string)
+	if replacementStrategy == nodePoolReplacementCreateBeforeDestroy {
+		// The successor gets a new generated name, which would differ from a configured one
+		if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("name").IsNull() {
+			return fmt.Errorf("replacement_strategy %q can't be used with name, use name_prefix instead", nodePoolReplacementCreateBeforeDestroy)
+		}
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("name") {
+		if err := d.ForceNew("name"); err != nil {
+			return err
+		}
+	}
+
+	keys := nodeConfigForceNewChanges(d, "node_config")
+	if len(keys) == 0 {
+		return nil
+	}
+	if replacementStrategy == nodePoolReplacementCreateBeforeDestroy {
+		// The successor replaces the node pool in Update, so the attributes
+		// identifying it are only known after apply
+		for _, k := range []string{"name", "id", "instance_group_urls", "managed_instance_group_urls"} {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, k := range keys {
+		if err := d.ForceNew(k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceContainerNodePoolReplace replaces the node pool with a successor with a
+// generated name without losing capacity: the node pool is only drained and
+// deleted once the nodes of the successor are Ready. If the successor doesn't
+// become Ready, it's deleted and the node pool is kept.
+func resourceContainerNodePoolReplace(d *schema.ResourceData, meta interface{}, nodePoolInfo *NodePoolInformation, userAgent string) error {
+	config := meta.(*transport_tpg.Config)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	startTime := time.Now()
+
+	drainSoakDuration, nodePoolSoakDuration, err := expandNodePoolReplacementSettings(d)
+	if err != nil {
+		return err
+	}
+
+	name := getNodePoolName(d.Id())
+	successorName := resource.UniqueId()
+	if v, ok := d.GetOk("name_prefix"); ok {
+		successorName = resource.PrefixedUniqueId(v.(string))
+	}
+
+	// The successor starts with the current size of the node pool
+	successor, err := expandNodePoolWithNameAndCount(d, "", successorName, d.Get("node_count").(int))
+	if err != nil {
+		return err
+	}
+	// Surge settings are only expanded when they change
+	if successor.UpgradeSettings != nil && successor.UpgradeSettings.Strategy == "SURGE" {
+		successor.UpgradeSettings.MaxSurge = int64(d.Get("upgrade_settings.0.max_surge").(int))
+		successor.UpgradeSettings.MaxUnavailable = int64(d.Get("upgrade_settings.0.max_unavailable").(int))
+	}
+
+	clusterGetCall := config.NewContainerClient(userAgent).Projects.Locations.Clusters.Get(nodePoolInfo.parent())
+	if config.UserProjectOverride {
+		clusterGetCall.Header().Add("X-Goog-User-Project", nodePoolInfo.project)
+	}
+	cluster, err := clusterGetCall.Do()
+	if err != nil {
+		return fmt.Errorf("Error reading cluster %s: %s", nodePoolInfo.cluster, err)
+	}
+	kubernetes, err := newKubernetesClient(config, cluster, userAgent)
+	if err != nil {
+		return fmt.Errorf("Error replacing NodePool %s: %s", name, err)
+	}
+
+	log.Printf("[INFO] Replacing GKE NodePool %s with %s", name, successorName)
+	if err := createContainerNodePoolSuccessor(config, userAgent, nodePoolInfo, successor, timeout-time.Since(startTime)); err != nil {
+		return err
+	}
+
+	if err := awaitContainerNodePoolSuccessor(config, userAgent, nodePoolInfo, kubernetes, successorName, timeout-time.Since(startTime)); err != nil {
+		log.Printf("[WARN] Deleting GKE NodePool %s, which isn't ready to replace %s", successorName, name)
+		if deleteErr := deleteContainerNodePool(config, userAgent, nodePoolInfo, successorName, d.Timeout(schema.TimeoutDelete)); deleteErr != nil {
+			return fmt.Errorf("%s. Additionally, error deleting the successor NodePool %s, which has to be deleted manually: %s", err, successorName, deleteErr)
+		}
+		return err
+	}
+
+	// The successor serves the workloads from now on, so the state tracks it even if
+	// the node pool fails to be deleted
+	d.SetId(nodePoolInfo.fullyQualifiedName(successorName))
+	if err := resourceContainerNodePoolRead(d, meta); err != nil {
+		return err
+	}
+
+	// The soak durations are bounded by the update timeout, after which the node
+	// pool is deleted with the pods that are left
+	deadline := startTime.Add(timeout)
+	drainSoakDuration = boundNodePoolSoakDuration("drain_soak_duration", drainSoakDuration, deadline)
+	if _, err := kubernetes.drainNodePool(config.Context, name, drainSoakDuration); err != nil {
+		return fmt.Errorf("Error draining NodePool %s, which has been replaced with %s and has to be deleted manually: %s", name, successorName, err)
+	}
+	nodePoolSoakDuration = boundNodePoolSoakDuration("node_pool_soak_duration", nodePoolSoakDuration, deadline)
+	if nodePoolSoakDuration > 0 {
+		log.Printf("[DEBUG] Waiting %s before deleting the drained GKE NodePool %s", nodePoolSoakDuration, name)
+		select {
+		case <-config.Context.Done():
+			return fmt.Errorf("Error deleting NodePool %s, which has been replaced with %s and has to be deleted manually: %s", name, successorName, config.Context.Err())
+		case <-time.After(nodePoolSoakDuration):
+		}
+	}
+	if err := deleteContainerNodePool(config, userAgent, nodePoolInfo, name, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("Error deleting NodePool %s, which has been replaced with %s and has to be deleted manually: %s", name, successorName, err)
+	}
+
+	log.Printf("[INFO] GKE NodePool %s has been replaced with %s", name, successorName)
+	return nil
+}
+
+func createContainerNodePoolSuccessor(config *transport_tpg.Config, userAgent string, nodePoolInfo *NodePoolInformation, nodePool *container.NodePool, timeout time.Duration) error {
+	// Acquire read-lock on cluster.
+	clusterLockKey := nodePoolInfo.clusterLockKey()
+	transport_tpg.MutexStore.RLock(clusterLockKey)
+	defer transport_tpg.MutexStore.RUnlock(clusterLockKey)
+
+	// Acquire write-lock on nodepool.
+	npLockKey := nodePoolInfo.nodePoolLockKey(nodePool.Name)
+	transport_tpg.MutexStore.Lock(npLockKey)
+	defer transport_tpg.MutexStore.Unlock(npLockKey)
+
+	req := &container.CreateNodePoolRequest{
+		NodePool: nodePool,
+	}
+
+	startTime := time.Now()
+
+	var operation *container.Operation
+	var err error
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		clusterNodePoolsCreateCall := config.NewContainerClient(userAgent).Projects.Locations.Clusters.NodePools.Create(nodePoolInfo.parent(), req)
+		if config.UserProjectOverride {
+			clusterNodePoolsCreateCall.Header().Add("X-Goog-User-Project", nodePoolInfo.project)
+		}
+		operation, err = clusterNodePoolsCreateCall.Do()
+
+		if err != nil {
+			if tpgresource.IsFailedPreconditionError(err) || tpgresource.IsQuotaError(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error creating successor NodePool %s: %s", nodePool.Name, err)
+	}
+	timeout -= time.Since(startTime)
+
+	return ContainerOperationWait(config, operation, nodePoolInfo.project, nodePoolInfo.location, "creating successor GKE NodePool", userAgent, timeout)
+}
+
+// awaitContainerNodePoolSuccessor waits until a successor node pool is running and
+// all of its nodes are registered and Ready.
+func awaitContainerNodePoolSuccessor(config *transport_tpg.Config, userAgent string, nodePoolInfo *NodePoolInformation, kubernetes *kubernetesClient, name string, timeout time.Duration) error {
+	startTime := time.Now()
+
+	state, err := containerNodePoolAwaitRestingState(config, nodePoolInfo.fullyQualifiedName(name), nodePoolInfo.project, userAgent, timeout)
+	if err != nil {
+		return err
+	}
+	if containerNodePoolRestingStates[state] == ErrorState {
+		return fmt.Errorf("successor NodePool %s was created in the error state %q", name, state)
+	}
+
+	clusterNodePoolsGetCall := config.NewContainerClient(userAgent).Projects.Locations.Clusters.NodePools.Get(nodePoolInfo.fullyQualifiedName(name))
+	if config.UserProjectOverride {
+		clusterNodePoolsGetCall.Header().Add("X-Goog-User-Project", nodePoolInfo.project)
+	}
+	nodePool, err := clusterNodePoolsGetCall.Do()
+	if err != nil {
+		return fmt.Errorf("Error reading successor NodePool %s: %s", name, err)
+	}
+
+	// Node pools have one instance group per zone, each with the initial node count
+	expected := int(nodePool.InitialNodeCount) * len(nodePool.InstanceGroupUrls)
+	return kubernetes.waitForNodePoolNodes(config.Context, name, expected, timeout-time.Since(startTime))
+}
+
+func expandNodePoolReplacementSettings(d *schema.ResourceData) (drainSoakDuration, nodePoolSoakDuration time.Duration, err error) {
+	drainSoakDuration = time.Hour
+	if v, ok := d.GetOk("replacement_settings.0.drain_soak_duration"); ok {
+		if drainSoakDuration, err = time.ParseDuration(v.(string)); err != nil {
+			return 0, 0, fmt.Errorf("Error parsing drain_soak_duration: %s", err)
+		}
+	}
+	if v, ok := d.GetOk("replacement_settings.0.node_pool_soak_duration"); ok {
+		if nodePoolSoakDuration, err = time.ParseDuration(v.(string)); err != nil {
+			return 0, 0, fmt.Errorf("Error parsing node_pool_soak_duration: %s", err)
+		}
+	}
+	return drainSoakDuration, nodePoolSoakDuration, nil
+}
+
+// boundNodePoolSoakDuration shortens a soak duration of a node pool replacement to
+// the time left until the deadline of the update.
+func boundNodePoolSoakDuration(name string, soakDuration time.Duration, deadline time.Time) time.Duration {
+	remaining := time.Until(deadline).Truncate(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	if soakDuration <= remaining {
+		return soakDuration
+	}
+	log.Printf("[WARN] Shortening %s from %s to %s, the time left before the update timeout", name, soakDuration, remaining)
+	return remaining
+}
+
 func resourceContainerNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*transport_tpg.Config)
 	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
@@ -792,6 +1084,18 @@ func resourceContainerNodePoolDelete(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if err := deleteContainerNodePool(config, userAgent, nodePoolInfo, name, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] GKE NodePool %s has been deleted", d.Id())
+
+	d.SetId("")
+
+	return nil
+}
+
+func deleteContainerNodePool(config *transport_tpg.Config, userAgent string, nodePoolInfo *NodePoolInformation, name string, timeout time.Duration) error {
 	// Acquire read-lock on cluster.
 	clusterLockKey := nodePoolInfo.clusterLockKey()
 	transport_tpg.MutexStore.RLock(clusterLockKey)
@@ -802,10 +1106,10 @@ func resourceContainerNodePoolDelete(d *schema.ResourceData, meta interface{}) e
 	transport_tpg.MutexStore.Lock(npLockKey)
 	defer transport_tpg.MutexStore.Unlock(npLockKey)
 
-	timeout := d.Timeout(schema.TimeoutDelete)
 	startTime := time.Now()
 
 	var operation *container.Operation
+	var err error
 	err = resource.Retry(timeout, func() *resource.RetryError {
 		clusterNodePoolsDeleteCall := config.NewContainerClient(userAgent).Projects.Locations.Clusters.NodePools.Delete(nodePoolInfo.fullyQualifiedName(name))
 		if config.UserProjectOverride {
@@ -839,10 +1143,6 @@ func resourceContainerNodePoolDelete(d *schema.ResourceData, meta interface{}) e
 		return waitErr
 	}
 
-	log.Printf("[INFO] GKE NodePool %s has been deleted", d.Id())
-
-	d.SetId("")
-
 	return nil
 }
 
@@ -941,6 +1241,10 @@ func expandNodePool(d *schema.ResourceData, prefix string) (*container.NodePool,
 		nodeCount = nc.(int)
 	}
 
+	return expandNodePoolWithNameAndCount(d, prefix, name, nodeCount)
+}
+
+func expandNodePoolWithNameAndCount(d *schema.ResourceData, prefix, name string, nodeCount int) (*container.NodePool, error) {
 	var locations []string
 	if v, ok := d.GetOk("node_locations"); ok && v.(*schema.Set).Len() > 0 {
 		locations = tpgresource.ConvertStringSet(v.(*schema.Set))
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package container
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func TestResourceContainerNodePoolReplacementDiff(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "projects/project/locations/us-central1/clusters/cluster/nodePools/tf-pool",
+		Attributes: map[string]string{
+			"id":                            "projects/project/locations/us-central1/clusters/cluster/nodePools/tf-pool",
+			"project":                       "project",
+			"location":                      "us-central1",
+			"cluster":                       "cluster",
+			"name":                          "tf-pool",
+			"name_prefix":                   "tf-",
+			"node_config.#":                 "1",
+			"node_config.0.machine_type":    "e2-medium",
+			"node_config.0.preemptible":     "false",
+			"node_config.0.tags.#":          "0",
+			"replacement_strategy":          "",
+			"replacement_settings.#":        "0",
+			"initial_node_count":            "1",
+			"node_count":                    "1",
+			"node_locations.#":              "0",
+			"management.#":                  "0",
+			"upgrade_settings.#":            "0",
+			"network_config.#":              "0",
+			"instance_group_urls.#":         "0",
+			"managed_instance_group_urls.#": "0",
+		},
+	}
+
+	cases := map[string]struct {
+		ReplacementStrategy string
+		Name                string
+		NodeConfig          map[string]interface{}
+		RequiresNew         bool
+		NewComputed         []string
+	}{
+		"updatable field": {
+			NodeConfig:  map[string]interface{}{"machine_type": "e2-standard-4"},
+			RequiresNew: false,
+		},
+		"immutable field": {
+			NodeConfig:  map[string]interface{}{"machine_type": "e2-medium", "preemptible": true},
+			RequiresNew: true,
+		},
+		"immutable field with destroy_before_create": {
+			ReplacementStrategy: nodePoolReplacementDestroyBeforeCreate,
+			NodeConfig:          map[string]interface{}{"machine_type": "e2-medium", "preemptible": true},
+			RequiresNew:         true,
+		},
+		"immutable field with create_before_destroy": {
+			ReplacementStrategy: nodePoolReplacementCreateBeforeDestroy,
+			NodeConfig:          map[string]interface{}{"machine_type": "e2-medium", "preemptible": true},
+			RequiresNew:         false,
+			NewComputed:         []string{"name", "id", "instance_group_urls.#", "managed_instance_group_urls.#"},
+		},
+		"updatable field with create_before_destroy": {
+			ReplacementStrategy: nodePoolReplacementCreateBeforeDestroy,
+			NodeConfig:          map[string]interface{}{"machine_type": "e2-standard-4"},
+			RequiresNew:         false,
+		},
+		"name": {
+			Name:        "other-pool",
+			NodeConfig:  map[string]interface{}{"machine_type": "e2-medium"},
+			RequiresNew: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		raw := map[string]interface{}{
+			"cluster":     "cluster",
+			"location":    "us-central1",
+			"node_config": []interface{}{tc.NodeConfig},
+		}
+		if tc.Name != "" {
+			raw["name"] = tc.Name
+		} else {
+			raw["name_prefix"] = "tf-"
+		}
+		if tc.ReplacementStrategy != "" {
+			raw["replacement_strategy"] = tc.ReplacementStrategy
+		}
+
+		// The other CustomizeDiff functions need the raw config Terraform sends
+		r := ResourceContainerNodePool()
+		r.CustomizeDiff = resourceContainerNodePoolReplacementDiff
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), &transport_tpg.Config{Project: "project"})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		if diff == nil {
+			t.Fatalf("%s: expected a diff", tn)
+		}
+		if got := diff.RequiresNew(); got != tc.RequiresNew {
+			t.Errorf("%s: expected RequiresNew %t, got %t", tn, tc.RequiresNew, got)
+		}
+		if tc.RequiresNew {
+			// All computed attributes are unknown on a new node pool
+			continue
+		}
+		for _, k := range []string{"name", "id", "instance_group_urls.#", "managed_instance_group_urls.#"} {
+			expected := false
+			for _, c := range tc.NewComputed {
+				expected = expected || c == k
+			}
+			if got := diff.Attributes[k] != nil && diff.Attributes[k].NewComputed; got != expected {
+				t.Errorf("%s: expected %s to be computed: %t, got %t", tn, k, expected, got)
+			}
+		}
+	}
+}
+
+func TestNodeConfigForceNewChanges(t *testing.T) {
+	d := ResourceContainerNodePool().TestResourceData()
+	if got := nodeConfigForceNewChanges(d, "node_config"); len(got) != 0 {
+		t.Errorf("expected no changes, got %v", got)
+	}
+
+	if schemaHasForceNew(schemaNodeConfigWithoutForceNew()) {
+		t.Errorf("expected the node config schema without ForceNew to have no ForceNew fields")
+	}
+	if !schemaHasForceNew(schemaNodeConfig()) {
+		t.Errorf("expected the node config schema to have ForceNew fields")
+	}
+}
+
+func TestExpandNodePoolReplacementSettings(t *testing.T) {
+	d := ResourceContainerNodePool().TestResourceData()
+	drain, soak, err := expandNodePoolReplacementSettings(d)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual([]interface{}{drain.String(), soak.String()}, []interface{}{"1h0m0s", "0s"}) {
+		t.Errorf("expected default durations (1h0m0s, 0s), got (%s, %s)", drain, soak)
+	}
+}
+
+func TestBoundNodePoolSoakDuration(t *testing.T) {
+	cases := map[string]struct {
+		SoakDuration time.Duration
+		Remaining    time.Duration
+		Expected     time.Duration
+	}{
+		"within the timeout": {
+			SoakDuration: 5 * time.Minute,
+			Remaining:    20 * time.Minute,
+			Expected:     5 * time.Minute,
+		},
+		"beyond the timeout": {
+			SoakDuration: time.Hour,
+			Remaining:    20 * time.Minute,
+			Expected:     20 * time.Minute,
+		},
+		"timeout elapsed": {
+			SoakDuration: time.Hour,
+			Remaining:    -time.Minute,
+			Expected:     0,
+		},
+	}
+
+	for tn, tc := range cases {
+		// The extra half second elapses before the remaining time is truncated
+		got := boundNodePoolSoakDuration("drain_soak_duration", tc.SoakDuration, time.Now().Add(tc.Remaining+500*time.Millisecond))
+		if got != tc.Expected {
+			t.Errorf("%s: expected %s, got %s", tn, tc.Expected, got)
+		}
+	}
+}
@@ -194,6 +194,51 @@ func TestAccContainerNodePool_namePrefix(t *testing.T) {
 	})
 }
 
+func TestAccContainerNodePool_replacementCreateBeforeDestroy(t *testing.T) {
+	// Randomness
+	acctest.SkipIfVcr(t)
+	t.Parallel()
+
+	cluster := fmt.Sprintf("tf-test-cluster-%s", acctest.RandString(t, 10))
+	networkName := acctest.BootstrapSharedTestNetwork(t, "gke-cluster")
+	subnetworkName := acctest.BootstrapSubnet(t, "gke-cluster", networkName)
+
+	var name string
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccCheckContainerNodePoolDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccContainerNodePool_replacementCreateBeforeDestroy(cluster, networkName, subnetworkName, false),
+				Check: resource.TestCheckResourceAttrWith("google_container_node_pool.np", "name", func(value string) error {
+					name = value
+					return nil
+				}),
+			},
+			{
+				Config: testAccContainerNodePool_replacementCreateBeforeDestroy(cluster, networkName, subnetworkName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_container_node_pool.np", "node_config.0.preemptible", "true"),
+					resource.TestCheckResourceAttr("google_container_node_pool.np", "node_count", "2"),
+					resource.TestCheckResourceAttrWith("google_container_node_pool.np", "name", func(value string) error {
+						if value == name {
+							return fmt.Errorf("expected the node pool %s to be replaced with a successor", name)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            "google_container_node_pool.np",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"name_prefix", "replacement_strategy", "replacement_settings"},
+			},
+		},
+	})
+}
+
 func TestAccContainerNodePool_noName(t *testing.T) {
 	// Randomness
 	acctest.SkipIfVcr(t)
@@ -2456,6 +2501,36 @@ resource "google_container_node_pool" "np" {
 `, cluster, networkName, subnetworkName, np)
 }
 
+func testAccContainerNodePool_replacementCreateBeforeDestroy(cluster, networkName, subnetworkName string, preemptible bool) string {
+	return fmt.Sprintf(`
+resource "google_container_cluster" "cluster" {
+  name                = "%s"
+  location            = "us-central1-a"
+  initial_node_count  = 1
+  deletion_protection = false
+  network             = "%s"
+  subnetwork          = "%s"
+}
+
+resource "google_container_node_pool" "np" {
+  name_prefix          = "tf-np-"
+  location             = "us-central1-a"
+  cluster              = google_container_cluster.cluster.name
+  node_count           = 2
+  replacement_strategy = "create_before_destroy"
+
+  replacement_settings {
+    drain_soak_duration = "300s"
+  }
+
+  node_config {
+    machine_type = "e2-medium"
+    preemptible  = %t
+  }
+}
+`, cluster, networkName, subnetworkName, preemptible)
+}
+
 func testAccContainerNodePool_noName(cluster, networkName, subnetworkName string) string {
 	return fmt.Sprintf(`
 resource "google_container_cluster" "cluster" {
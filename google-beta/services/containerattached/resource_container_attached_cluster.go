@@ -45,7 +45,7 @@ func suppressAttachedClustersLoggingConfigDiff(_, old, new string, d *schema.Res
 }
 
 func ResourceContainerAttachedCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceContainerAttachedClusterCreate,
 		Read:   resourceContainerAttachedClusterRead,
 		Update: resourceContainerAttachedClusterUpdate,
@@ -61,6 +61,8 @@ func ResourceContainerAttachedCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -326,6 +328,13 @@ this is an Azure region.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"errors": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -407,6 +416,10 @@ the Workload Identity Pool.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceContainerAttachedClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -674,6 +687,9 @@ func resourceContainerAttachedClusterRead(d *schema.ResourceData, meta interface
 	if err := d.Set("effective_annotations", flattenContainerAttachedClusterEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Cluster: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenContainerAttachedClusterTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Cluster: %s", err)
+	}
 
 	return nil
 }
@@ -1282,6 +1298,10 @@ func flattenContainerAttachedClusterEffectiveAnnotations(v interface{}, d *schem
 	return v
 }
 
+func flattenContainerAttachedClusterTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandContainerAttachedClusterName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
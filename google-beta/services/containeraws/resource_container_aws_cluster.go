@@ -36,7 +36,7 @@ import (
 )
 
 func ResourceContainerAwsCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceContainerAwsClusterCreate,
 		Read:   resourceContainerAwsClusterRead,
 		Update: resourceContainerAwsClusterUpdate,
@@ -51,6 +51,8 @@ func ResourceContainerAwsCluster() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.SetAnnotationsDiff,
@@ -132,6 +134,13 @@ func ResourceContainerAwsCluster() *schema.Resource {
 				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
 			},
 
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"logging_config": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -207,6 +216,10 @@ func ResourceContainerAwsCluster() *schema.Resource {
 			},
 		},
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func ContainerAwsClusterAuthorizationSchema() *schema.Resource {
@@ -802,6 +815,9 @@ func resourceContainerAwsClusterRead(d *schema.ResourceData, meta interface{}) e
 	if err = d.Set("effective_annotations", res.Annotations); err != nil {
 		return fmt.Errorf("error setting effective_annotations in state: %s", err)
 	}
+	if err = d.Set("terraform_annotations", tpgresource.FlattenTerraformAnnotations(res.Annotations, d, config, "")); err != nil {
+		return fmt.Errorf("error setting terraform_annotations in state: %s", err)
+	}
 	if err = d.Set("logging_config", flattenContainerAwsClusterLoggingConfig(res.LoggingConfig)); err != nil {
 		return fmt.Errorf("error setting logging_config in state: %s", err)
 	}
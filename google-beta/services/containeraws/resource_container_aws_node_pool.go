@@ -36,7 +36,7 @@ import (
 )
 
 func ResourceContainerAwsNodePool() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceContainerAwsNodePoolCreate,
 		Read:   resourceContainerAwsNodePoolRead,
 		Update: resourceContainerAwsNodePoolUpdate,
@@ -51,6 +51,8 @@ func ResourceContainerAwsNodePool() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgdclresource.ResourceContainerAwsNodePoolCustomizeDiffFunc,
@@ -124,6 +126,13 @@ func ResourceContainerAwsNodePool() *schema.Resource {
 				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
 			},
 
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"management": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -195,6 +204,10 @@ func ResourceContainerAwsNodePool() *schema.Resource {
 			},
 		},
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func ContainerAwsNodePoolAutoscalingSchema() *schema.Resource {
@@ -681,6 +694,9 @@ func resourceContainerAwsNodePoolRead(d *schema.ResourceData, meta interface{})
 	if err = d.Set("effective_annotations", res.Annotations); err != nil {
 		return fmt.Errorf("error setting effective_annotations in state: %s", err)
 	}
+	if err = d.Set("terraform_annotations", tpgresource.FlattenTerraformAnnotations(res.Annotations, d, config, "")); err != nil {
+		return fmt.Errorf("error setting terraform_annotations in state: %s", err)
+	}
 	if err = d.Set("management", tpgresource.FlattenContainerAwsNodePoolManagement(res.Management, d, config)); err != nil {
 		return fmt.Errorf("error setting management in state: %s", err)
 	}
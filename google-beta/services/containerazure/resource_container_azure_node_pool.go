@@ -36,7 +36,7 @@ import (
 )
 
 func ResourceContainerAzureNodePool() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceContainerAzureNodePoolCreate,
 		Read:   resourceContainerAzureNodePoolRead,
 		Update: resourceContainerAzureNodePoolUpdate,
@@ -51,6 +51,8 @@ func ResourceContainerAzureNodePool() *schema.Resource {
 			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
 			tpgresource.SetAnnotationsDiff,
@@ -131,6 +133,13 @@ func ResourceContainerAzureNodePool() *schema.Resource {
 				Description: "All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.",
 			},
 
+			"terraform_annotations": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The combination of annotations configured directly on the resource and default annotations configured on the provider.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
 			"management": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -193,6 +202,10 @@ func ResourceContainerAzureNodePool() *schema.Resource {
 			},
 		},
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func ContainerAzureNodePoolAutoscalingSchema() *schema.Resource {
@@ -483,6 +496,9 @@ func resourceContainerAzureNodePoolRead(d *schema.ResourceData, meta interface{}
 	if err = d.Set("effective_annotations", res.Annotations); err != nil {
 		return fmt.Errorf("error setting effective_annotations in state: %s", err)
 	}
+	if err = d.Set("terraform_annotations", tpgresource.FlattenTerraformAnnotations(res.Annotations, d, config, "")); err != nil {
+		return fmt.Errorf("error setting terraform_annotations in state: %s", err)
+	}
 	if err = d.Set("management", tpgresource.FlattenContainerAzureNodePoolManagement(res.Management, d, config)); err != nil {
 		return fmt.Errorf("error setting management in state: %s", err)
 	}
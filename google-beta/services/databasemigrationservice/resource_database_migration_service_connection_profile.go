@@ -51,7 +51,7 @@ func ResourceDatabaseMigrationServiceConnectionProfile() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_database_migration_service_connection_profile"),
 			tpgresource.DefaultProviderProject,
 		),
 
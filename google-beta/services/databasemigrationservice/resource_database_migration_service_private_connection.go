@@ -49,7 +49,7 @@ func ResourceDatabaseMigrationServicePrivateConnection() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_database_migration_service_private_connection"),
 			tpgresource.DefaultProviderProject,
 		),
 
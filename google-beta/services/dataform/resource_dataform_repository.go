@@ -49,7 +49,7 @@ func ResourceDataformRepository() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataform_repository"),
 			tpgresource.DefaultProviderProject,
 		),
 
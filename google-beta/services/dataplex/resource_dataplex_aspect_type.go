@@ -53,7 +53,7 @@ func ResourceDataplexAspectType() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataplex_aspect_type"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -53,7 +53,7 @@ func ResourceDataplexAsset() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataplex_asset"),
 		),
 
 		Schema: map[string]*schema.Schema{
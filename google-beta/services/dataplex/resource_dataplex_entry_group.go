@@ -50,7 +50,7 @@ func ResourceDataplexEntryGroup() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataplex_entry_group"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceDataplexEntryType() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataplex_entry_type"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -53,7 +53,7 @@ func ResourceDataplexLake() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataplex_lake"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -51,7 +51,7 @@ func ResourceDataplexTask() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataplex_task"),
 			tpgresource.DefaultProviderProject,
 		),
 
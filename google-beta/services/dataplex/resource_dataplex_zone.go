@@ -53,7 +53,7 @@ func ResourceDataplexZone() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataplex_zone"),
 		),
 
 		Schema: map[string]*schema.Schema{
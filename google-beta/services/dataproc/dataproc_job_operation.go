@@ -78,7 +78,7 @@ func DataprocJobOperationWait(config *transport_tpg.Config, region, projectId, j
 		ProjectId: projectId,
 		JobId:     jobId,
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWaitContext(config.Context, w, activity, timeout, config.PollInterval)
 }
 
 type DataprocDeleteJobOperationWaiter struct {
@@ -118,5 +118,5 @@ func DataprocDeleteOperationWait(config *transport_tpg.Config, region, projectId
 			JobId:     jobId,
 		},
 	}
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWaitContext(config.Context, w, activity, timeout, config.PollInterval)
 }
@@ -34,7 +34,7 @@ func ResourceDataprocJob() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataproc_job"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -53,7 +53,7 @@ func ResourceDataprocWorkflowTemplate() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataproc_workflow_template"),
 		),
 		SchemaVersion: 1,
 		StateUpgraders: []schema.StateUpgrader{
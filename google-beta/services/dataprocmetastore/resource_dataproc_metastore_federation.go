@@ -51,7 +51,7 @@ func ResourceDataprocMetastoreFederation() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataproc_metastore_federation"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -51,7 +51,7 @@ func ResourceDataprocMetastoreService() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dataproc_metastore_service"),
 			tpgresource.DefaultProviderProject,
 		),
 
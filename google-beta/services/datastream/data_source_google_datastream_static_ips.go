@@ -52,10 +52,17 @@ func dataSourceGoogleDatastreamStaticIpsRead(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	staticIps, err := tpgresource.PaginatedListRequest(project, url, userAgent, config, flattenStaticIpsList)
+	ipList, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:    config,
+		Project:   project,
+		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "staticIps",
+	})
 	if err != nil {
 		return fmt.Errorf("Error retrieving monitoring uptime check ips: %s", err)
 	}
+	staticIps := flattenStaticIpsList(ipList)
 
 	if err := d.Set("static_ips", staticIps); err != nil {
 		return fmt.Errorf("Error retrieving monitoring uptime check ips: %s", err)
@@ -70,8 +77,7 @@ func dataSourceGoogleDatastreamStaticIpsRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func flattenStaticIpsList(resp map[string]interface{}) []interface{} {
-	ipList := resp["staticIps"].([]interface{})
+func flattenStaticIpsList(ipList []interface{}) []interface{} {
 	staticIps := make([]interface{}, len(ipList))
 	for i, u := range ipList {
 		staticIps[i] = u
@@ -50,7 +50,7 @@ func ResourceDatastreamConnectionProfile() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_datastream_connection_profile"),
 			tpgresource.DefaultProviderProject,
 		),
 
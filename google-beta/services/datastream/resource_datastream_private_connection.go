@@ -93,7 +93,7 @@ func ResourceDatastreamPrivateConnection() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_datastream_private_connection"),
 			tpgresource.DefaultProviderProject,
 		),
 
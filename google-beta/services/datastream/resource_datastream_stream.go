@@ -121,7 +121,7 @@ func ResourceDatastreamStream() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceDatastreamStreamCustomDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_datastream_stream"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -67,7 +67,7 @@ func DeploymentManagerOperationWaitTime(config *transport_tpg.Config, resp inter
 		return err
 	}
 
-	return tpgresource.OperationWait(w, activity, timeout, config.PollInterval)
+	return tpgresource.OperationWaitContext(config.Context, w, activity, timeout, config.PollInterval)
 }
 
 func (w *DeploymentManagerOperationWaiter) Error() error {
@@ -52,7 +52,7 @@ func ResourceDialogflowCXIntent() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dialogflow_cx_intent"),
 		),
 
 		Schema: map[string]*schema.Schema{
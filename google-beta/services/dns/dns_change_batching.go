@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package dns
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"google.golang.org/api/dns/v1"
+)
+
+const (
+	batchKeyTmplDnsChanges = "projects/%s/managedZones/%s/changes"
+
+	// dnsChangeMaxBatchSize keeps combined changes within the default
+	// per-change quota for record set additions and deletions.
+	dnsChangeMaxBatchSize = 100
+)
+
+// BatchRequestDnsChange applies chg to the managed zone and waits for it to be
+// done. Changes to the same zone requested by several google_dns_record_set
+// resources are combined and applied as a single DNS Change, which is waited for
+// within the timeout of the request that started the batch.
+func BatchRequestDnsChange(chg *dns.Change, project, zone, userAgent string, config *transport_tpg.Config, reqDesc string, timeout time.Duration) (*dns.Change, error) {
+	req := &transport_tpg.BatchRequest{
+		ResourceName: zone,
+		Body:         chg,
+		CombineF:     transport_tpg.NewBatcherCombineFunc(combineDnsChanges),
+		SendF:        sendBatchDnsChange(config, project, userAgent, timeout),
+		DebugId:      reqDesc,
+		MaxBatchSize: dnsChangeMaxBatchSize,
+	}
+
+	v, err := config.RequestBatcherDns.SendRequestWithTimeout(
+		fmt.Sprintf(batchKeyTmplDnsChanges, project, zone),
+		req,
+		timeout)
+	if err != nil {
+		return nil, err
+	}
+	return v.(*dns.Change), nil
+}
+
+// combineDnsChanges returns a new change with the additions and deletions of
+// both changes, leaving them unmodified as they may be resent alone.
+func combineDnsChanges(chg *dns.Change, toAdd *dns.Change) (*dns.Change, error) {
+	combined := &dns.Change{}
+	for _, c := range []*dns.Change{chg, toAdd} {
+		combined.Additions = append(combined.Additions, c.Additions...)
+		combined.Deletions = append(combined.Deletions, c.Deletions...)
+	}
+	return combined, nil
+}
+
+func sendBatchDnsChange(config *transport_tpg.Config, project, userAgent string, timeout time.Duration) transport_tpg.BatcherSendFunc {
+	return transport_tpg.NewBatcherSendFunc(func(zone string, chg *dns.Change) (interface{}, error) {
+		log.Printf("[DEBUG] DNS change request for %q: %d additions, %d deletions", zone, len(chg.Additions), len(chg.Deletions))
+		chg, err := config.NewDnsClient(userAgent).Changes.Create(project, zone, chg).Do()
+		if err != nil {
+			return nil, err
+		}
+
+		w := &DnsChangeWaiter{
+			Service:     config.NewDnsClient(userAgent),
+			Change:      chg,
+			Project:     project,
+			ManagedZone: zone,
+		}
+		conf := w.Conf()
+		conf.Timeout = timeout
+		if _, err := conf.WaitForState(); err != nil {
+			return nil, fmt.Errorf("Error waiting for Google DNS change: %s", err)
+		}
+		return chg, nil
+	})
+}
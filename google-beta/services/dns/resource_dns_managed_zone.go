@@ -54,7 +54,7 @@ func ResourceDNSManagedZone() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_dns_managed_zone"),
 			tpgresource.DefaultProviderProject,
 		),
 
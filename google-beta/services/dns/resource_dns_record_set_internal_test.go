@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+
+	"google.golang.org/api/dns/v1"
 )
 
 func TestValidateRecordNameTrailingDot(t *testing.T) {
@@ -23,3 +25,29 @@ func TestValidateRecordNameTrailingDot(t *testing.T) {
 		t.Errorf("Failed to validate DNS Record name with value: %v", es)
 	}
 }
+
+func TestCombineDnsChanges(t *testing.T) {
+	a := &dns.ResourceRecordSet{Name: "a.hashicorptest.com.", Type: "A"}
+	b := &dns.ResourceRecordSet{Name: "b.hashicorptest.com.", Type: "A"}
+	oldB := &dns.ResourceRecordSet{Name: "b.hashicorptest.com.", Type: "A", Ttl: 60}
+
+	create := &dns.Change{Additions: []*dns.ResourceRecordSet{a}}
+	update := &dns.Change{
+		Additions: []*dns.ResourceRecordSet{b},
+		Deletions: []*dns.ResourceRecordSet{oldB},
+	}
+
+	combined, err := combineDnsChanges(create, update)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(combined.Additions) != 2 || combined.Additions[0] != a || combined.Additions[1] != b {
+		t.Errorf("expected the additions of both changes, got %v", combined.Additions)
+	}
+	if len(combined.Deletions) != 1 || combined.Deletions[0] != oldB {
+		t.Errorf("expected the deletions of both changes, got %v", combined.Deletions)
+	}
+	if len(create.Additions) != 1 || len(create.Deletions) != 0 {
+		t.Errorf("expected the combined changes to be left unmodified, got %v", create)
+	}
+}
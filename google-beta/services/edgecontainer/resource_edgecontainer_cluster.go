@@ -51,7 +51,7 @@ func ResourceEdgecontainerCluster() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_edgecontainer_cluster"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceEdgecontainerNodePool() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_edgecontainer_node_pool"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -49,7 +49,7 @@ func ResourceEdgecontainerVpnConnection() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_edgecontainer_vpn_connection"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -53,7 +53,7 @@ func ResourceEventarcTrigger() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_eventarc_trigger"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +50,7 @@ func ResourceFilestoreBackup() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_filestore_backup"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -62,7 +62,7 @@ func ResourceFilestoreInstance() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_filestore_instance"),
 			tpgresource.DefaultProviderProject,
 		),
 
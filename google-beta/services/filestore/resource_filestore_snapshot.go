@@ -50,7 +50,7 @@ func ResourceFilestoreSnapshot() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_filestore_snapshot"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceFirebaseHostingChannel() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_firebase_hosting_channel"),
 		),
 
 		Schema: map[string]*schema.Schema{
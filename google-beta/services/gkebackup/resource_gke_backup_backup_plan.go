@@ -51,7 +51,7 @@ func ResourceGKEBackupBackupPlan() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_gke_backup_backup_plan"),
 			tpgresource.DefaultProviderProject,
 		),
 
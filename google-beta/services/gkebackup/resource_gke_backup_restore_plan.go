@@ -51,7 +51,7 @@ func ResourceGKEBackupRestorePlan() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_gke_backup_restore_plan"),
 			tpgresource.DefaultProviderProject,
 		),
 
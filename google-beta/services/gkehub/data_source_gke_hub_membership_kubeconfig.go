@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package gkehub
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/services/container"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func DataSourceGoogleGkeHubMembershipKubeconfig() *schema.Resource {
+	dsSchema := container.KubeconfigSchema()
+	dsSchema["membership_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: `The ID of the fleet membership.`,
+	}
+	dsSchema["location"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "global",
+		Description: `The location of the fleet membership.`,
+	}
+	dsSchema["project"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: `The ID of the fleet host project of the membership. If it is not provided, the provider project is used.`,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceGoogleGkeHubMembershipKubeconfigRead,
+		Schema: dsSchema,
+	}
+}
+
+func dataSourceGoogleGkeHubMembershipKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+	location := d.Get("location").(string)
+	membershipId := d.Get("membership_id").(string)
+	id := fmt.Sprintf("projects/%s/locations/%s/memberships/%s", project, location, membershipId)
+
+	url := config.GKEHubBasePath + id
+	if _, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   project,
+		RawURL:    url,
+		UserAgent: userAgent,
+	}); err != nil {
+		return transport_tpg.HandleDataSourceNotFoundError(err, d, fmt.Sprintf("Membership %q", membershipId), url)
+	}
+
+	// The Connect gateway only accepts project numbers
+	p, err := config.NewResourceManagerClient(userAgent).Projects.Get(project).Do()
+	if err != nil {
+		return fmt.Errorf("Error reading project %q: %s", project, err)
+	}
+
+	contextName := d.Get("context_name").(string)
+	if contextName == "" {
+		// Matches the context name of gcloud container fleet memberships get-credentials
+		contextName = fmt.Sprintf("connectgateway_%s_%s_%s", project, location, membershipId)
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	// The Connect gateway is served with a publicly trusted certificate
+	if err := container.SetKubeconfig(d, config.TokenSource(), &container.Kubeconfig{
+		ContextName: contextName,
+		Server:      connectGatewayServer(p.ProjectNumber, location, membershipId),
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(id)
+	return nil
+}
+
+// connectGatewayServer returns the URL of the Connect gateway of a membership.
+// Memberships outside of the global location are served by regional gateways.
+func connectGatewayServer(projectNumber int64, location, membershipId string) string {
+	host := "connectgateway.googleapis.com"
+	if location != "global" {
+		host = location + "-" + host
+	}
+	return fmt.Sprintf("https://%s/v1/projects/%d/locations/%s/gkeMemberships/%s", host, projectNumber, location, membershipId)
+}
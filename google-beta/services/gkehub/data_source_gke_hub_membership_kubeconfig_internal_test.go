@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package gkehub
+
+import "testing"
+
+func TestConnectGatewayServer(t *testing.T) {
+	cases := map[string]struct {
+		Location string
+		Expected string
+	}{
+		"global": {
+			Location: "global",
+			Expected: "https://connectgateway.googleapis.com/v1/projects/1234/locations/global/gkeMemberships/membership",
+		},
+		"regional": {
+			Location: "us-central1",
+			Expected: "https://us-central1-connectgateway.googleapis.com/v1/projects/1234/locations/us-central1/gkeMemberships/membership",
+		},
+	}
+
+	for tn, tc := range cases {
+		if got := connectGatewayServer(1234, tc.Location, "membership"); got != tc.Expected {
+			t.Errorf("%s: expected %q, got %q", tn, tc.Expected, got)
+		}
+	}
+}
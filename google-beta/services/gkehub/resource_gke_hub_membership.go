@@ -70,7 +70,7 @@ func ResourceGKEHubMembership() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_gke_hub_membership"),
 			tpgresource.DefaultProviderProject,
 		),
 
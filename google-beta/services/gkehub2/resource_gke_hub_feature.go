@@ -51,7 +51,7 @@ func ResourceGKEHub2Feature() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_gke_hub_feature"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceGKEHub2MembershipBinding() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_gke_hub_membership_binding"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceGKEHub2Namespace() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_gke_hub_namespace"),
 			tpgresource.DefaultProviderProject,
 		),
 
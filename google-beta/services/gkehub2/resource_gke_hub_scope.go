@@ -50,7 +50,7 @@ func ResourceGKEHub2Scope() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_gke_hub_scope"),
 			tpgresource.DefaultProviderProject,
 		),
 
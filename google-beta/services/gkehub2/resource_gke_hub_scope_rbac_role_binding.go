@@ -51,7 +51,7 @@ func ResourceGKEHub2ScopeRBACRoleBinding() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_gke_hub_scope_rbac_role_binding"),
 			tpgresource.DefaultProviderProject,
 		),
 
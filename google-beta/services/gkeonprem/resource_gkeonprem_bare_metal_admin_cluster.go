@@ -34,7 +34,7 @@ import (
 )
 
 func ResourceGkeonpremBareMetalAdminCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceGkeonpremBareMetalAdminClusterCreate,
 		Read:   resourceGkeonpremBareMetalAdminClusterRead,
 		Update: resourceGkeonpremBareMetalAdminClusterUpdate,
@@ -50,6 +50,8 @@ func ResourceGkeonpremBareMetalAdminCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -528,6 +530,13 @@ automatically created during cluster creation.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -721,6 +730,10 @@ indicate real problems requiring user intervention.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceGkeonpremBareMetalAdminClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -993,6 +1006,9 @@ func resourceGkeonpremBareMetalAdminClusterRead(d *schema.ResourceData, meta int
 	if err := d.Set("effective_annotations", flattenGkeonpremBareMetalAdminClusterEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading BareMetalAdminCluster: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenGkeonpremBareMetalAdminClusterTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading BareMetalAdminCluster: %s", err)
+	}
 
 	return nil
 }
@@ -1948,6 +1964,10 @@ func flattenGkeonpremBareMetalAdminClusterEffectiveAnnotations(v interface{}, d
 	return v
 }
 
+func flattenGkeonpremBareMetalAdminClusterTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandGkeonpremBareMetalAdminClusterDescription(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
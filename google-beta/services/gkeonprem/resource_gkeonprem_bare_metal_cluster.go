@@ -34,7 +34,7 @@ import (
 )
 
 func ResourceGkeonpremBareMetalCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceGkeonpremBareMetalClusterCreate,
 		Read:   resourceGkeonpremBareMetalClusterRead,
 		Update: resourceGkeonpremBareMetalClusterUpdate,
@@ -50,6 +50,8 @@ func ResourceGkeonpremBareMetalCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -1012,6 +1014,13 @@ Examples: ["127.0.0.1", "example.com", ".corp", "localhost"].`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -1205,6 +1214,10 @@ indicate real problems requiring user intervention.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceGkeonpremBareMetalClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -1513,6 +1526,9 @@ func resourceGkeonpremBareMetalClusterRead(d *schema.ResourceData, meta interfac
 	if err := d.Set("effective_annotations", flattenGkeonpremBareMetalClusterEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading BareMetalCluster: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenGkeonpremBareMetalClusterTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading BareMetalCluster: %s", err)
+	}
 
 	return nil
 }
@@ -3085,6 +3101,10 @@ func flattenGkeonpremBareMetalClusterEffectiveAnnotations(v interface{}, d *sche
 	return v
 }
 
+func flattenGkeonpremBareMetalClusterTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandGkeonpremBareMetalClusterAdminClusterMembership(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -34,7 +34,7 @@ import (
 )
 
 func ResourceGkeonpremBareMetalNodePool() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceGkeonpremBareMetalNodePoolCreate,
 		Read:   resourceGkeonpremBareMetalNodePoolRead,
 		Update: resourceGkeonpremBareMetalNodePoolUpdate,
@@ -50,6 +50,8 @@ func ResourceGkeonpremBareMetalNodePool() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -201,6 +203,13 @@ Please refer to the field 'effective_annotations' for all of the annotations pre
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -292,6 +301,10 @@ indicate real problems requiring user intervention.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceGkeonpremBareMetalNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
@@ -462,6 +475,9 @@ func resourceGkeonpremBareMetalNodePoolRead(d *schema.ResourceData, meta interfa
 	if err := d.Set("effective_annotations", flattenGkeonpremBareMetalNodePoolEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading BareMetalNodePool: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenGkeonpremBareMetalNodePoolTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading BareMetalNodePool: %s", err)
+	}
 
 	return nil
 }
@@ -838,6 +854,10 @@ func flattenGkeonpremBareMetalNodePoolEffectiveAnnotations(v interface{}, d *sch
 	return v
 }
 
+func flattenGkeonpremBareMetalNodePoolTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandGkeonpremBareMetalNodePoolDisplayName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
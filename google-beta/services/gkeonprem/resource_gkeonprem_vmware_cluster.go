@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceGkeonpremVmwareCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceGkeonpremVmwareClusterCreate,
 		Read:   resourceGkeonpremVmwareClusterRead,
 		Update: resourceGkeonpremVmwareClusterUpdate,
@@ -49,6 +49,8 @@ func ResourceGkeonpremVmwareCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -709,6 +711,13 @@ Inherited from the admin cluster.`,
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"endpoint": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -898,6 +907,10 @@ indicate real problems requiring user intervention.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceGkeonpremVmwareClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -1206,6 +1219,9 @@ func resourceGkeonpremVmwareClusterRead(d *schema.ResourceData, meta interface{}
 	if err := d.Set("effective_annotations", flattenGkeonpremVmwareClusterEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading VmwareCluster: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenGkeonpremVmwareClusterTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading VmwareCluster: %s", err)
+	}
 
 	return nil
 }
@@ -2478,6 +2494,10 @@ func flattenGkeonpremVmwareClusterEffectiveAnnotations(v interface{}, d *schema.
 	return v
 }
 
+func flattenGkeonpremVmwareClusterTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandGkeonpremVmwareClusterAdminClusterMembership(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -34,7 +34,7 @@ import (
 )
 
 func ResourceGkeonpremVmwareNodePool() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceGkeonpremVmwareNodePoolCreate,
 		Read:   resourceGkeonpremVmwareNodePoolRead,
 		Update: resourceGkeonpremVmwareNodePoolUpdate,
@@ -50,6 +50,8 @@ func ResourceGkeonpremVmwareNodePool() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
@@ -261,6 +263,13 @@ Please refer to the field 'effective_annotations' for all of the annotations pre
 				Description: `All of annotations (key/value pairs) present on the resource in GCP, including the annotations configured through Terraform, other clients and services.`,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"etag": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -357,6 +366,10 @@ indicate real problems requiring user intervention.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceGkeonpremVmwareNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
@@ -539,6 +552,9 @@ func resourceGkeonpremVmwareNodePoolRead(d *schema.ResourceData, meta interface{
 	if err := d.Set("effective_annotations", flattenGkeonpremVmwareNodePoolEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading VmwareNodePool: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenGkeonpremVmwareNodePoolTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading VmwareNodePool: %s", err)
+	}
 
 	return nil
 }
@@ -1091,6 +1107,10 @@ func flattenGkeonpremVmwareNodePoolEffectiveAnnotations(v interface{}, d *schema
 	return v
 }
 
+func flattenGkeonpremVmwareNodePoolTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandGkeonpremVmwareNodePoolDisplayName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
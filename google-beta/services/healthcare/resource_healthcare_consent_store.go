@@ -50,7 +50,7 @@ func ResourceHealthcareConsentStore() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_healthcare_consent_store"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +50,7 @@ func ResourceHealthcareDicomStore() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_healthcare_dicom_store"),
 		),
 
 		Schema: map[string]*schema.Schema{
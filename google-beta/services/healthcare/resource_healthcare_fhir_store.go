@@ -51,7 +51,7 @@ func ResourceHealthcareFhirStore() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_healthcare_fhir_store"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -54,7 +54,7 @@ func ResourceHealthcareHl7V2Store() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_healthcare_hl7_v2_store"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -175,7 +175,7 @@ func resourceIapBrandCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 	d.SetId(name.(string))
 
-	err = transport_tpg.PollingWaitTime(resourceIapBrandPollRead(d, meta), transport_tpg.PollCheckForExistence, "Creating Brand", d.Timeout(schema.TimeoutCreate), 5)
+	err = transport_tpg.PollingWaitTimeContext(config.Context, resourceIapBrandPollRead(d, meta), transport_tpg.PollCheckForExistence, "Creating Brand", d.Timeout(schema.TimeoutCreate), 5)
 	if err != nil {
 		return fmt.Errorf("Error waiting to create Brand: %s", err)
 	}
@@ -71,7 +71,7 @@ func ResourceIntegrationConnectorsConnection() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_integration_connectors_connection"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceIntegrationConnectorsEndpointAttachment() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_integration_connectors_endpoint_attachment"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceIntegrationConnectorsManagedZone() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_integration_connectors_managed_zone"),
 			tpgresource.DefaultProviderProject,
 		),
 
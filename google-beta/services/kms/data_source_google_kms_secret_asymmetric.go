@@ -109,7 +109,7 @@ func dataSourceGoogleKmsSecretAsymmetricRead(ctx context.Context, d *schema.Reso
 		return fmt.Errorf("failed to get a KMS client")
 	}
 
-	result, err := client.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.AsymmetricDecrypt(cryptoKeyVersion, &req).Do()
+	result, err := client.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.AsymmetricDecrypt(cryptoKeyVersion, &req).Context(ctx).Do()
 	if err != nil {
 		return fmt.Errorf("failed to decrypt ciphertext: %v", err)
 	}
@@ -62,7 +62,7 @@ func ResourceKMSCryptoKey() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_kms_crypto_key"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -52,7 +52,7 @@ func ResourceMemcacheInstance() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_memcache_instance"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceMigrationCenterGroup() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_migration_center_group"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -59,7 +59,7 @@ func ResourceMLEngineModel() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_ml_engine_model"),
 			tpgresource.DefaultProviderProject,
 		),
 
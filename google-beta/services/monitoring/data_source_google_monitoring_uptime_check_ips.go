@@ -48,10 +48,16 @@ func dataSourceGoogleMonitoringUptimeCheckIpsRead(d *schema.ResourceData, meta i
 
 	url := "https://monitoring.googleapis.com/v3/uptimeCheckIps"
 
-	uptimeCheckIps, err := tpgresource.PaginatedListRequest("", url, userAgent, config, flattenUptimeCheckIpsList)
+	ipObjList, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:    config,
+		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "uptimeCheckIps",
+	})
 	if err != nil {
 		return fmt.Errorf("Error retrieving monitoring uptime check ips: %s", err)
 	}
+	uptimeCheckIps := flattenUptimeCheckIpsList(ipObjList)
 
 	if err := d.Set("uptime_check_ips", uptimeCheckIps); err != nil {
 		return fmt.Errorf("Error retrieving monitoring uptime check ips: %s", err)
@@ -60,8 +66,7 @@ func dataSourceGoogleMonitoringUptimeCheckIpsRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func flattenUptimeCheckIpsList(resp map[string]interface{}) []interface{} {
-	ipObjList := resp["uptimeCheckIps"].([]interface{})
+func flattenUptimeCheckIpsList(ipObjList []interface{}) []interface{} {
 	uptimeCheckIps := make([]interface{}, len(ipObjList))
 	for i, u := range ipObjList {
 		ipObj := u.(map[string]interface{})
@@ -50,7 +50,7 @@ func ResourceNetappactiveDirectory() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_netapp_active_directory"),
 			tpgresource.DefaultProviderProject,
 		),
 
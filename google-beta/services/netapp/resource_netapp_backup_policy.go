@@ -50,7 +50,7 @@ func ResourceNetappbackupPolicy() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_netapp_backup_policy"),
 			tpgresource.DefaultProviderProject,
 		),
 
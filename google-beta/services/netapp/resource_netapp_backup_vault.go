@@ -50,7 +50,7 @@ func ResourceNetappbackupVault() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_netapp_backup_vault"),
 			tpgresource.DefaultProviderProject,
 		),
 
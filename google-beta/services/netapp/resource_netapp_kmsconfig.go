@@ -50,7 +50,7 @@ func ResourceNetappkmsconfig() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_netapp_kmsconfig"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -51,7 +51,7 @@ func ResourceNetappstoragePool() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_netapp_storage_pool"),
 			tpgresource.DefaultProviderProject,
 		),
 
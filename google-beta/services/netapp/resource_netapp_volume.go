@@ -51,7 +51,7 @@ func ResourceNetappVolume() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_netapp_volume"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -103,7 +103,7 @@ func ResourceNetappVolumeReplication() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_netapp_volume_replication"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceNetappVolumeSnapshot() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_netapp_volume_snapshot"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -53,7 +53,7 @@ func ResourceNetworkConnectivityHub() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_connectivity_hub"),
 		),
 
 		Schema: map[string]*schema.Schema{
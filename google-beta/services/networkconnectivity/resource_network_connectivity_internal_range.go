@@ -51,7 +51,7 @@ func ResourceNetworkConnectivityInternalRange() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_connectivity_internal_range"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceNetworkConnectivityPolicyBasedRoute() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_connectivity_policy_based_route"),
 			tpgresource.DefaultProviderProject,
 		),
 
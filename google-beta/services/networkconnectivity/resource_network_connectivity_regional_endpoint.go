@@ -50,7 +50,7 @@ func ResourceNetworkConnectivityRegionalEndpoint() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_connectivity_regional_endpoint"),
 			tpgresource.DefaultProviderProject,
 		),
 
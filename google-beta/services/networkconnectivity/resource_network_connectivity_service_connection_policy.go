@@ -51,7 +51,7 @@ func ResourceNetworkConnectivityServiceConnectionPolicy() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_connectivity_service_connection_policy"),
 			tpgresource.DefaultProviderProject,
 		),
 
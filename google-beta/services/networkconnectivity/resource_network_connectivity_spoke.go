@@ -53,7 +53,7 @@ func ResourceNetworkConnectivitySpoke() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_connectivity_spoke"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -51,7 +51,7 @@ func ResourceNetworkManagementConnectivityTest() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_management_connectivity_test"),
 			tpgresource.DefaultProviderProject,
 		),
 
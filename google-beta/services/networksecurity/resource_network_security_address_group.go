@@ -51,7 +51,7 @@ func ResourceNetworkSecurityAddressGroup() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_security_address_group"),
 		),
 
 		Schema: map[string]*schema.Schema{
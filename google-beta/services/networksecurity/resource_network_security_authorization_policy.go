@@ -51,7 +51,7 @@ func ResourceNetworkSecurityAuthorizationPolicy() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_security_authorization_policy"),
 			tpgresource.DefaultProviderProject,
 		),
 
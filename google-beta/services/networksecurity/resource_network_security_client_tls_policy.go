@@ -50,7 +50,7 @@ func ResourceNetworkSecurityClientTlsPolicy() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_security_client_tls_policy"),
 			tpgresource.DefaultProviderProject,
 		),
 
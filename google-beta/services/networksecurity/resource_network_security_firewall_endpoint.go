@@ -50,7 +50,7 @@ func ResourceNetworkSecurityFirewallEndpoint() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_security_firewall_endpoint"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +50,7 @@ func ResourceNetworkSecurityFirewallEndpointAssociation() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_security_firewall_endpoint_association"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -51,7 +51,7 @@ func ResourceNetworkSecuritySecurityProfile() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_security_security_profile"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -50,7 +50,7 @@ func ResourceNetworkSecuritySecurityProfileGroup() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_security_security_profile_group"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -51,7 +51,7 @@ func ResourceNetworkSecurityServerTlsPolicy() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_security_server_tls_policy"),
 			tpgresource.DefaultProviderProject,
 		),
 
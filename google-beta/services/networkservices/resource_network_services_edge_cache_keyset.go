@@ -50,7 +50,7 @@ func ResourceNetworkServicesEdgeCacheKeyset() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_edge_cache_keyset"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -52,7 +52,7 @@ func ResourceNetworkServicesEdgeCacheOrigin() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_edge_cache_origin"),
 			tpgresource.DefaultProviderProject,
 		),
 
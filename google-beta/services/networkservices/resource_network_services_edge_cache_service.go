@@ -51,7 +51,7 @@ func ResourceNetworkServicesEdgeCacheService() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_edge_cache_service"),
 			tpgresource.DefaultProviderProject,
 		),
 
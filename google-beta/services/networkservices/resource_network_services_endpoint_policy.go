@@ -51,7 +51,7 @@ func ResourceNetworkServicesEndpointPolicy() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_endpoint_policy"),
 			tpgresource.DefaultProviderProject,
 		),
 
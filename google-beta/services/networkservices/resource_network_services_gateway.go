@@ -166,7 +166,7 @@ func ResourceNetworkServicesGateway() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_gateway"),
 			tpgresource.DefaultProviderProject,
 		),
 
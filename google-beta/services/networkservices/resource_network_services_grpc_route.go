@@ -51,7 +51,7 @@ func ResourceNetworkServicesGrpcRoute() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_grpc_route"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceNetworkServicesHttpRoute() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_http_route"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -51,7 +51,7 @@ func ResourceNetworkServicesLbRouteExtension() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_lb_route_extension"),
 			tpgresource.DefaultProviderProject,
 		),
 
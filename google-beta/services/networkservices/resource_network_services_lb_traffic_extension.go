@@ -51,7 +51,7 @@ func ResourceNetworkServicesLbTrafficExtension() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_lb_traffic_extension"),
 			tpgresource.DefaultProviderProject,
 		),
 
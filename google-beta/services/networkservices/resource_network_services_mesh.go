@@ -50,7 +50,7 @@ func ResourceNetworkServicesMesh() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_mesh"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -59,7 +59,7 @@ func ResourceNetworkServicesServiceBinding() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_service_binding"),
 			tpgresource.DefaultProviderProject,
 		),
 
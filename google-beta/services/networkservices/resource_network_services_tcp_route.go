@@ -50,7 +50,7 @@ func ResourceNetworkServicesTcpRoute() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_network_services_tcp_route"),
 			tpgresource.DefaultProviderProject,
 		),
 
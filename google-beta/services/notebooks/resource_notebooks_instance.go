@@ -162,7 +162,7 @@ func ResourceNotebooksInstance() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_notebooks_instance"),
 			tpgresource.DefaultProviderProject,
 		),
 
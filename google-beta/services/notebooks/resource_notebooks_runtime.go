@@ -73,7 +73,7 @@ func ResourceNotebooksRuntime() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_notebooks_runtime"),
 			tpgresource.DefaultProviderProject,
 		),
 
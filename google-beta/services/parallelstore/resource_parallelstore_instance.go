@@ -50,7 +50,7 @@ func ResourceParallelstoreInstance() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_parallelstore_instance"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -51,7 +51,7 @@ func ResourcePrivatecaCaPool() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_privateca_ca_pool"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -51,7 +51,7 @@ func ResourcePrivatecaCertificate() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_privateca_certificate"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -73,7 +73,7 @@ func ResourcePrivatecaCertificateAuthority() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourcePrivateCaCACustomDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_privateca_certificate_authority"),
 			tpgresource.DefaultProviderProject,
 		),
 
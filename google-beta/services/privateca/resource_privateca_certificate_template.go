@@ -50,7 +50,7 @@ func ResourcePrivatecaCertificateTemplate() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_privateca_certificate_template"),
 			tpgresource.DefaultProviderProject,
 		),
 
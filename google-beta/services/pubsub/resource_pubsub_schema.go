@@ -377,7 +377,7 @@ func resourcePubsubSchemaDelete(d *schema.ResourceData, meta interface{}) error
 		return transport_tpg.HandleNotFoundError(err, d, "Schema")
 	}
 
-	err = transport_tpg.PollingWaitTime(resourcePubsubSchemaPollRead(d, meta), transport_tpg.PollCheckForAbsence, "Deleting Schema", d.Timeout(schema.TimeoutCreate), 10)
+	err = transport_tpg.PollingWaitTimeContext(config.Context, resourcePubsubSchemaPollRead(d, meta), transport_tpg.PollCheckForAbsence, "Deleting Schema", d.Timeout(schema.TimeoutCreate), 10)
 	if err != nil {
 		return fmt.Errorf("Error waiting to delete Schema: %s", err)
 	}
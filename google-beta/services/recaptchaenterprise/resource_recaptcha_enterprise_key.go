@@ -53,7 +53,7 @@ func ResourceRecaptchaEnterpriseKey() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_recaptcha_enterprise_key"),
 		),
 
 		Schema: map[string]*schema.Schema{
@@ -95,7 +95,7 @@ func ResourceRedisInstance() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			customdiff.ForceNewIfChange("redis_version", isRedisVersionDecreasing),
 			tpgresource.DefaultProviderProject,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_redis_instance"),
 		),
 
 		Schema: map[string]*schema.Schema{
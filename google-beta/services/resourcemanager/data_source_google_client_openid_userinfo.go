@@ -101,7 +101,7 @@ func (d *GoogleClientOpenIDUserinfoDataSource) Read(ctx context.Context, req dat
 	}
 
 	userAgent := fwtransport.GenerateFrameworkUserAgentString(metaData, d.providerConfig.UserAgent)
-	email := fwtransport.GetCurrentUserEmailFramework(d.providerConfig, userAgent, &diags)
+	email := fwtransport.GetCurrentUserEmailFramework(ctx, d.providerConfig, userAgent, &diags)
 
 	data.Email = types.StringValue(email)
 	data.Id = types.StringValue(email)
@@ -59,8 +59,9 @@ func DataSourceGoogleIamPolicy() *schema.Resource {
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"expression": {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: tpgiamresource.ValidateIamConditionExpression,
 									},
 									"title": {
 										Type:     schema.TypeString,
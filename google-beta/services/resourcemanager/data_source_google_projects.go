@@ -72,38 +72,21 @@ func datasourceGoogleProjectsRead(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	params := make(map[string]string)
-	projects := make([]map[string]interface{}, 0)
-
-	for {
-		params["filter"] = d.Get("filter").(string)
-		url := "https://cloudresourcemanager.googleapis.com/v1/projects"
-
-		url, err := transport_tpg.AddQueryParams(url, params)
-		if err != nil {
-			return err
-		}
-
-		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
-		if err != nil {
-			return fmt.Errorf("Error retrieving projects: %s", err)
-		}
-
-		pageProjects := flattenDatasourceGoogleProjectsList(res["projects"])
-		projects = append(projects, pageProjects...)
+	url, err := transport_tpg.AddQueryParams("https://cloudresourcemanager.googleapis.com/v1/projects", map[string]string{"filter": d.Get("filter").(string)})
+	if err != nil {
+		return err
+	}
 
-		pToken, ok := res["nextPageToken"]
-		if ok && pToken != nil && pToken.(string) != "" {
-			params["pageToken"] = pToken.(string)
-		} else {
-			break
-		}
+	items, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:    config,
+		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "projects",
+	})
+	if err != nil {
+		return fmt.Errorf("Error retrieving projects: %s", err)
 	}
+	projects := flattenDatasourceGoogleProjectsList(items)
 
 	if err := d.Set("projects", projects); err != nil {
 		return fmt.Errorf("Error retrieving projects: %s", err)
@@ -44,7 +44,7 @@ func ResourceGoogleProject() *schema.Resource {
 		Delete: resourceGoogleProjectDelete,
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_project"),
 		),
 
 		Importer: &schema.ResourceImporter{
@@ -54,13 +54,6 @@ func dataSourceSecretManagerSecretsRead(d *schema.ResourceData, meta interface{}
 
 	filter, has_filter := d.GetOk("filter")
 
-	if has_filter {
-		url, err = transport_tpg.AddQueryParams(url, map[string]string{"filter": filter.(string)})
-		if err != nil {
-			return err
-		}
-	}
-
 	billingProject := ""
 
 	project, err := tpgresource.GetProject(d, config)
@@ -74,38 +67,16 @@ func dataSourceSecretManagerSecretsRead(d *schema.ResourceData, meta interface{}
 		billingProject = bp
 	}
 
-	// To handle the pagination locally
-	allSecrets := make([]interface{}, 0)
-	token := ""
-	for paginate := true; paginate; {
-		if token != "" {
-			url, err = transport_tpg.AddQueryParams(url, map[string]string{"pageToken": token})
-			if err != nil {
-				return err
-			}
-		}
-		secrets, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			Project:   billingProject,
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
-		if err != nil {
-			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("SecretManagerSecrets %q", d.Id()))
-		}
-		secretsInterface := secrets["secrets"]
-		if secretsInterface == nil {
-			break
-		}
-		allSecrets = append(allSecrets, secretsInterface.([]interface{})...)
-		tokenInterface := secrets["nextPageToken"]
-		if tokenInterface == nil {
-			paginate = false
-		} else {
-			paginate = true
-			token = tokenInterface.(string)
-		}
+	allSecrets, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:    config,
+		Project:   billingProject,
+		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "secrets",
+		Filter:    filter.(string),
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("SecretManagerSecrets %q", d.Id()))
 	}
 
 	if err := d.Set("secrets", flattenSecretManagerSecretsSecrets(allSecrets, d, config)); err != nil {
@@ -60,7 +60,7 @@ func secretManagerSecretAutoCustomizeDiff(_ context.Context, diff *schema.Resour
 }
 
 func ResourceSecretManagerSecret() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceSecretManagerSecretCreate,
 		Read:   resourceSecretManagerSecretRead,
 		Update: resourceSecretManagerSecretUpdate,
@@ -76,9 +76,11 @@ func ResourceSecretManagerSecret() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
 			secretManagerSecretAutoCustomizeDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_secret_manager_secret"),
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -319,6 +321,13 @@ the actual destruction happens after this TTL expires.`,
 				Description: `The resource name of the Secret. Format:
 'projects/{{project}}/secrets/{{secret_id}}'`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -335,6 +344,10 @@ the actual destruction happens after this TTL expires.`,
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceSecretManagerSecretCreate(d *schema.ResourceData, meta interface{}) error {
@@ -530,6 +543,9 @@ func resourceSecretManagerSecretRead(d *schema.ResourceData, meta interface{}) e
 	if err := d.Set("effective_annotations", flattenSecretManagerSecretEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Secret: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenSecretManagerSecretTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Secret: %s", err)
+	}
 
 	return nil
 }
@@ -979,6 +995,10 @@ func flattenSecretManagerSecretEffectiveAnnotations(v interface{}, d *schema.Res
 	return v
 }
 
+func flattenSecretManagerSecretTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandSecretManagerSecretVersionAliases(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (map[string]string, error) {
 	if v == nil {
 		return map[string]string{}, nil
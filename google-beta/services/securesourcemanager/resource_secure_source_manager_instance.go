@@ -49,7 +49,7 @@ func ResourceSecureSourceManagerInstance() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_secure_source_manager_instance"),
 			tpgresource.DefaultProviderProject,
 		),
 
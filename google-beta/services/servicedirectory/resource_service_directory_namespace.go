@@ -51,7 +51,7 @@ func ResourceServiceDirectoryNamespace() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_service_directory_namespace"),
 			tpgresource.DefaultProviderProject,
 		),
 
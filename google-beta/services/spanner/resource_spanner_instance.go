@@ -110,7 +110,7 @@ func ResourceSpannerInstance() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_spanner_instance"),
 			tpgresource.DefaultProviderProject,
 		),
 
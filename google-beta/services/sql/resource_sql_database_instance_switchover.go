@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sql
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+const (
+	sqlSwitchoverPromoteReplica = "PROMOTE_REPLICA"
+	sqlSwitchoverSwitchover     = "SWITCHOVER"
+	sqlSwitchoverFailover       = "FAILOVER"
+
+	sqlReplicaInstanceType = "READ_REPLICA_INSTANCE"
+)
+
+func ResourceSqlDatabaseInstanceSwitchover() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSqlDatabaseInstanceSwitchoverCreate,
+		Read:   resourceSqlDatabaseInstanceSwitchoverRead,
+		Delete: resourceSqlDatabaseInstanceSwitchoverDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.All(
+			tpgresource.DefaultProviderProject,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"instance": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the Cloud SQL instance the operation is performed on: the read replica to promote or to switch over to, or the primary instance to fail over.`,
+			},
+
+			"operation": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{sqlSwitchoverPromoteReplica, sqlSwitchoverSwitchover, sqlSwitchoverFailover}, false),
+				Description:  `The operation to perform. PROMOTE_REPLICA promotes a read replica to a stand-alone primary instance. SWITCHOVER switches the primary instance over to its disaster recovery replica, which becomes the primary of the cluster. FAILOVER fails a highly available primary instance over to its standby zone.`,
+			},
+
+			"replica_failover": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Only for PROMOTE_REPLICA. Whether to perform a replica failover to the disaster recovery replica, in which case the original primary instance is added as a replica of the promoted instance once it's back online. Only applicable to MySQL.`,
+			},
+
+			"db_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     verify.ValidateDuration(),
+				DiffSuppressFunc: tpgresource.DurationDiffSuppress,
+				Description:      `Only for SWITCHOVER. The maximum duration the switchover waits for the replica to catch up with the primary instance, in seconds with up to nine fractional digits, terminated by 's'. Example: "600s". Only applicable to SQL Server.`,
+			},
+
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Arbitrary map of values that, when changed, performs the operation again.`,
+			},
+
+			"project": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the project in which the instance belongs. If it is not provided, the provider project is used.`,
+			},
+
+			"operation_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The name of the Cloud SQL operation that performed the operation.`,
+			},
+
+			"former_primary": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `For PROMOTE_REPLICA and SWITCHOVER, the name of the primary instance of the replica before the operation.`,
+			},
+
+			"affected_instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `The current roles of the instances affected by the operation: the instance, and for PROMOTE_REPLICA and SWITCHOVER, the former primary instance if it still exists.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the instance.`,
+						},
+						"instance_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The type of the instance, like CLOUD_SQL_INSTANCE for a primary instance or READ_REPLICA_INSTANCE for a replica.`,
+						},
+						"master_instance_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The name of the primary instance, if the instance is a replica.`,
+						},
+						"replica_names": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `The names of the replicas of the instance.`,
+						},
+						"gce_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The zone the instance is serving from.`,
+						},
+						"secondary_gce_zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `The standby zone of a highly available instance.`,
+						},
+					},
+				},
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceSqlDatabaseInstanceSwitchoverCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("instance").(string)
+	operation := d.Get("operation").(string)
+	if d.Get("replica_failover").(bool) && operation != sqlSwitchoverPromoteReplica {
+		return fmt.Errorf("replica_failover can only be set for the %s operation", sqlSwitchoverPromoteReplica)
+	}
+	if _, ok := d.GetOk("db_timeout"); ok && operation != sqlSwitchoverSwitchover {
+		return fmt.Errorf("db_timeout can only be set for the %s operation", sqlSwitchoverSwitchover)
+	}
+
+	instance, err := getSqlDatabaseInstance(config, userAgent, project, name, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error reading SQL Database Instance %q: %s", name, err)
+	}
+
+	formerPrimary := ""
+	if operation == sqlSwitchoverFailover && instance.Settings == nil {
+		return fmt.Errorf("Error, the settings of SQL Database Instance %q are unknown", name)
+	}
+	if operation == sqlSwitchoverPromoteReplica || operation == sqlSwitchoverSwitchover {
+		if instance.InstanceType != sqlReplicaInstanceType {
+			return fmt.Errorf("Error, %s requires a read replica, but SQL Database Instance %q is a %s", operation, name, instance.InstanceType)
+		}
+		formerPrimary = instance.MasterInstanceName
+
+		primaryProject, primaryName := sqlInstanceProjectAndName(project, formerPrimary)
+		transport_tpg.MutexStore.Lock(instanceMutexKey(primaryProject, primaryName))
+		defer transport_tpg.MutexStore.Unlock(instanceMutexKey(primaryProject, primaryName))
+	}
+
+	transport_tpg.MutexStore.Lock(instanceMutexKey(project, name))
+	defer transport_tpg.MutexStore.Unlock(instanceMutexKey(project, name))
+
+	var op *sqladmin.Operation
+	var activity string
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (rerr error) {
+			instancesService := config.NewSqlAdminClient(userAgent).Instances
+			switch operation {
+			case sqlSwitchoverPromoteReplica:
+				activity = "Promote Replica"
+				op, rerr = instancesService.PromoteReplica(project, name).Failover(d.Get("replica_failover").(bool)).Do()
+			case sqlSwitchoverSwitchover:
+				activity = "Switchover Instance"
+				call := instancesService.Switchover(project, name)
+				if v, ok := d.GetOk("db_timeout"); ok {
+					call.DbTimeout(v.(string))
+				}
+				op, rerr = call.Do()
+			case sqlSwitchoverFailover:
+				activity = "Failover Instance"
+				// The settings version guards against failing over an instance whose settings changed meanwhile
+				req := &sqladmin.InstancesFailoverRequest{
+					FailoverContext: &sqladmin.FailoverContext{
+						SettingsVersion: instance.Settings.SettingsVersion,
+					},
+				}
+				op, rerr = instancesService.Failover(project, name, req).Do()
+			}
+			return rerr
+		},
+		Timeout:              d.Timeout(schema.TimeoutCreate),
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+	})
+	if err != nil {
+		return fmt.Errorf("Error, failed to perform %s on SQL Database Instance %q: %s", operation, name, err)
+	}
+
+	err = SqlAdminOperationWaitTime(config, op, project, activity, userAgent, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("projects/%s/instances/%s/operations/%s", project, name, op.Name))
+	if err := d.Set("operation_id", op.Name); err != nil {
+		return fmt.Errorf("Error setting operation_id: %s", err)
+	}
+	if err := d.Set("former_primary", formerPrimary); err != nil {
+		return fmt.Errorf("Error setting former_primary: %s", err)
+	}
+
+	return resourceSqlDatabaseInstanceSwitchoverRead(d, meta)
+}
+
+func resourceSqlDatabaseInstanceSwitchoverRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	project, err := tpgresource.GetProject(d, config)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("instance").(string)
+	instance, err := getSqlDatabaseInstance(config, userAgent, project, name, d.Timeout(schema.TimeoutRead))
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("SQL Database Instance %q", name))
+	}
+	affected := []map[string]interface{}{flattenSqlSwitchoverAffectedInstance(instance)}
+
+	if formerPrimary := d.Get("former_primary").(string); formerPrimary != "" {
+		primaryProject, primaryName := sqlInstanceProjectAndName(project, formerPrimary)
+		primary, err := getSqlDatabaseInstance(config, userAgent, primaryProject, primaryName, d.Timeout(schema.TimeoutRead))
+		if err != nil {
+			if !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+				return fmt.Errorf("Error reading SQL Database Instance %q: %s", formerPrimary, err)
+			}
+			log.Printf("[DEBUG] Former primary SQL Database Instance %q no longer exists", formerPrimary)
+		} else {
+			affected = append(affected, flattenSqlSwitchoverAffectedInstance(primary))
+		}
+	}
+
+	if err := d.Set("project", project); err != nil {
+		return fmt.Errorf("Error setting project: %s", err)
+	}
+	if err := d.Set("affected_instances", affected); err != nil {
+		return fmt.Errorf("Error setting affected_instances: %s", err)
+	}
+
+	return nil
+}
+
+func resourceSqlDatabaseInstanceSwitchoverDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] The %s operation on SQL Database Instance %q can't be undone, it's only removed from the state", d.Get("operation").(string), d.Get("instance").(string))
+	d.SetId("")
+
+	return nil
+}
+
+func getSqlDatabaseInstance(config *transport_tpg.Config, userAgent, project, name string, timeout time.Duration) (*sqladmin.DatabaseInstance, error) {
+	var instance *sqladmin.DatabaseInstance
+	err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		RetryFunc: func() (rerr error) {
+			instance, rerr = config.NewSqlAdminClient(userAgent).Instances.Get(project, name).Do()
+			return rerr
+		},
+		Timeout:              timeout,
+		ErrorRetryPredicates: []transport_tpg.RetryErrorPredicateFunc{transport_tpg.IsSqlOperationInProgressError},
+	})
+	return instance, err
+}
+
+// sqlInstanceProjectAndName splits the name of an instance in another project, like
+// the primary instance of a cross-project replica, in the form "project:instance"
+func sqlInstanceProjectAndName(project, name string) (string, string) {
+	if parts := strings.SplitN(name, ":", 2); len(parts) == 2 {
+		return parts[0], parts[1]
+	}
+	return project, name
+}
+
+func flattenSqlSwitchoverAffectedInstance(instance *sqladmin.DatabaseInstance) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                 instance.Name,
+		"instance_type":        instance.InstanceType,
+		"master_instance_name": instance.MasterInstanceName,
+		"replica_names":        instance.ReplicaNames,
+		"gce_zone":             instance.GceZone,
+		"secondary_gce_zone":   instance.SecondaryGceZone,
+	}
+}
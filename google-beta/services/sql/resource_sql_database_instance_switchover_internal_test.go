@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sql
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	sqladmin "google.golang.org/api/sqladmin/v1beta4"
+)
+
+// fakeSqlAdminServer implements the parts of the Cloud SQL Admin API used to promote,
+// switch over and fail over instances, with operations that are done immediately
+type fakeSqlAdminServer struct {
+	*httptest.Server
+
+	mutex     sync.Mutex
+	instances map[string]*sqladmin.DatabaseInstance
+	calls     []string
+}
+
+func newFakeSqlAdminServer(t *testing.T) *fakeSqlAdminServer {
+	s := &fakeSqlAdminServer{instances: map[string]*sqladmin.DatabaseInstance{
+		"db-primary": {
+			Name:             "db-primary",
+			InstanceType:     "CLOUD_SQL_INSTANCE",
+			ReplicaNames:     []string{"db-replica"},
+			GceZone:          "us-central1-a",
+			SecondaryGceZone: "us-central1-b",
+			Settings:         &sqladmin.Settings{SettingsVersion: 7},
+		},
+		"db-replica": {
+			Name:               "db-replica",
+			InstanceType:       sqlReplicaInstanceType,
+			MasterInstanceName: "db-primary",
+			GceZone:            "us-east1-b",
+			Settings:           &sqladmin.Settings{SettingsVersion: 3},
+		},
+	}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeSqlAdminServer) config() *transport_tpg.Config {
+	return &transport_tpg.Config{
+		Context: context.Background(),
+		Client:  s.Client(),
+		// The client appends the versioned path to the root of the server
+		SQLBasePath: s.URL + "/",
+		UserAgent:   "test",
+	}
+}
+
+// makePrimary makes an instance the primary instance of another one, or a stand-alone
+// primary instance if replica is nil
+func (s *fakeSqlAdminServer) makePrimary(instance, replica *sqladmin.DatabaseInstance) {
+	if former, ok := s.instances[instance.MasterInstanceName]; ok {
+		var replicaNames []string
+		for _, name := range former.ReplicaNames {
+			if name != instance.Name {
+				replicaNames = append(replicaNames, name)
+			}
+		}
+		former.ReplicaNames = replicaNames
+	}
+	instance.InstanceType = "CLOUD_SQL_INSTANCE"
+	instance.MasterInstanceName = ""
+	if replica != nil {
+		replica.InstanceType = sqlReplicaInstanceType
+		replica.MasterInstanceName = instance.Name
+		instance.ReplicaNames = append(instance.ReplicaNames, replica.Name)
+	}
+}
+
+func (s *fakeSqlAdminServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/sql/v1beta4/projects/my-project/instances/")
+	name, method, _ := strings.Cut(path, "/")
+	instance, ok := s.instances[name]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error": {"code": 404, "message": "The Cloud SQL instance does not exist."}}`))
+		return
+	}
+	if r.Method == http.MethodGet && method == "" {
+		json.NewEncoder(w).Encode(instance)
+		return
+	}
+
+	s.calls = append(s.calls, r.Method+" "+path+"?"+r.URL.RawQuery)
+	switch {
+	case r.Method == http.MethodPost && method == "promoteReplica":
+		former := s.instances[instance.MasterInstanceName]
+		if r.URL.Query().Get("failover") == "true" {
+			s.makePrimary(instance, former)
+		} else {
+			s.makePrimary(instance, nil)
+		}
+
+	case r.Method == http.MethodPost && method == "switchover":
+		s.makePrimary(instance, s.instances[instance.MasterInstanceName])
+
+	case r.Method == http.MethodPost && method == "failover":
+		req := &sqladmin.InstancesFailoverRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if req.FailoverContext == nil || req.FailoverContext.SettingsVersion != instance.Settings.SettingsVersion {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			w.Write([]byte(`{"error": {"code": 412, "message": "Condition does not match."}}`))
+			return
+		}
+		instance.GceZone, instance.SecondaryGceZone = instance.SecondaryGceZone, instance.GceZone
+
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+r.URL.Path, http.StatusBadRequest)
+		return
+	}
+
+	json.NewEncoder(w).Encode(&sqladmin.Operation{
+		Name:   "operation-" + method,
+		Status: "DONE",
+	})
+}
+
+func TestResourceSqlDatabaseInstanceSwitchover(t *testing.T) {
+	cases := map[string]struct {
+		Config            map[string]interface{}
+		ExpectedCall      string
+		ExpectedPrimary   string
+		ExpectedAffected  []interface{}
+		ExpectedOperation string
+	}{
+		"promote replica": {
+			Config: map[string]interface{}{
+				"instance":  "db-replica",
+				"operation": "PROMOTE_REPLICA",
+			},
+			ExpectedCall:    "POST db-replica/promoteReplica?alt=json&failover=false&prettyPrint=false",
+			ExpectedPrimary: "db-primary",
+			ExpectedAffected: []interface{}{
+				testSqlSwitchoverAffectedInstance("db-replica", "CLOUD_SQL_INSTANCE", "", nil, "us-east1-b", ""),
+				testSqlSwitchoverAffectedInstance("db-primary", "CLOUD_SQL_INSTANCE", "", nil, "us-central1-a", "us-central1-b"),
+			},
+			ExpectedOperation: "operation-promoteReplica",
+		},
+		"promote replica with replica failover": {
+			Config: map[string]interface{}{
+				"instance":         "db-replica",
+				"operation":        "PROMOTE_REPLICA",
+				"replica_failover": true,
+			},
+			ExpectedCall:    "POST db-replica/promoteReplica?alt=json&failover=true&prettyPrint=false",
+			ExpectedPrimary: "db-primary",
+			ExpectedAffected: []interface{}{
+				testSqlSwitchoverAffectedInstance("db-replica", "CLOUD_SQL_INSTANCE", "", []interface{}{"db-primary"}, "us-east1-b", ""),
+				testSqlSwitchoverAffectedInstance("db-primary", sqlReplicaInstanceType, "db-replica", nil, "us-central1-a", "us-central1-b"),
+			},
+			ExpectedOperation: "operation-promoteReplica",
+		},
+		"switchover": {
+			Config: map[string]interface{}{
+				"instance":   "db-replica",
+				"operation":  "SWITCHOVER",
+				"db_timeout": "600s",
+			},
+			ExpectedCall:    "POST db-replica/switchover?alt=json&dbTimeout=600s&prettyPrint=false",
+			ExpectedPrimary: "db-primary",
+			ExpectedAffected: []interface{}{
+				testSqlSwitchoverAffectedInstance("db-replica", "CLOUD_SQL_INSTANCE", "", []interface{}{"db-primary"}, "us-east1-b", ""),
+				testSqlSwitchoverAffectedInstance("db-primary", sqlReplicaInstanceType, "db-replica", nil, "us-central1-a", "us-central1-b"),
+			},
+			ExpectedOperation: "operation-switchover",
+		},
+		"failover": {
+			Config: map[string]interface{}{
+				"instance":  "db-primary",
+				"operation": "FAILOVER",
+			},
+			ExpectedCall:    "POST db-primary/failover?alt=json&prettyPrint=false",
+			ExpectedPrimary: "",
+			ExpectedAffected: []interface{}{
+				testSqlSwitchoverAffectedInstance("db-primary", "CLOUD_SQL_INSTANCE", "", []interface{}{"db-replica"}, "us-central1-b", "us-central1-a"),
+			},
+			ExpectedOperation: "operation-failover",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			server := newFakeSqlAdminServer(t)
+			tc.Config["project"] = "my-project"
+			d := schema.TestResourceDataRaw(t, ResourceSqlDatabaseInstanceSwitchover().Schema, tc.Config)
+
+			if err := resourceSqlDatabaseInstanceSwitchoverCreate(d, server.config()); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if expected := []string{tc.ExpectedCall}; !reflect.DeepEqual(server.calls, expected) {
+				t.Errorf("expected calls %v, got %v", expected, server.calls)
+			}
+			if expected := "projects/my-project/instances/" + tc.Config["instance"].(string) + "/operations/" + tc.ExpectedOperation; d.Id() != expected {
+				t.Errorf("expected id %q, got %q", expected, d.Id())
+			}
+			if former := d.Get("former_primary"); former != tc.ExpectedPrimary {
+				t.Errorf("expected former_primary %q, got %q", tc.ExpectedPrimary, former)
+			}
+			if affected := d.Get("affected_instances"); !reflect.DeepEqual(affected, tc.ExpectedAffected) {
+				t.Errorf("expected affected_instances %v, got %v", tc.ExpectedAffected, affected)
+			}
+		})
+	}
+}
+
+func TestResourceSqlDatabaseInstanceSwitchover_invalid(t *testing.T) {
+	cases := map[string]struct {
+		Config map[string]interface{}
+		Error  string
+	}{
+		"promote a primary instance": {
+			Config: map[string]interface{}{
+				"instance":  "db-primary",
+				"operation": "PROMOTE_REPLICA",
+			},
+			Error: "requires a read replica",
+		},
+		"db_timeout for a failover": {
+			Config: map[string]interface{}{
+				"instance":   "db-primary",
+				"operation":  "FAILOVER",
+				"db_timeout": "60s",
+			},
+			Error: "db_timeout can only be set for the SWITCHOVER operation",
+		},
+		"replica_failover for a switchover": {
+			Config: map[string]interface{}{
+				"instance":         "db-replica",
+				"operation":        "SWITCHOVER",
+				"replica_failover": true,
+			},
+			Error: "replica_failover can only be set for the PROMOTE_REPLICA operation",
+		},
+		"missing instance": {
+			Config: map[string]interface{}{
+				"instance":  "db-missing",
+				"operation": "FAILOVER",
+			},
+			Error: "does not exist",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			server := newFakeSqlAdminServer(t)
+			tc.Config["project"] = "my-project"
+			d := schema.TestResourceDataRaw(t, ResourceSqlDatabaseInstanceSwitchover().Schema, tc.Config)
+
+			err := resourceSqlDatabaseInstanceSwitchoverCreate(d, server.config())
+			if err == nil || !strings.Contains(err.Error(), tc.Error) {
+				t.Fatalf("expected an error containing %q, got %v", tc.Error, err)
+			}
+			if len(server.calls) != 0 {
+				t.Errorf("expected no operation, got %v", server.calls)
+			}
+		})
+	}
+}
+
+func TestResourceSqlDatabaseInstanceSwitchover_read(t *testing.T) {
+	server := newFakeSqlAdminServer(t)
+	d := schema.TestResourceDataRaw(t, ResourceSqlDatabaseInstanceSwitchover().Schema, map[string]interface{}{
+		"project":   "my-project",
+		"instance":  "db-replica",
+		"operation": "PROMOTE_REPLICA",
+	})
+	if err := resourceSqlDatabaseInstanceSwitchoverCreate(d, server.config()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// A deleted former primary is no longer an affected instance
+	delete(server.instances, "db-primary")
+	if err := resourceSqlDatabaseInstanceSwitchoverRead(d, server.config()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if affected := d.Get("affected_instances").([]interface{}); len(affected) != 1 {
+		t.Errorf("expected only the promoted instance to be affected, got %v", affected)
+	}
+
+	// The resource is removed from the state once the instance is deleted
+	delete(server.instances, "db-replica")
+	if err := resourceSqlDatabaseInstanceSwitchoverRead(d, server.config()); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected the resource to be removed from the state, got id %q", d.Id())
+	}
+}
+
+func testSqlSwitchoverAffectedInstance(name, instanceType, master string, replicas []interface{}, zone, secondaryZone string) interface{} {
+	if replicas == nil {
+		replicas = []interface{}{}
+	}
+	return map[string]interface{}{
+		"name":                 name,
+		"instance_type":        instanceType,
+		"master_instance_name": master,
+		"replica_names":        replicas,
+		"gce_zone":             zone,
+		"secondary_gce_zone":   secondaryZone,
+	}
+}
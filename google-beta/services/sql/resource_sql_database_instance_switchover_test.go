@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package sql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSqlDatabaseInstanceSwitchover_promoteReplica(t *testing.T) {
+	t.Parallel()
+
+	primaryName := "tf-test-sql-instance-" + acctest.RandString(t, 10)
+	replicaName := "tf-test-sql-instance-replica-" + acctest.RandString(t, 10)
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccSqlDatabaseInstanceDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSqlDatabaseInstanceSwitchover_promoteReplica(primaryName, replicaName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_sql_database_instance_switchover.promote", "former_primary", primaryName),
+					resource.TestCheckResourceAttr("google_sql_database_instance_switchover.promote", "affected_instances.#", "2"),
+					resource.TestCheckResourceAttr("google_sql_database_instance_switchover.promote", "affected_instances.0.name", replicaName),
+					resource.TestCheckResourceAttr("google_sql_database_instance_switchover.promote", "affected_instances.0.instance_type", "CLOUD_SQL_INSTANCE"),
+					resource.TestCheckResourceAttr("google_sql_database_instance_switchover.promote", "affected_instances.0.master_instance_name", ""),
+					resource.TestCheckResourceAttr("google_sql_database_instance_switchover.promote", "affected_instances.1.replica_names.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSqlDatabaseInstanceSwitchover_promoteReplica(primaryName, replicaName string) string {
+	return fmt.Sprintf(`
+resource "google_sql_database_instance" "primary" {
+  name                = "%s"
+  region              = "us-central1"
+  database_version    = "MYSQL_8_0"
+  deletion_protection = false
+
+  settings {
+    tier = "db-n1-standard-1"
+
+    backup_configuration {
+      binary_log_enabled = true
+      enabled            = true
+    }
+  }
+}
+
+resource "google_sql_database_instance" "replica" {
+  name                 = "%s"
+  region               = "us-central1"
+  database_version     = "MYSQL_8_0"
+  master_instance_name = google_sql_database_instance.primary.name
+  deletion_protection  = false
+
+  settings {
+    tier = "db-n1-standard-1"
+  }
+
+  # The promotion changes the role of the replica outside of this resource
+  lifecycle {
+    ignore_changes = [master_instance_name, instance_type]
+  }
+}
+
+resource "google_sql_database_instance_switchover" "promote" {
+  instance  = google_sql_database_instance.replica.name
+  operation = "PROMOTE_REPLICA"
+}
+`, primaryName, replicaName)
+}
@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/envvar"
+)
+
+func TestAccStorageManagedFolderIamBindingGenerated(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix":           acctest.RandString(t, 10),
+		"role":                    "roles/storage.objectViewer",
+		"admin_role":              "roles/storage.admin",
+		"condition_title":         "expires_after_2019_12_31",
+		"condition_expr":          `request.time < timestamp(\"2020-01-01T00:00:00Z\")`,
+		"condition_desc":          "Expiring at midnight of 2019-12-31",
+		"condition_title_no_desc": "expires_after_2019_12_31-no-description",
+		"condition_expr_no_desc":  `request.time < timestamp(\"2020-01-01T00:00:00Z\")`,
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageManagedFolderIamBinding_basicGenerated(context),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("b/%s/managedFolders/managed/folder/name/ roles/storage.objectViewer", fmt.Sprintf("tf-test-my-bucket%s", context["random_suffix"])),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Test Iam Binding update
+				Config: testAccStorageManagedFolderIamBinding_updateGenerated(context),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_binding.foo",
+				ImportStateId:     fmt.Sprintf("b/%s/managedFolders/managed/folder/name/ roles/storage.objectViewer", fmt.Sprintf("tf-test-my-bucket%s", context["random_suffix"])),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStorageManagedFolderIamMemberGenerated(t *testing.T) {
+	t.Parallel()
+
+	context := map[string]interface{}{
+		"random_suffix":           acctest.RandString(t, 10),
+		"role":                    "roles/storage.objectViewer",
+		"admin_role":              "roles/storage.admin",
+		"condition_title":         "expires_after_2019_12_31",
+		"condition_expr":          `request.time < timestamp(\"2020-01-01T00:00:00Z\")`,
+		"condition_desc":          "Expiring at midnight of 2019-12-31",
+		"condition_title_no_desc": "expires_after_2019_12_31-no-description",
+		"condition_expr_no_desc":  `request.time < timestamp(\"2020-01-01T00:00:00Z\")`,
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				// Test Iam Member creation (no update for member, no need to test)
+				Config: testAccStorageManagedFolderIamMember_basicGenerated(context),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_member.foo",
+				ImportStateId:     fmt.Sprintf("b/%s/managedFolders/managed/folder/name/ roles/storage.objectViewer user:admin@hashicorptest.com", fmt.Sprintf("tf-test-my-bucket%s", context["random_suffix"])),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccStorageManagedFolderIamPolicyGenerated(t *testing.T) {
+	t.Parallel()
+
+	// This may skip test, so do it first
+	sa := envvar.GetTestServiceAccountFromEnv(t)
+	context := map[string]interface{}{
+		"random_suffix":           acctest.RandString(t, 10),
+		"role":                    "roles/storage.objectViewer",
+		"admin_role":              "roles/storage.admin",
+		"condition_title":         "expires_after_2019_12_31",
+		"condition_expr":          `request.time < timestamp(\"2020-01-01T00:00:00Z\")`,
+		"condition_desc":          "Expiring at midnight of 2019-12-31",
+		"condition_title_no_desc": "expires_after_2019_12_31-no-description",
+		"condition_expr_no_desc":  `request.time < timestamp(\"2020-01-01T00:00:00Z\")`,
+	}
+	context["service_account"] = sa
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageManagedFolderIamPolicy_basicGenerated(context),
+				Check:  resource.TestCheckResourceAttrSet("data.google_storage_managed_folder_iam_policy.foo", "policy_data"),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("b/%s/managedFolders/managed/folder/name/", fmt.Sprintf("tf-test-my-bucket%s", context["random_suffix"])),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStorageManagedFolderIamPolicy_emptyBinding(context),
+			},
+			{
+				ResourceName:      "google_storage_managed_folder_iam_policy.foo",
+				ImportStateId:     fmt.Sprintf("b/%s/managedFolders/managed/folder/name/", fmt.Sprintf("tf-test-my-bucket%s", context["random_suffix"])),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStorageManagedFolderIamMember_basicGenerated(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                        = "tf-test-my-bucket%{random_suffix}"
+  location                    = "EU"
+  uniform_bucket_level_access = true
+}
+
+resource "google_storage_managed_folder" "folder" {
+  bucket = google_storage_bucket.bucket.name
+  name   = "managed/folder/name/"
+}
+
+resource "google_storage_managed_folder_iam_member" "foo" {
+  bucket = google_storage_managed_folder.folder.bucket
+  managed_folder = google_storage_managed_folder.folder.name
+  role = "%{role}"
+  member = "user:admin@hashicorptest.com"
+}
+`, context)
+}
+
+func testAccStorageManagedFolderIamPolicy_basicGenerated(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                        = "tf-test-my-bucket%{random_suffix}"
+  location                    = "EU"
+  uniform_bucket_level_access = true
+}
+
+resource "google_storage_managed_folder" "folder" {
+  bucket = google_storage_bucket.bucket.name
+  name   = "managed/folder/name/"
+}
+
+data "google_iam_policy" "foo" {
+  binding {
+    role = "%{role}"
+    members = ["user:admin@hashicorptest.com"]
+  }
+  binding {
+    role = "%{admin_role}"
+    members = ["serviceAccount:%{service_account}"]
+  }
+}
+
+resource "google_storage_managed_folder_iam_policy" "foo" {
+  bucket = google_storage_managed_folder.folder.bucket
+  managed_folder = google_storage_managed_folder.folder.name
+  policy_data = data.google_iam_policy.foo.policy_data
+}
+
+data "google_storage_managed_folder_iam_policy" "foo" {
+  bucket = google_storage_managed_folder.folder.bucket
+  managed_folder = google_storage_managed_folder.folder.name
+  depends_on = [
+    google_storage_managed_folder_iam_policy.foo
+  ]
+}
+`, context)
+}
+
+func testAccStorageManagedFolderIamPolicy_emptyBinding(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                        = "tf-test-my-bucket%{random_suffix}"
+  location                    = "EU"
+  uniform_bucket_level_access = true
+}
+
+resource "google_storage_managed_folder" "folder" {
+  bucket = google_storage_bucket.bucket.name
+  name   = "managed/folder/name/"
+}
+
+data "google_iam_policy" "foo" {
+}
+
+resource "google_storage_managed_folder_iam_policy" "foo" {
+  bucket = google_storage_managed_folder.folder.bucket
+  managed_folder = google_storage_managed_folder.folder.name
+  policy_data = data.google_iam_policy.foo.policy_data
+}
+`, context)
+}
+
+func testAccStorageManagedFolderIamBinding_basicGenerated(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                        = "tf-test-my-bucket%{random_suffix}"
+  location                    = "EU"
+  uniform_bucket_level_access = true
+}
+
+resource "google_storage_managed_folder" "folder" {
+  bucket = google_storage_bucket.bucket.name
+  name   = "managed/folder/name/"
+}
+
+resource "google_storage_managed_folder_iam_binding" "foo" {
+  bucket = google_storage_managed_folder.folder.bucket
+  managed_folder = google_storage_managed_folder.folder.name
+  role = "%{role}"
+  members = ["user:admin@hashicorptest.com"]
+}
+`, context)
+}
+
+func testAccStorageManagedFolderIamBinding_updateGenerated(context map[string]interface{}) string {
+	return acctest.Nprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                        = "tf-test-my-bucket%{random_suffix}"
+  location                    = "EU"
+  uniform_bucket_level_access = true
+}
+
+resource "google_storage_managed_folder" "folder" {
+  bucket = google_storage_bucket.bucket.name
+  name   = "managed/folder/name/"
+}
+
+resource "google_storage_managed_folder_iam_binding" "foo" {
+  bucket = google_storage_managed_folder.folder.bucket
+  managed_folder = google_storage_managed_folder.folder.name
+  role = "%{role}"
+  members = ["user:admin@hashicorptest.com", "user:gterraformtest1@gmail.com"]
+}
+`, context)
+}
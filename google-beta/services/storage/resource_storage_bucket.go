@@ -11,6 +11,7 @@ import (
 	"math"
 	"regexp"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -40,7 +41,7 @@ func ResourceStorageBucket() *schema.Resource {
 		},
 		CustomizeDiff: customdiff.All(
 			customdiff.ForceNewIfChange("retention_policy.0.is_locked", isPolicyLocked),
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_storage_bucket"),
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -538,6 +539,24 @@ func ResourceStorageBucket() *schema.Resource {
 					},
 				},
 			},
+			"hierarchical_namespace": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							ForceNew:    true,
+							Description: `While set to true, hierarchical namespace is enabled for this bucket, organizing objects in folders. Requires uniform_bucket_level_access to be enabled.`,
+						},
+					},
+				},
+				Description:      `The bucket's hierarchical namespace configuration.`,
+				DiffSuppressFunc: hierarchicalNamespaceDiffSuppress,
+			},
 		},
 		UseJSONNumber: true,
 	}
@@ -685,6 +704,10 @@ func resourceStorageBucketCreate(d *schema.ResourceData, meta interface{}) error
 		sb.SoftDeletePolicy = expandBucketSoftDeletePolicy(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("hierarchical_namespace"); ok {
+		sb.HierarchicalNamespace = expandBucketHierarchicalNamespace(v.([]interface{}))
+	}
+
 	var res *storage.Bucket
 
 	err = transport_tpg.Retry(transport_tpg.RetryOptions{
@@ -1026,6 +1049,19 @@ func resourceStorageBucketDelete(d *schema.ResourceData, meta interface{}) error
 		wp.StopWait()
 	}
 
+	// Managed folders and the folders of buckets with hierarchical namespace
+	// enabled must be deleted separately from objects.
+	if listError == nil && deleteObjectError == nil {
+		if err := deleteBucketManagedFolders(d, config, userAgent, bucket); err != nil {
+			return err
+		}
+		if d.Get("hierarchical_namespace.0.enabled").(bool) {
+			if err := deleteBucketFolders(d, config, userAgent, bucket); err != nil {
+				return err
+			}
+		}
+	}
+
 	// remove empty bucket
 	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		err := config.NewStorageClient(userAgent).Buckets.Delete(bucket).Do()
@@ -1276,6 +1312,129 @@ func flattenBucketSoftDeletePolicy(softDeletePolicy *storage.BucketSoftDeletePol
 	return policies
 }
 
+func hierarchicalNamespaceDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	// A disabled hierarchical namespace is equivalent to an unset one
+	if k == "hierarchical_namespace.0.enabled" {
+		return old != "true" && new != "true"
+	}
+	if k == "hierarchical_namespace.#" {
+		o, n := d.GetChange("hierarchical_namespace")
+		return !hierarchicalNamespaceEnabled(o) && !hierarchicalNamespaceEnabled(n)
+	}
+	return false
+}
+
+func hierarchicalNamespaceEnabled(v interface{}) bool {
+	l, ok := v.([]interface{})
+	if !ok || len(l) == 0 || l[0] == nil {
+		return false
+	}
+	return l[0].(map[string]interface{})["enabled"].(bool)
+}
+
+func expandBucketHierarchicalNamespace(configured []interface{}) *storage.BucketHierarchicalNamespace {
+	if len(configured) == 0 || configured[0] == nil {
+		return nil
+	}
+	hierarchicalNamespace := configured[0].(map[string]interface{})
+	return &storage.BucketHierarchicalNamespace{
+		Enabled:         hierarchicalNamespace["enabled"].(bool),
+		ForceSendFields: []string{"Enabled"},
+	}
+}
+
+func flattenBucketHierarchicalNamespace(hierarchicalNamespace *storage.BucketHierarchicalNamespace) []map[string]interface{} {
+	hierarchicalNamespaces := make([]map[string]interface{}, 0, 1)
+	if hierarchicalNamespace == nil {
+		return hierarchicalNamespaces
+	}
+	hierarchicalNamespaces = append(hierarchicalNamespaces, map[string]interface{}{
+		"enabled": hierarchicalNamespace.Enabled,
+	})
+	return hierarchicalNamespaces
+}
+
+// deleteBucketManagedFolders deletes the managed folders of a bucket when force_destroy is set.
+func deleteBucketManagedFolders(d *schema.ResourceData, config *transport_tpg.Config, userAgent, bucket string) error {
+	managedFoldersService := config.NewStorageClient(userAgent).ManagedFolders
+
+	var names []string
+	err := managedFoldersService.List(bucket).Fields("items(name)", "nextPageToken").Pages(config.Context, func(res *storage.ManagedFolders) error {
+		for _, managedFolder := range res.Items {
+			names = append(names, managedFolder.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		// Buckets without managed folders support, e.g. with uniform bucket-level access disabled,
+		// can be deleted as usual.
+		log.Printf("[DEBUG] Error listing managed folders of bucket %s: %v", bucket, err)
+		return nil
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	if !d.Get("force_destroy").(bool) {
+		return fmt.Errorf("Error trying to delete bucket %s containing managed folders without `force_destroy` set to true", bucket)
+	}
+	for _, name := range names {
+		log.Printf("[TRACE] Attempting to delete managed folder %s", name)
+		if err := managedFoldersService.Delete(bucket, name).AllowNonEmpty(true).Do(); err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error deleting managed folder %s of bucket %s: %s", name, bucket, err)
+		}
+	}
+	return nil
+}
+
+// deleteBucketFolders deletes the folders of a bucket with hierarchical namespace enabled when force_destroy is set.
+func deleteBucketFolders(d *schema.ResourceData, config *transport_tpg.Config, userAgent, bucket string) error {
+	names, err := listStorageFolders(config, userAgent, bucket, "")
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	if !d.Get("force_destroy").(bool) {
+		return fmt.Errorf("Error trying to delete bucket %s containing folders without `force_destroy` set to true", bucket)
+	}
+	return deleteStorageFolders(config, userAgent, bucket, names)
+}
+
+// listStorageFolders lists the names of the folders under a prefix of a bucket with hierarchical namespace enabled.
+func listStorageFolders(config *transport_tpg.Config, userAgent, bucket, prefix string) ([]string, error) {
+	var names []string
+	err := config.NewStorageClient(userAgent).Folders.List(bucket).Prefix(prefix).Fields("items(name)", "nextPageToken").Pages(config.Context, func(res *storage.Folders) error {
+		for _, folder := range res.Items {
+			names = append(names, folder.Name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing folders of bucket %s: %s", bucket, err)
+	}
+	return names, nil
+}
+
+// deleteStorageFolders deletes folders of a bucket. Folders must be empty to be deleted, so the deepest are deleted first.
+func deleteStorageFolders(config *transport_tpg.Config, userAgent, bucket string, names []string) error {
+	foldersService := config.NewStorageClient(userAgent).Folders
+
+	names = append([]string(nil), names...)
+	sort.SliceStable(names, func(i, j int) bool {
+		return strings.Count(names[i], "/") > strings.Count(names[j], "/")
+	})
+	for _, name := range names {
+		log.Printf("[TRACE] Attempting to delete folder %s", name)
+		if err := foldersService.Delete(bucket, name).Do(); err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error deleting folder %s of bucket %s: %s", name, bucket, err)
+		}
+	}
+	return nil
+}
+
 func expandBucketVersioning(configured interface{}) *storage.BucketVersioning {
 	versionings := configured.([]interface{})
 	if len(versionings) == 0 {
@@ -1865,6 +2024,9 @@ func setStorageBucket(d *schema.ResourceData, config *transport_tpg.Config, res
 	if err := d.Set("soft_delete_policy", flattenBucketSoftDeletePolicy(res.SoftDeletePolicy)); err != nil {
 		return fmt.Errorf("Error setting soft_delete_policy: %s", err)
 	}
+	if err := d.Set("hierarchical_namespace", flattenBucketHierarchicalNamespace(res.HierarchicalNamespace)); err != nil {
+		return fmt.Errorf("Error setting hierarchical_namespace: %s", err)
+	}
 	if res.IamConfiguration != nil && res.IamConfiguration.UniformBucketLevelAccess != nil {
 		if err := d.Set("uniform_bucket_level_access", res.IamConfiguration.UniformBucketLevelAccess.Enabled); err != nil {
 			return fmt.Errorf("Error setting uniform_bucket_level_access: %s", err)
@@ -84,3 +84,41 @@ func TestLabelDiffSuppress(t *testing.T) {
 		}
 	}
 }
+
+func TestHierarchicalNamespaceDiffSuppress(t *testing.T) {
+	cases := map[string]struct {
+		K, Old, New        string
+		ExpectDiffSuppress bool
+	}{
+		"unset to disabled": {
+			K:                  "hierarchical_namespace.0.enabled",
+			Old:                "",
+			New:                "false",
+			ExpectDiffSuppress: true,
+		},
+		"disabled to unset": {
+			K:                  "hierarchical_namespace.0.enabled",
+			Old:                "false",
+			New:                "",
+			ExpectDiffSuppress: true,
+		},
+		"unset to enabled": {
+			K:                  "hierarchical_namespace.0.enabled",
+			Old:                "",
+			New:                "true",
+			ExpectDiffSuppress: false,
+		},
+		"enabled to disabled": {
+			K:                  "hierarchical_namespace.0.enabled",
+			Old:                "true",
+			New:                "false",
+			ExpectDiffSuppress: false,
+		},
+	}
+
+	for tn, tc := range cases {
+		if hierarchicalNamespaceDiffSuppress(tc.K, tc.Old, tc.New, nil) != tc.ExpectDiffSuppress {
+			t.Errorf("bad: %s, %q: %q => %q expect DiffSuppress to return %t", tn, tc.K, tc.Old, tc.New, tc.ExpectDiffSuppress)
+		}
+	}
+}
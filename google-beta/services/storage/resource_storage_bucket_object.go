@@ -14,6 +14,7 @@ import (
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"crypto/sha256"
 	"encoding/base64"
@@ -117,6 +118,27 @@ func ResourceStorageBucketObject() *schema.Resource {
 				Description:  `A path to the data you want to upload. Must be defined if content is not.`,
 			},
 
+			"upload_chunk_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateStorageUploadChunkSize,
+				Description:  `The size in bytes of the chunks in which source is uploaded with a resumable upload, for files larger than one chunk. Must be a multiple of 262144 (256 KiB). A failed chunk is retried from the offset persisted by Cloud Storage instead of restarting the upload.`,
+			},
+
+			"parallel_composite_upload_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  `The size in bytes above which source is uploaded as a parallel composite upload: its parts are uploaded in parallel as temporary objects, which are composed into the object and then deleted. Composite objects have no MD5 hash. If unset or 0, parallel composite uploads are disabled.`,
+			},
+
+			"parallel_composite_upload_parts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(2, storageComposeMaxSourceObjects),
+				Description:  `The number of parts of a parallel composite upload, between 2 and 32. Defaults to 8.`,
+			},
+
 			// Detect changes to local file or changes made outside of Terraform to the file stored on the server.
 			"detect_md5hash": {
 				Type: schema.TypeString,
@@ -289,13 +311,16 @@ func resourceStorageBucketObjectCreate(d *schema.ResourceData, meta interface{})
 	bucket := d.Get("bucket").(string)
 	name := d.Get("name").(string)
 	var media io.Reader
+	var file *os.File
 
 	if v, ok := d.GetOk("source"); ok {
 		var err error
-		media, err = os.Open(v.(string))
+		file, err = os.Open(v.(string))
 		if err != nil {
 			return err
 		}
+		defer file.Close()
+		media = file
 	} else if v, ok := d.GetOk("content"); ok {
 		media = bytes.NewReader([]byte(v.(string)))
 	} else {
@@ -349,6 +374,33 @@ func resourceStorageBucketObjectCreate(d *schema.ResourceData, meta interface{})
 		object.TemporaryHold = v.(bool)
 	}
 
+	if file != nil {
+		upload, err := newStorageObjectUpload(d, config, userAgent, object, file)
+		if err != nil {
+			return err
+		}
+		if upload.composite() || upload.resumable() {
+			object.Name = name
+			res, err := upload.Do()
+			if err != nil {
+				return fmt.Errorf("Error uploading object %s: %s", name, err)
+			}
+
+			// Composite objects have no MD5 hash, so the hash of the source is recorded
+			// along with the CRC32C the object is compared against when it's read.
+			if res.ComponentCount > 0 {
+				if err := d.Set("crc32c", res.Crc32c); err != nil {
+					return fmt.Errorf("Error setting crc32c: %s", err)
+				}
+				if err := d.Set("detect_md5hash", getFileMd5Hash(file.Name())); err != nil {
+					return fmt.Errorf("Error setting detect_md5hash: %s", err)
+				}
+			}
+
+			return resourceStorageBucketObjectRead(d, meta)
+		}
+	}
+
 	insertCall := objectsService.Insert(bucket, object)
 	insertCall.Name(name)
 	insertCall.Media(media)
@@ -443,10 +495,17 @@ func resourceStorageBucketObjectRead(d *schema.ResourceData, meta interface{}) e
 		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Storage Bucket Object %q", d.Get("name").(string)))
 	}
 
+	// Composite objects have no MD5 hash. The hash of the source recorded on upload is
+	// kept for as long as the object's CRC32C is the one recorded with it.
+	detectMd5Hash := res.Md5Hash
+	if res.Md5Hash == "" && res.ComponentCount > 0 && d.Get("crc32c").(string) == res.Crc32c {
+		detectMd5Hash = d.Get("detect_md5hash").(string)
+	}
+
 	if err := d.Set("md5hash", res.Md5Hash); err != nil {
 		return fmt.Errorf("Error setting md5hash: %s", err)
 	}
-	if err := d.Set("detect_md5hash", res.Md5Hash); err != nil {
+	if err := d.Set("detect_md5hash", detectMd5Hash); err != nil {
 		return fmt.Errorf("Error setting detect_md5hash: %s", err)
 	}
 	if err := d.Set("crc32c", res.Crc32c); err != nil {
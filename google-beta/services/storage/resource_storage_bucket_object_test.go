@@ -6,7 +6,9 @@ import (
 	"crypto/md5"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/binary"
 	"fmt"
+	"hash/crc32"
 	"io/ioutil"
 	"testing"
 
@@ -459,6 +461,68 @@ func TestAccStorageObject_retention(t *testing.T) {
 	})
 }
 
+func TestAccStorageObject_resumableUpload(t *testing.T) {
+	t.Parallel()
+
+	bucketName := acctest.TestBucketName(t)
+	data := make([]byte, 600*1024)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	h := md5.New()
+	if _, err := h.Write(data); err != nil {
+		t.Errorf("error calculating md5: %v", err)
+	}
+	dataMd5 := base64.StdEncoding.EncodeToString(h.Sum(nil))
+	testFile := getNewTmpTestFile(t, "tf-test")
+	if err := ioutil.WriteFile(testFile.Name(), data, 0644); err != nil {
+		t.Errorf("error writing file: %v", err)
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageObjectDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testGoogleStorageBucketsObjectResumableUpload(bucketName, testFile.Name()),
+				Check:  testAccCheckGoogleStorageObject(t, bucketName, objectName, dataMd5),
+			},
+		},
+	})
+}
+
+func TestAccStorageObject_parallelCompositeUpload(t *testing.T) {
+	t.Parallel()
+
+	bucketName := acctest.TestBucketName(t)
+	data := make([]byte, 600*1024)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	crc32c := make([]byte, 4)
+	binary.BigEndian.PutUint32(crc32c, crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)))
+	testFile := getNewTmpTestFile(t, "tf-test")
+	if err := ioutil.WriteFile(testFile.Name(), data, 0644); err != nil {
+		t.Errorf("error writing file: %v", err)
+	}
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageObjectDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testGoogleStorageBucketsObjectParallelCompositeUpload(bucketName, testFile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket_object.object", "crc32c", base64.StdEncoding.EncodeToString(crc32c)),
+					resource.TestCheckResourceAttr("google_storage_bucket_object.object", "md5hash", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGoogleStorageObject(t *testing.T, bucket, object, md5 string) resource.TestCheckFunc {
 	return testAccCheckGoogleStorageObjectWithEncryption(t, bucket, object, md5, "")
 }
@@ -601,6 +665,39 @@ resource "google_storage_bucket_object" "object" {
 `, bucketName, objectName, sourceFilename)
 }
 
+func testGoogleStorageBucketsObjectResumableUpload(bucketName, sourceFilename string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name     = "%s"
+  location = "US"
+}
+
+resource "google_storage_bucket_object" "object" {
+  name              = "%s"
+  bucket            = google_storage_bucket.bucket.name
+  source            = "%s"
+  upload_chunk_size = 262144
+}
+`, bucketName, objectName, sourceFilename)
+}
+
+func testGoogleStorageBucketsObjectParallelCompositeUpload(bucketName, sourceFilename string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name     = "%s"
+  location = "US"
+}
+
+resource "google_storage_bucket_object" "object" {
+  name                                = "%s"
+  bucket                              = google_storage_bucket.bucket.name
+  source                              = "%s"
+  parallel_composite_upload_threshold = 262144
+  parallel_composite_upload_parts     = 4
+}
+`, bucketName, objectName, sourceFilename)
+}
+
 func testGoogleStorageBucketsObjectOptionalContentFields(
 	bucketName, disposition, encoding, language, content_type string) string {
 	return fmt.Sprintf(`
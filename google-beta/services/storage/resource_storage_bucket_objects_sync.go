@@ -0,0 +1,615 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"google.golang.org/api/storage/v1"
+)
+
+func ResourceStorageBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageBucketObjectsSyncCreate,
+		Read:   resourceStorageBucketObjectsSyncRead,
+		Update: resourceStorageBucketObjectsSyncUpdate,
+		Delete: resourceStorageBucketObjectsSyncDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: resourceStorageBucketObjectsSyncCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The name of the bucket to sync objects to.`,
+			},
+
+			"source": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The path to the local directory to sync objects from.`,
+			},
+
+			"prefix": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`(^$|/$)`), `must be empty or end with "/", as objects with a sibling prefix would otherwise be synced too`),
+				Description:  `The folder to sync objects to, ending with "/", e.g. "static/". The name of each object is the prefix followed by the path of its file, relative to source. Defaults to the root of the bucket.`,
+			},
+
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Glob patterns of the files to sync, relative to source. "*" matches any characters except "/" and "**" matches any characters. Defaults to all files.`,
+			},
+
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Glob patterns of the files not to sync, relative to source. Takes precedence over include.`,
+			},
+
+			"detect_content_type": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Whether to set the Content-Type of objects based on the extension of their file. Objects with an unknown extension use the default Content-Type of Cloud Storage.`,
+			},
+
+			"metadata_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Rules setting the metadata of the objects whose file matches a pattern. When several rules match a file, later rules override the values set by earlier ones.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `The glob pattern of the files the rule applies to, relative to source.`,
+						},
+						"content_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Type of the object data.`,
+						},
+						"cache_control": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Cache-Control directive to specify caching behavior of object data.`,
+						},
+						"content_disposition": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Disposition of the object data.`,
+						},
+						"content_encoding": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Encoding of the object data.`,
+						},
+						"content_language": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Content-Language of the object data.`,
+						},
+						"metadata": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `User-provided metadata, in key/value pairs.`,
+						},
+					},
+				},
+			},
+
+			"delete_unmanaged_objects": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Whether to delete objects under the prefix that don't match a synced file, including objects not created by Terraform. By default, only objects previously synced by this resource are deleted when their file is removed.`,
+			},
+
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      16,
+				ValidateFunc: validation.IntBetween(1, 256),
+				Description:  `The number of files hashed, and objects uploaded or deleted, concurrently.`,
+			},
+
+			"objects": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `The synced objects, as a map of object names to the base64 CRC32C hash of their data.`,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+// syncFile is a local file synced to an object
+type syncFile struct {
+	// Path is the path of the file, and Rel the slash separated path relative to source
+	Path   string
+	Rel    string
+	Name   string
+	Md5    string
+	Crc32c string
+}
+
+// syncMetadataRule holds the object metadata set for files matching a pattern
+type syncMetadataRule struct {
+	Pattern            *regexp.Regexp
+	ContentType        string
+	CacheControl       string
+	ContentDisposition string
+	ContentEncoding    string
+	ContentLanguage    string
+	Metadata           map[string]string
+}
+
+func resourceStorageBucketObjectsSyncCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// The files can't be listed until the source and patterns are known
+	for _, k := range []string{"source", "prefix", "include", "exclude"} {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("objects")
+		}
+	}
+
+	files, err := listSyncFiles(d.Get("source").(string), d.Get("prefix").(string), tpgresource.ConvertStringArr(d.Get("include").([]interface{})), tpgresource.ConvertStringArr(d.Get("exclude").([]interface{})), d.Get("parallelism").(int))
+	if err != nil {
+		return err
+	}
+
+	objects := syncFilesManifest(files)
+	if reflect.DeepEqual(objects, tpgresource.ConvertStringMap(d.Get("objects").(map[string]interface{}))) {
+		return nil
+	}
+	return d.SetNew("objects", objects)
+}
+
+func resourceStorageBucketObjectsSyncCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := resourceStorageBucketObjectsSyncApply(d, meta, d.Timeout(schema.TimeoutCreate), false); err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("bucket").(string), d.Get("prefix").(string)))
+
+	return resourceStorageBucketObjectsSyncRead(d, meta)
+}
+
+func resourceStorageBucketObjectsSyncUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Objects are uploaded with their metadata, so changes to it upload every file again
+	uploadAll := d.HasChanges("metadata_rule", "detect_content_type")
+	if err := resourceStorageBucketObjectsSyncApply(d, meta, d.Timeout(schema.TimeoutUpdate), uploadAll); err != nil {
+		return err
+	}
+
+	return resourceStorageBucketObjectsSyncRead(d, meta)
+}
+
+func resourceStorageBucketObjectsSyncApply(d *schema.ResourceData, meta interface{}, timeout time.Duration, uploadAll bool) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	parallelism := d.Get("parallelism").(int)
+
+	files, err := listSyncFiles(d.Get("source").(string), prefix, tpgresource.ConvertStringArr(d.Get("include").([]interface{})), tpgresource.ConvertStringArr(d.Get("exclude").([]interface{})), parallelism)
+	if err != nil {
+		return err
+	}
+	rules, err := expandSyncMetadataRules(d.Get("metadata_rule").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	objectsService := storage.NewObjectsService(config.NewStorageClientWithTimeoutOverride(userAgent, timeout))
+	remote, err := listSyncObjects(config.Context, objectsService, bucket, prefix)
+	if err != nil {
+		return fmt.Errorf("Error listing objects in bucket %s with prefix %q: %s", bucket, prefix, err)
+	}
+
+	var uploads []syncFile
+	for _, f := range files {
+		if o, ok := remote[f.Name]; uploadAll || !ok || o.Crc32c != f.Crc32c {
+			uploads = append(uploads, f)
+		}
+	}
+
+	// Objects whose file was removed since the last apply are deleted, or all
+	// objects without a file if the resource manages the whole prefix
+	local := syncFilesManifest(files)
+	previous, _ := d.GetChange("objects")
+	var deletes []string
+	for name := range remote {
+		if _, ok := local[name]; ok {
+			continue
+		}
+		if _, managed := previous.(map[string]interface{})[name]; managed || d.Get("delete_unmanaged_objects").(bool) {
+			deletes = append(deletes, name)
+		}
+	}
+	sort.Strings(deletes)
+
+	log.Printf("[DEBUG] Syncing %d files to bucket %s with prefix %q: uploading %d objects, deleting %d objects", len(files), bucket, prefix, len(uploads), len(deletes))
+
+	detectContentType := d.Get("detect_content_type").(bool)
+	err = runSyncTasks(parallelism, len(uploads), func(i int) error {
+		f := uploads[i]
+		return uploadSyncFile(objectsService, bucket, f, syncFileObject(f, rules, detectContentType))
+	})
+	if err != nil {
+		return err
+	}
+
+	err = runSyncTasks(parallelism, len(deletes), func(i int) error {
+		if err := objectsService.Delete(bucket, deletes[i]).Do(); err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error deleting object %s: %s", deletes[i], err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := d.Set("objects", local); err != nil {
+		return fmt.Errorf("Error setting objects: %s", err)
+	}
+	return nil
+}
+
+func resourceStorageBucketObjectsSyncRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	objectsService := storage.NewObjectsService(config.NewStorageClientWithTimeoutOverride(userAgent, d.Timeout(schema.TimeoutRead)))
+	remote, err := listSyncObjects(config.Context, objectsService, bucket, prefix)
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Storage Bucket %q", bucket))
+	}
+
+	// Record the objects as they are in the bucket, so that objects changed or
+	// deleted outside of Terraform are uploaded again on the next apply
+	objects := make(map[string]string)
+	for name := range d.Get("objects").(map[string]interface{}) {
+		if o, ok := remote[name]; ok {
+			objects[name] = o.Crc32c
+		}
+	}
+	if d.Get("delete_unmanaged_objects").(bool) {
+		for name, o := range remote {
+			objects[name] = o.Crc32c
+		}
+	}
+
+	if err := d.Set("objects", objects); err != nil {
+		return fmt.Errorf("Error setting objects: %s", err)
+	}
+	return nil
+}
+
+func resourceStorageBucketObjectsSyncDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("bucket").(string)
+	objectsService := storage.NewObjectsService(config.NewStorageClientWithTimeoutOverride(userAgent, d.Timeout(schema.TimeoutDelete)))
+
+	var names []string
+	for name := range d.Get("objects").(map[string]interface{}) {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	err = runSyncTasks(d.Get("parallelism").(int), len(names), func(i int) error {
+		if err := objectsService.Delete(bucket, names[i]).Do(); err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			return fmt.Errorf("Error deleting object %s: %s", names[i], err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// listSyncFiles returns the files under source matching the include and exclude
+// patterns, with the hashes of their content
+func listSyncFiles(source, prefix string, include, exclude []string, parallelism int) ([]syncFile, error) {
+	includeRegexps, err := syncGlobsToRegexps(include)
+	if err != nil {
+		return nil, err
+	}
+	excludeRegexps, err := syncGlobsToRegexps(exclude)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []syncFile
+	err = filepath.WalkDir(source, func(p string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if len(include) > 0 && !matchesAnySyncRegexp(rel, includeRegexps) || matchesAnySyncRegexp(rel, excludeRegexps) {
+			return nil
+		}
+		files = append(files, syncFile{Path: p, Rel: rel, Name: prefix + rel})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing files in %s: %s", source, err)
+	}
+
+	err = runSyncTasks(parallelism, len(files), func(i int) error {
+		return hashSyncFile(&files[i])
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// hashSyncFile sets the base64 MD5 and CRC32C hashes of a file, as used by Cloud Storage
+func hashSyncFile(f *syncFile) error {
+	file, err := os.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	md5Hash := md5.New()
+	crc32cHash := crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	if _, err := io.Copy(io.MultiWriter(md5Hash, crc32cHash), file); err != nil {
+		return fmt.Errorf("Error reading %s: %s", f.Path, err)
+	}
+
+	crc32c := make([]byte, 4)
+	binary.BigEndian.PutUint32(crc32c, crc32cHash.Sum32())
+	f.Md5 = base64.StdEncoding.EncodeToString(md5Hash.Sum(nil))
+	f.Crc32c = base64.StdEncoding.EncodeToString(crc32c)
+	return nil
+}
+
+func syncFilesManifest(files []syncFile) map[string]string {
+	manifest := make(map[string]string, len(files))
+	for _, f := range files {
+		manifest[f.Name] = f.Crc32c
+	}
+	return manifest
+}
+
+// listSyncObjects lists the objects in a bucket with a prefix, keyed by name
+func listSyncObjects(ctx context.Context, objectsService *storage.ObjectsService, bucket, prefix string) (map[string]*storage.Object, error) {
+	objects := make(map[string]*storage.Object)
+	err := objectsService.List(bucket).Prefix(prefix).Fields("items(name,crc32c,md5Hash)", "nextPageToken").Pages(ctx, func(res *storage.Objects) error {
+		for _, o := range res.Items {
+			objects[o.Name] = o
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// syncFileObject returns the metadata of the object a file is uploaded to
+func syncFileObject(f syncFile, rules []syncMetadataRule, detectContentType bool) *storage.Object {
+	object := &storage.Object{
+		Name: f.Name,
+		// Cloud Storage rejects the upload if the data doesn't match these hashes
+		Md5Hash: f.Md5,
+		Crc32c:  f.Crc32c,
+	}
+
+	if detectContentType {
+		object.ContentType = mime.TypeByExtension(path.Ext(f.Name))
+	}
+
+	for _, rule := range rules {
+		if !rule.Pattern.MatchString(f.Rel) {
+			continue
+		}
+		if rule.ContentType != "" {
+			object.ContentType = rule.ContentType
+		}
+		if rule.CacheControl != "" {
+			object.CacheControl = rule.CacheControl
+		}
+		if rule.ContentDisposition != "" {
+			object.ContentDisposition = rule.ContentDisposition
+		}
+		if rule.ContentEncoding != "" {
+			object.ContentEncoding = rule.ContentEncoding
+		}
+		if rule.ContentLanguage != "" {
+			object.ContentLanguage = rule.ContentLanguage
+		}
+		for k, v := range rule.Metadata {
+			if object.Metadata == nil {
+				object.Metadata = make(map[string]string)
+			}
+			object.Metadata[k] = v
+		}
+	}
+	return object
+}
+
+func uploadSyncFile(objectsService *storage.ObjectsService, bucket string, f syncFile, object *storage.Object) error {
+	media, err := os.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer media.Close()
+
+	insertCall := objectsService.Insert(bucket, object)
+	insertCall.Name(f.Name)
+	insertCall.Media(media)
+	if _, err := insertCall.Do(); err != nil {
+		return fmt.Errorf("Error uploading object %s: %s", f.Name, err)
+	}
+	return nil
+}
+
+func expandSyncMetadataRules(configured []interface{}) ([]syncMetadataRule, error) {
+	rules := make([]syncMetadataRule, 0, len(configured))
+	for _, raw := range configured {
+		original := raw.(map[string]interface{})
+		pattern, err := syncGlobToRegexp(original["pattern"].(string))
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, syncMetadataRule{
+			Pattern:            pattern,
+			ContentType:        original["content_type"].(string),
+			CacheControl:       original["cache_control"].(string),
+			ContentDisposition: original["content_disposition"].(string),
+			ContentEncoding:    original["content_encoding"].(string),
+			ContentLanguage:    original["content_language"].(string),
+			Metadata:           tpgresource.ConvertStringMap(original["metadata"].(map[string]interface{})),
+		})
+	}
+	return rules, nil
+}
+
+// runSyncTasks runs a task for each index in [0, count) with at most parallelism
+// tasks running at once, and returns the errors of all failed tasks
+func runSyncTasks(parallelism, count int, task func(i int) error) error {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var errs *multierror.Error
+
+	indexes := make(chan int)
+	for w := 0; w < parallelism && w < count; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if err := task(i); err != nil {
+					mutex.Lock()
+					errs = multierror.Append(errs, err)
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+func syncGlobsToRegexps(globs []string) ([]*regexp.Regexp, error) {
+	regexps := make([]*regexp.Regexp, 0, len(globs))
+	for _, glob := range globs {
+		re, err := syncGlobToRegexp(glob)
+		if err != nil {
+			return nil, err
+		}
+		regexps = append(regexps, re)
+	}
+	return regexps, nil
+}
+
+// syncGlobToRegexp converts a glob pattern to a regular expression matching
+// slash separated paths. "**/" matches any number of directories, "**" any
+// characters, "*" any characters except "/" and "?" one character except "/".
+func syncGlobToRegexp(glob string) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case glob[i] == '*':
+			b.WriteString("[^/]*")
+		case glob[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+	b.WriteString("$")
+
+	re, err := regexp.Compile(b.String())
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing glob pattern %q: %s", glob, err)
+	}
+	return re, nil
+}
+
+func matchesAnySyncRegexp(s string, regexps []*regexp.Regexp) bool {
+	for _, re := range regexps {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,456 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"google.golang.org/api/storage/v1"
+)
+
+var fakeUploadContentRangeRegexp = regexp.MustCompile(`^bytes (?:(\d+)-(\d+)|\*)/(\d+)$`)
+
+type fakeUploadSession struct {
+	object *storage.Object
+	data   []byte
+}
+
+// fakeStorageServer implements the parts of the Cloud Storage JSON API used to sync
+// and upload objects: listing, multipart and resumable uploads, compose and delete
+// requests
+type fakeStorageServer struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	objects  map[string]*storage.Object
+	contents map[string][]byte
+	sessions map[string]*fakeUploadSession
+	uploads  []string
+	deletes  []string
+	composed []string
+	chunks   []string
+
+	// failChunk is the number of the chunk request that fails with a 503 after
+	// persisting failPersist bytes of it. 0 disables failures.
+	failChunk   int
+	failPersist int64
+
+	// corrupt flips a byte of the uploaded data, as if it was corrupted in transit
+	corrupt bool
+}
+
+func newFakeStorageServer(t *testing.T) *fakeStorageServer {
+	s := &fakeStorageServer{
+		objects:  make(map[string]*storage.Object),
+		contents: make(map[string][]byte),
+		sessions: make(map[string]*fakeUploadSession),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *fakeStorageServer) config() *transport_tpg.Config {
+	return &transport_tpg.Config{
+		Context:         context.Background(),
+		Client:          s.Client(),
+		StorageBasePath: s.URL + "/storage/v1/",
+		UserAgent:       "test",
+	}
+}
+
+func (s *fakeStorageServer) put(name, data string) {
+	s.store(name, []byte(data))
+}
+
+// store creates an object with the data and returns its metadata
+func (s *fakeStorageServer) store(name string, data []byte) *storage.Object {
+	s.objects[name] = &storage.Object{
+		Bucket:     "my-bucket",
+		Name:       name,
+		Generation: 1,
+		Size:       uint64(len(data)),
+		Crc32c:     testCrc32c(data),
+	}
+	s.contents[name] = data
+	return s.objects[name]
+}
+
+func (s *fakeStorageServer) names() []string {
+	var names []string
+	for name := range s.objects {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (s *fakeStorageServer) handle(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	path := r.URL.EscapedPath()
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/storage/v1/b/") && strings.HasSuffix(path, "/o"):
+		res := &storage.Objects{}
+		for _, name := range s.names() {
+			if strings.HasPrefix(name, r.URL.Query().Get("prefix")) {
+				res.Items = append(res.Items, s.objects[name])
+			}
+		}
+		json.NewEncoder(w).Encode(res)
+
+	case r.Method == http.MethodPost && strings.HasPrefix(path, "/upload/storage/v1/b/") && r.URL.Query().Get("uploadType") == "resumable":
+		object := &storage.Object{}
+		if err := json.NewDecoder(r.Body).Decode(object); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		id := strconv.Itoa(len(s.sessions))
+		s.sessions[id] = &fakeUploadSession{object: object}
+		w.Header().Set("Location", s.URL+"/upload/sessions/"+id)
+
+	case r.Method == http.MethodPut && strings.HasPrefix(path, "/upload/sessions/"):
+		session, ok := s.sessions[strings.TrimPrefix(path, "/upload/sessions/")]
+		if !ok {
+			http.Error(w, "unknown session", http.StatusNotFound)
+			return
+		}
+		m := fakeUploadContentRangeRegexp.FindStringSubmatch(r.Header.Get("Content-Range"))
+		if m == nil {
+			http.Error(w, "invalid Content-Range", http.StatusBadRequest)
+			return
+		}
+		size, _ := strconv.ParseInt(m[3], 10, 64)
+		if m[1] != "" {
+			s.chunks = append(s.chunks, m[1]+"-"+m[2])
+			start, _ := strconv.ParseInt(m[1], 10, 64)
+			if start != int64(len(session.data)) {
+				http.Error(w, "chunk doesn't start at the persisted offset", http.StatusBadRequest)
+				return
+			}
+			data, _ := io.ReadAll(r.Body)
+			if len(s.chunks) == s.failChunk {
+				session.data = append(session.data, data[:s.failPersist]...)
+				http.Error(w, "backend error", http.StatusServiceUnavailable)
+				return
+			}
+			session.data = append(session.data, data...)
+		}
+		if int64(len(session.data)) < size {
+			if len(session.data) > 0 {
+				w.Header().Set("Range", fmt.Sprintf("bytes=0-%d", len(session.data)-1))
+			}
+			w.Header().Set("X-Http-Status-Code-Override", "308")
+			return
+		}
+		data := session.data
+		if s.corrupt {
+			data = append([]byte{data[0] ^ 0xff}, data[1:]...)
+		}
+		json.NewEncoder(w).Encode(s.store(session.object.Name, data))
+
+	case r.Method == http.MethodPost && strings.HasSuffix(path, "/compose"):
+		name, _ := url.PathUnescape(strings.TrimSuffix(path[strings.Index(path, "/o/")+len("/o/"):], "/compose"))
+		req := &storage.ComposeRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var data []byte
+		for _, source := range req.SourceObjects {
+			s.composed = append(s.composed, source.Name)
+			data = append(data, s.contents[source.Name]...)
+		}
+		object := s.store(name, data)
+		object.ComponentCount = int64(len(req.SourceObjects))
+		json.NewEncoder(w).Encode(object)
+
+	case r.Method == http.MethodPost && strings.HasPrefix(path, "/upload/storage/v1/b/"):
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		reader := multipart.NewReader(r.Body, params["boundary"])
+		part, err := reader.NextPart()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		object := &storage.Object{}
+		if err := json.NewDecoder(part).Decode(object); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		part, err = reader.NextPart()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data, _ := io.ReadAll(part)
+		if object.Crc32c != testCrc32c(data) {
+			http.Error(w, "Provided CRC32C doesn't match calculated CRC32C", http.StatusBadRequest)
+			return
+		}
+		if object.Md5Hash != testMd5(data) {
+			http.Error(w, "Provided MD5 hash doesn't match calculated MD5 hash", http.StatusBadRequest)
+			return
+		}
+		s.objects[object.Name] = object
+		s.contents[object.Name] = data
+		s.uploads = append(s.uploads, object.Name)
+		json.NewEncoder(w).Encode(object)
+
+	case r.Method == http.MethodDelete && strings.Contains(path, "/o/"):
+		name, _ := url.PathUnescape(path[strings.Index(path, "/o/")+len("/o/"):])
+		if _, ok := s.objects[name]; !ok {
+			http.Error(w, `{"error": {"code": 404, "message": "No such object"}}`, http.StatusNotFound)
+			return
+		}
+		delete(s.objects, name)
+		delete(s.contents, name)
+		s.deletes = append(s.deletes, name)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		http.Error(w, "unexpected request "+r.Method+" "+path, http.StatusBadRequest)
+	}
+}
+
+func testCrc32c(data []byte) string {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli)))
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func testMd5(data []byte) string {
+	sum := md5.Sum(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func writeTestSyncFiles(t *testing.T, dir string, files map[string]string) {
+	for name, data := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestResourceStorageBucketObjectsSync(t *testing.T) {
+	server := newFakeStorageServer(t)
+	config := server.config()
+	source := t.TempDir()
+	writeTestSyncFiles(t, source, map[string]string{
+		"index.html":      "<html></html>",
+		"css/site.css":    "body {}",
+		"build/debug.log": "debug",
+	})
+	server.put("site/unmanaged.txt", "unmanaged")
+
+	r := ResourceStorageBucketObjectsSync()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"bucket":  "my-bucket",
+		"source":  source,
+		"prefix":  "site/",
+		"exclude": []interface{}{"**/*.log"},
+		"metadata_rule": []interface{}{
+			map[string]interface{}{
+				"pattern":       "**/*.html",
+				"cache_control": "no-cache",
+				"metadata":      map[string]interface{}{"team": "web"},
+			},
+		},
+	})
+
+	// Create uploads the included files
+	if err := resourceStorageBucketObjectsSyncCreate(d, config); err != nil {
+		t.Fatalf("unexpected error on create: %s", err)
+	}
+	if d.Id() != "my-bucket/site/" {
+		t.Errorf("unexpected id %q", d.Id())
+	}
+	sort.Strings(server.uploads)
+	if expected := []string{"site/css/site.css", "site/index.html"}; !reflect.DeepEqual(server.uploads, expected) {
+		t.Errorf("expected uploads %v, got %v", expected, server.uploads)
+	}
+	expectedObjects := map[string]interface{}{
+		"site/css/site.css": testCrc32c([]byte("body {}")),
+		"site/index.html":   testCrc32c([]byte("<html></html>")),
+	}
+	if objects := d.Get("objects"); !reflect.DeepEqual(objects, expectedObjects) {
+		t.Errorf("expected objects %v, got %v", expectedObjects, objects)
+	}
+	index := server.objects["site/index.html"]
+	if index.ContentType != "text/html; charset=utf-8" || index.CacheControl != "no-cache" || index.Metadata["team"] != "web" {
+		t.Errorf("unexpected metadata of site/index.html: %+v", index)
+	}
+	if css := server.objects["site/css/site.css"]; css.ContentType != "text/css; charset=utf-8" || css.CacheControl != "" {
+		t.Errorf("unexpected metadata of site/css/site.css: %+v", css)
+	}
+
+	// Update uploads changed files and deletes the objects of removed files only
+	server.uploads = nil
+	writeTestSyncFiles(t, source, map[string]string{"css/site.css": "body { margin: 0 }"})
+	if err := os.Remove(filepath.Join(source, "index.html")); err != nil {
+		t.Fatal(err)
+	}
+	d = r.Data(d.State())
+	if err := resourceStorageBucketObjectsSyncUpdate(d, config); err != nil {
+		t.Fatalf("unexpected error on update: %s", err)
+	}
+	if expected := []string{"site/css/site.css"}; !reflect.DeepEqual(server.uploads, expected) {
+		t.Errorf("expected uploads %v, got %v", expected, server.uploads)
+	}
+	if expected := []string{"site/index.html"}; !reflect.DeepEqual(server.deletes, expected) {
+		t.Errorf("expected deletes %v, got %v", expected, server.deletes)
+	}
+	if expected := []string{"site/css/site.css", "site/unmanaged.txt"}; !reflect.DeepEqual(server.names(), expected) {
+		t.Errorf("expected objects in bucket %v, got %v", expected, server.names())
+	}
+
+	// Read records objects changed outside of Terraform
+	server.put("site/css/site.css", "changed")
+	if err := resourceStorageBucketObjectsSyncRead(d, config); err != nil {
+		t.Fatalf("unexpected error on read: %s", err)
+	}
+	if crc32c := d.Get("objects").(map[string]interface{})["site/css/site.css"]; crc32c != testCrc32c([]byte("changed")) {
+		t.Errorf("expected the changed object to be read, got %v", crc32c)
+	}
+
+	// Delete only deletes the synced objects
+	server.deletes = nil
+	if err := resourceStorageBucketObjectsSyncDelete(d, config); err != nil {
+		t.Fatalf("unexpected error on delete: %s", err)
+	}
+	if expected := []string{"site/unmanaged.txt"}; !reflect.DeepEqual(server.names(), expected) {
+		t.Errorf("expected objects in bucket %v, got %v", expected, server.names())
+	}
+}
+
+func TestResourceStorageBucketObjectsSync_deleteUnmanagedObjects(t *testing.T) {
+	server := newFakeStorageServer(t)
+	config := server.config()
+	source := t.TempDir()
+	writeTestSyncFiles(t, source, map[string]string{"config.yaml": "key: value"})
+	server.put("config/config.yaml", "key: value")
+	server.put("config/stale.yaml", "stale")
+	server.put("other/file.txt", "other")
+
+	d := schema.TestResourceDataRaw(t, ResourceStorageBucketObjectsSync().Schema, map[string]interface{}{
+		"bucket":                   "my-bucket",
+		"source":                   source,
+		"prefix":                   "config/",
+		"delete_unmanaged_objects": true,
+	})
+	if err := resourceStorageBucketObjectsSyncCreate(d, config); err != nil {
+		t.Fatalf("unexpected error on create: %s", err)
+	}
+
+	// Unchanged objects aren't uploaded again
+	if len(server.uploads) != 0 {
+		t.Errorf("expected no uploads, got %v", server.uploads)
+	}
+	if expected := []string{"config/stale.yaml"}; !reflect.DeepEqual(server.deletes, expected) {
+		t.Errorf("expected deletes %v, got %v", expected, server.deletes)
+	}
+	if expected := []string{"config/config.yaml", "other/file.txt"}; !reflect.DeepEqual(server.names(), expected) {
+		t.Errorf("expected objects in bucket %v, got %v", expected, server.names())
+	}
+}
+
+func TestResourceStorageBucketObjectsSync_prefixValidation(t *testing.T) {
+	cases := map[string]struct {
+		Prefix  string
+		IsValid bool
+	}{
+		"bucket root":   {Prefix: "", IsValid: true},
+		"folder":        {Prefix: "static/", IsValid: true},
+		"nested folder": {Prefix: "site/static/", IsValid: true},
+		// "static" would also match "static-old/app.js" and "staticfiles.txt"
+		"no trailing slash": {Prefix: "static", IsValid: false},
+		"object name":       {Prefix: "site/index.html", IsValid: false},
+	}
+
+	validate := ResourceStorageBucketObjectsSync().Schema["prefix"].ValidateFunc
+	for tn, tc := range cases {
+		_, errs := validate(tc.Prefix, "prefix")
+		if isValid := len(errs) == 0; isValid != tc.IsValid {
+			t.Errorf("%s: expected prefix %q to be valid: %t, got errors %v", tn, tc.Prefix, tc.IsValid, errs)
+		}
+	}
+}
+
+func TestSyncGlobToRegexp(t *testing.T) {
+	cases := map[string]struct {
+		Glob    string
+		Matches []string
+		Misses  []string
+	}{
+		"star": {
+			Glob:    "*.html",
+			Matches: []string{"index.html"},
+			Misses:  []string{"docs/index.html", "index.htm"},
+		},
+		"double star directories": {
+			Glob:    "**/*.html",
+			Matches: []string{"index.html", "docs/index.html", "a/b/c.html"},
+			Misses:  []string{"index.css"},
+		},
+		"double star": {
+			Glob:    "assets/**",
+			Matches: []string{"assets/a.png", "assets/img/b.png"},
+			Misses:  []string{"other/assets/a.png"},
+		},
+		"question mark and special characters": {
+			Glob:    "v?.(1).txt",
+			Matches: []string{"v1.(1).txt"},
+			Misses:  []string{"v/.(1).txt", "v12.(1).txt"},
+		},
+	}
+
+	for tn, tc := range cases {
+		re, err := syncGlobToRegexp(tc.Glob)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %s", tn, err)
+		}
+		for _, s := range tc.Matches {
+			if !re.MatchString(s) {
+				t.Errorf("%s: expected %q to match %q", tn, tc.Glob, s)
+			}
+		}
+		for _, s := range tc.Misses {
+			if re.MatchString(s) {
+				t.Errorf("%s: expected %q not to match %q", tn, tc.Glob, s)
+			}
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"google.golang.org/api/storage/v1"
+)
+
+func TestAccStorageBucketObjectsSync_basic(t *testing.T) {
+	// Files are read from the local filesystem
+	acctest.SkipIfVcr(t)
+	t.Parallel()
+
+	bucketName := acctest.TestBucketName(t)
+	source := t.TempDir()
+	writeFiles := func(files map[string]string) {
+		for name, data := range files {
+			p := filepath.Join(source, name)
+			if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(p, []byte(data), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	writeFiles(map[string]string{
+		"index.html":    "<html></html>",
+		"css/site.css":  "body {}",
+		"tmp/debug.log": "debug",
+	})
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucketObjectsSync_basic(bucketName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket_objects_sync.site", "objects.%", "2"),
+					testAccCheckStorageBucketObjectsSyncObject(t, bucketName, "site/index.html", "text/html; charset=utf-8", "no-cache"),
+					testAccCheckStorageBucketObjectsSyncObject(t, bucketName, "site/css/site.css", "text/css; charset=utf-8", ""),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFiles(map[string]string{"css/site.css": "body { margin: 0 }"})
+					if err := os.Remove(filepath.Join(source, "index.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccStorageBucketObjectsSync_basic(bucketName, source),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("google_storage_bucket_objects_sync.site", "objects.%", "1"),
+					testAccCheckStorageBucketObjectsSyncObject(t, bucketName, "site/css/site.css", "text/css; charset=utf-8", ""),
+					testAccCheckStorageBucketObjectsSyncObjectDeleted(t, bucketName, "site/index.html"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStorageBucketObjectsSyncObject(t *testing.T, bucket, name, contentType, cacheControl string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+
+		objectsService := storage.NewObjectsService(config.NewStorageClient(config.UserAgent))
+		res, err := objectsService.Get(bucket, name).Do()
+		if err != nil {
+			return fmt.Errorf("Error retrieving object %s: %s", name, err)
+		}
+		if res.ContentType != contentType {
+			return fmt.Errorf("Object %s has content type %q, expected %q", name, res.ContentType, contentType)
+		}
+		if res.CacheControl != cacheControl {
+			return fmt.Errorf("Object %s has cache control %q, expected %q", name, res.CacheControl, cacheControl)
+		}
+		return nil
+	}
+}
+
+func testAccCheckStorageBucketObjectsSyncObjectDeleted(t *testing.T, bucket, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+
+		objectsService := storage.NewObjectsService(config.NewStorageClient(config.UserAgent))
+		if _, err := objectsService.Get(bucket, name).Do(); err == nil {
+			return fmt.Errorf("Object %s still exists", name)
+		}
+		return nil
+	}
+}
+
+func testAccStorageBucketObjectsSync_basic(bucketName, source string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name          = "%s"
+  location      = "US"
+  force_destroy = true
+}
+
+resource "google_storage_bucket_objects_sync" "site" {
+  bucket  = google_storage_bucket.bucket.name
+  source  = "%s"
+  prefix  = "site/"
+  exclude = ["**/*.log"]
+
+  metadata_rule {
+    pattern       = "**/*.html"
+    cache_control = "no-cache"
+  }
+}
+`, bucketName, source)
+}
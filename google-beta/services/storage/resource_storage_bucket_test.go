@@ -1470,6 +1470,62 @@ func TestAccStorageBucket_SoftDeletePolicy(t *testing.T) {
 	})
 }
 
+func TestAccStorageBucket_hierarchicalNamespace(t *testing.T) {
+	t.Parallel()
+
+	var bucket storage.Bucket
+	bucketName := fmt.Sprintf("tf-test-acc-bucket-%d", acctest.RandInt(t))
+
+	acctest.VcrTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.AccTestPreCheck(t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories(t),
+		CheckDestroy:             testAccStorageBucketDestroyProducer(t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStorageBucket_hierarchicalNamespace(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketExists(
+						t, "google_storage_bucket.bucket", bucketName, &bucket),
+					resource.TestCheckResourceAttr(
+						"google_storage_bucket.bucket", "hierarchical_namespace.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            "google_storage_bucket.bucket",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy"},
+			},
+			{
+				// Folders and their objects are deleted with the bucket when force_destroy is set
+				Config: testAccStorageBucket_hierarchicalNamespace(bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStorageBucketPutFolderItem(t, bucketName, "parent/child/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStorageBucketPutFolderItem(t *testing.T, bucketName, folderName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := acctest.GoogleProviderConfig(t)
+		client := config.NewStorageClient(config.UserAgent)
+
+		if _, err := client.Folders.Insert(bucketName, &storage.Folder{Name: folderName}).Recursive(true).Do(); err != nil {
+			return fmt.Errorf("Folders.Insert failed: %v", err)
+		}
+
+		dataReader := bytes.NewReader([]byte("test"))
+		object := &storage.Object{Name: folderName + "bucketDestroyTestFile"}
+		if _, err := client.Objects.Insert(bucketName, object).Media(dataReader).Do(); err != nil {
+			return fmt.Errorf("Objects.Insert failed: %v", err)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckStorageBucketExists(t *testing.T, n string, bucketName string, bucket *storage.Bucket) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2470,6 +2526,21 @@ resource "google_storage_bucket" "bucket" {
 `, bucketName, duration)
 }
 
+func testAccStorageBucket_hierarchicalNamespace(bucketName string) string {
+	return fmt.Sprintf(`
+resource "google_storage_bucket" "bucket" {
+  name                        = "%s"
+  location                    = "US"
+  force_destroy               = true
+  uniform_bucket_level_access = true
+
+  hierarchical_namespace {
+    enabled = true
+  }
+}
+`, bucketName)
+}
+
 func testAccStorageBucket_websiteNoAttributes(bucketName string) string {
 	return fmt.Sprintf(`
 resource "google_storage_bucket" "website" {
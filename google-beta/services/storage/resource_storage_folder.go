@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/verify"
+
+	"google.golang.org/api/storage/v1"
+)
+
+func ResourceStorageFolder() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStorageFolderCreate,
+		Read:   resourceStorageFolderRead,
+		Update: resourceStorageFolderUpdate,
+		Delete: resourceStorageFolderDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: resourceStorageFolderImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: tpgresource.CompareSelfLinkOrResourceName,
+				Description:      `The name of the bucket that contains the folder. The bucket must have hierarchical namespace enabled.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidateRegexp(`/$`),
+				Description: `The name of the folder expressed as a path. Must include
+trailing '/'. For example, 'example_dir/example_dir2/'.`,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The timestamp at which this folder was created.`,
+			},
+			"metageneration": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The metadata generation of the folder.`,
+			},
+			"update_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The timestamp at which this folder was most recently updated.`,
+			},
+			"force_destroy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If set to true, the objects, folders and managed folders under the folder are
+deleted before the folder itself. If a non-empty folder is deleted without this
+field set to true, the deletion fails.`,
+			},
+			"recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `If set to true, the missing parent folders of the folder are created along with it.
+Only used at creation, parent folders aren't deleted with the folder.`,
+			},
+			"self_link": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+		UseJSONNumber: true,
+	}
+}
+
+func resourceStorageFolderCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	obj := make(map[string]interface{})
+	bucketProp, err := expandStorageFolderBucket(d.Get("bucket"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("bucket"); !tpgresource.IsEmptyValue(reflect.ValueOf(bucketProp)) && (ok || !reflect.DeepEqual(v, bucketProp)) {
+		obj["bucket"] = bucketProp
+	}
+	nameProp, err := expandStorageFolderName(d.Get("name"), d, config)
+	if err != nil {
+		return err
+	} else if v, ok := d.GetOkExists("name"); !tpgresource.IsEmptyValue(reflect.ValueOf(nameProp)) && (ok || !reflect.DeepEqual(v, nameProp)) {
+		obj["name"] = nameProp
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{StorageBasePath}}b/{{bucket}}/folders")
+	if err != nil {
+		return err
+	}
+	if d.Get("recursive").(bool) {
+		url, err = transport_tpg.AddQueryParams(url, map[string]string{"recursive": "true"})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Creating new Folder: %#v", obj)
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	headers := make(http.Header)
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "POST",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutCreate),
+		Headers:   headers,
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating Folder: %s", err)
+	}
+
+	// Store the ID now
+	id, err := tpgresource.ReplaceVars(d, config, "{{bucket}}/{{name}}")
+	if err != nil {
+		return fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	log.Printf("[DEBUG] Finished creating Folder %q: %#v", d.Id(), res)
+
+	return resourceStorageFolderRead(d, meta)
+}
+
+func resourceStorageFolderRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{StorageBasePath}}b/{{bucket}}/folders/{{%name}}")
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	headers := make(http.Header)
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Headers:   headers,
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("StorageFolder %q", d.Id()))
+	}
+
+	// Explicitly set virtual fields to default values if unset
+	if _, ok := d.GetOkExists("force_destroy"); !ok {
+		if err := d.Set("force_destroy", false); err != nil {
+			return fmt.Errorf("Error setting force_destroy: %s", err)
+		}
+	}
+	if _, ok := d.GetOkExists("recursive"); !ok {
+		if err := d.Set("recursive", false); err != nil {
+			return fmt.Errorf("Error setting recursive: %s", err)
+		}
+	}
+
+	if err := d.Set("bucket", flattenStorageFolderBucket(res["bucket"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Folder: %s", err)
+	}
+	if err := d.Set("name", flattenStorageFolderName(res["name"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Folder: %s", err)
+	}
+	if err := d.Set("create_time", flattenStorageFolderCreateTime(res["createTime"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Folder: %s", err)
+	}
+	if err := d.Set("metageneration", flattenStorageFolderMetageneration(res["metageneration"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Folder: %s", err)
+	}
+	if err := d.Set("update_time", flattenStorageFolderUpdateTime(res["updateTime"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Folder: %s", err)
+	}
+	if err := d.Set("self_link", tpgresource.ConvertSelfLinkToV1(res["selfLink"].(string))); err != nil {
+		return fmt.Errorf("Error reading Folder: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStorageFolderUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only the virtual fields "force_destroy" and "recursive" are mutable
+	return resourceStorageFolderRead(d, meta)
+}
+
+func resourceStorageFolderDelete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*transport_tpg.Config)
+	userAgent, err := tpgresource.GenerateUserAgentString(d, config.UserAgent)
+	if err != nil {
+		return err
+	}
+
+	billingProject := ""
+
+	url, err := tpgresource.ReplaceVars(d, config, "{{StorageBasePath}}b/{{bucket}}/folders/{{%name}}")
+	if err != nil {
+		return err
+	}
+
+	var obj map[string]interface{}
+
+	// err == nil indicates that the billing_project value was found
+	if bp, err := tpgresource.GetBillingProject(d, config); err == nil {
+		billingProject = bp
+	}
+
+	headers := make(http.Header)
+	if d.Get("force_destroy").(bool) {
+		if err := deleteStorageFolderContents(config, userAgent, d.Get("bucket").(string), d.Get("name").(string)); err != nil {
+			return err
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting Folder %q", d.Id())
+	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+		Config:    config,
+		Method:    "DELETE",
+		Project:   billingProject,
+		RawURL:    url,
+		UserAgent: userAgent,
+		Body:      obj,
+		Timeout:   d.Timeout(schema.TimeoutDelete),
+		Headers:   headers,
+	})
+	if err != nil {
+		return transport_tpg.HandleNotFoundError(err, d, "Folder")
+	}
+
+	log.Printf("[DEBUG] Finished deleting Folder %q: %#v", d.Id(), res)
+	return nil
+}
+
+func resourceStorageFolderImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*transport_tpg.Config)
+	if err := tpgresource.ParseImportId([]string{
+		"^(?P<bucket>[^/]+)/folders/(?P<name>.+)$",
+		"^(?P<bucket>[^/]+)/(?P<name>.+)$",
+	}, d, config); err != nil {
+		return nil, err
+	}
+
+	// Replace import id for the resource id
+	id, err := tpgresource.ReplaceVars(d, config, "{{bucket}}/{{name}}")
+	if err != nil {
+		return nil, fmt.Errorf("Error constructing id: %s", err)
+	}
+	d.SetId(id)
+
+	// Explicitly set virtual fields to default values on import
+	if err := d.Set("force_destroy", false); err != nil {
+		return nil, fmt.Errorf("Error setting force_destroy: %s", err)
+	}
+	if err := d.Set("recursive", false); err != nil {
+		return nil, fmt.Errorf("Error setting recursive: %s", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// deleteStorageFolderContents deletes the objects, managed folders and folders under a folder.
+func deleteStorageFolderContents(config *transport_tpg.Config, userAgent, bucket, name string) error {
+	client := config.NewStorageClient(userAgent)
+
+	err := client.Objects.List(bucket).Prefix(name).Versions(true).Fields("items(name,generation)", "nextPageToken").Pages(config.Context, func(res *storage.Objects) error {
+		for _, object := range res.Items {
+			log.Printf("[TRACE] Attempting to delete %s", object.Name)
+			if err := client.Objects.Delete(bucket, object.Name).Generation(object.Generation).Do(); err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+				return fmt.Errorf("Error deleting object %s: %s", object.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting objects of folder %s: %s", name, err)
+	}
+
+	err = client.ManagedFolders.List(bucket).Prefix(name).Fields("items(name)", "nextPageToken").Pages(config.Context, func(res *storage.ManagedFolders) error {
+		for _, managedFolder := range res.Items {
+			log.Printf("[TRACE] Attempting to delete managed folder %s", managedFolder.Name)
+			if err := client.ManagedFolders.Delete(bucket, managedFolder.Name).AllowNonEmpty(true).Do(); err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+				return fmt.Errorf("Error deleting managed folder %s: %s", managedFolder.Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting managed folders of folder %s: %s", name, err)
+	}
+
+	names, err := listStorageFolders(config, userAgent, bucket, name)
+	if err != nil {
+		return err
+	}
+	var subfolders []string
+	for _, n := range names {
+		if n != name {
+			subfolders = append(subfolders, n)
+		}
+	}
+	return deleteStorageFolders(config, userAgent, bucket, subfolders)
+}
+
+func flattenStorageFolderBucket(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	if v == nil {
+		return v
+	}
+	return tpgresource.ConvertSelfLinkToV1(v.(string))
+}
+
+func flattenStorageFolderName(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenStorageFolderCreateTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenStorageFolderMetageneration(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func flattenStorageFolderUpdateTime(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return v
+}
+
+func expandStorageFolderBucket(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
+
+func expandStorageFolderName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
+	return v, nil
+}
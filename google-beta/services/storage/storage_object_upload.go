@@ -0,0 +1,402 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+const (
+	// The chunks of a resumable upload, except the last one, must be multiples of 256 KiB
+	storageUploadChunkGranularity = 256 * 1024
+
+	// A compose request accepts at most 32 source objects
+	storageComposeMaxSourceObjects = 32
+
+	storageCompositeUploadDefaultParts = 8
+
+	// Temporary objects of parallel composite uploads are named after this prefix,
+	// so that they can be found and deleted if an upload is interrupted.
+	storageCompositeUploadTempPrefix = "terraform/tmp/parallel_composite_uploads/"
+)
+
+var storageUploadRangeRegexp = regexp.MustCompile(`^bytes=0-(\d+)$`)
+
+// storageObjectUpload uploads a local file to an object with a resumable upload
+// session, in chunks, or as a parallel composite upload.
+type storageObjectUpload struct {
+	config    *transport_tpg.Config
+	userAgent string
+	timeout   time.Duration
+
+	// object is the metadata of the uploaded object, including its bucket and name
+	object             *storage.Object
+	customerEncryption map[string]string
+
+	file *os.File
+	size int64
+
+	// chunkSize is the size of the chunks of resumable uploads. If 0, the file
+	// is only uploaded with a resumable upload as a parallel composite upload,
+	// whose parts are sent in chunks of googleapi.DefaultUploadChunkSize.
+	chunkSize int64
+
+	// compositeThreshold is the size above which the file is uploaded as a
+	// parallel composite upload of compositeParts parts. 0 disables it.
+	compositeThreshold int64
+	compositeParts     int
+}
+
+func (u *storageObjectUpload) resumable() bool {
+	return u.chunkSize > 0 && u.size > u.chunkSize
+}
+
+func (u *storageObjectUpload) composite() bool {
+	return u.compositeThreshold > 0 && u.size > u.compositeThreshold
+}
+
+// requestChunkSize returns the size of the chunks the data is sent in. Data is
+// always sent in chunks, as the provider's transport reads the whole body of a
+// request into memory to log and retry it.
+func (u *storageObjectUpload) requestChunkSize() int64 {
+	if u.chunkSize > 0 {
+		return u.chunkSize
+	}
+	return googleapi.DefaultUploadChunkSize
+}
+
+// Do uploads the file and verifies the CRC32C of the resulting object against the
+// file. An object that doesn't match the file is deleted.
+func (u *storageObjectUpload) Do() (*storage.Object, error) {
+	ctx, cancel := context.WithTimeout(u.config.Context, u.timeout)
+	defer cancel()
+
+	f := &syncFile{Path: u.file.Name()}
+	if err := hashSyncFile(f); err != nil {
+		return nil, err
+	}
+
+	var res *storage.Object
+	var err error
+	if u.composite() {
+		res, err = u.uploadComposite(ctx)
+	} else {
+		res, err = u.uploadRange(ctx, u.object, 0, u.size)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Crc32c != f.Crc32c {
+		if err := u.objectsService().Delete(res.Bucket, res.Name).Context(ctx).Do(); err != nil {
+			log.Printf("[WARN] Error deleting object %s with a mismatched CRC32C: %s", res.Name, err)
+		}
+		return nil, fmt.Errorf("CRC32C %q of the uploaded object doesn't match CRC32C %q of %s", res.Crc32c, f.Crc32c, f.Path)
+	}
+
+	return res, nil
+}
+
+func (u *storageObjectUpload) objectsService() *storage.ObjectsService {
+	return storage.NewObjectsService(u.config.NewStorageClientWithTimeoutOverride(u.userAgent, u.timeout))
+}
+
+// uploadComposite uploads the parts of the file as temporary objects in parallel,
+// composes them into the object and deletes the temporary objects.
+func (u *storageObjectUpload) uploadComposite(ctx context.Context) (*storage.Object, error) {
+	partSize := (u.size + int64(u.compositeParts) - 1) / int64(u.compositeParts)
+	count := int((u.size + partSize - 1) / partSize)
+
+	uploadId := strconv.FormatInt(time.Now().UnixNano(), 36)
+	parts := make([]*storage.Object, count)
+	defer u.deleteTemporaryObjects(parts)
+
+	err := runSyncTasks(count, count, func(i int) error {
+		start := int64(i) * partSize
+		length := partSize
+		if start+length > u.size {
+			length = u.size - start
+		}
+		part := &storage.Object{
+			Bucket: u.object.Bucket,
+			Name:   fmt.Sprintf("%s%s_%d", storageCompositeUploadTempPrefix, uploadId, i),
+		}
+		res, err := u.uploadRange(ctx, part, start, length)
+		if err != nil {
+			return fmt.Errorf("Error uploading part %d: %s", i, err)
+		}
+		parts[i] = res
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	destination := *u.object
+	destination.KmsKeyName = ""
+	composeRequest := &storage.ComposeRequest{Destination: &destination}
+	for _, part := range parts {
+		composeRequest.SourceObjects = append(composeRequest.SourceObjects, &storage.ComposeRequestSourceObjects{
+			Name:       part.Name,
+			Generation: part.Generation,
+		})
+	}
+
+	composeCall := u.objectsService().Compose(u.object.Bucket, u.object.Name, composeRequest).Context(ctx)
+	if u.object.KmsKeyName != "" {
+		composeCall.KmsKeyName(u.object.KmsKeyName)
+	}
+	if u.customerEncryption != nil {
+		setEncryptionHeaders(u.customerEncryption, composeCall.Header())
+	}
+
+	var res *storage.Object
+	err = transport_tpg.Retry(transport_tpg.RetryOptions{
+		Context: ctx,
+		Timeout: u.timeout,
+		RetryFunc: func() (err error) {
+			res, err = composeCall.Do()
+			return err
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error composing parts: %s", err)
+	}
+
+	return res, nil
+}
+
+// deleteTemporaryObjects deletes the uploaded parts of a parallel composite upload
+func (u *storageObjectUpload) deleteTemporaryObjects(parts []*storage.Object) {
+	objectsService := u.objectsService()
+	for _, part := range parts {
+		if part == nil {
+			continue
+		}
+		// The upload context may be done already, so the provider's context is used instead
+		err := objectsService.Delete(part.Bucket, part.Name).Generation(part.Generation).Context(u.config.Context).Do()
+		if err != nil && !transport_tpg.IsGoogleApiErrorWithCode(err, 404) {
+			log.Printf("[WARN] Error deleting temporary object %s of a parallel composite upload: %s", part.Name, err)
+		}
+	}
+}
+
+// uploadRange uploads size bytes of the file, starting at start, to an object with a
+// resumable upload session. A failed request is retried from the offset persisted by
+// Cloud Storage instead of restarting the upload.
+func (u *storageObjectUpload) uploadRange(ctx context.Context, object *storage.Object, start, size int64) (*storage.Object, error) {
+	var session string
+	err := transport_tpg.Retry(transport_tpg.RetryOptions{
+		Context: ctx,
+		Timeout: u.timeout,
+		RetryFunc: func() (err error) {
+			session, err = u.startSession(ctx, object, size)
+			return err
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var offset int64
+	for {
+		var res *storage.Object
+		before := offset
+		retrying := false
+		err := transport_tpg.Retry(transport_tpg.RetryOptions{
+			Context: ctx,
+			Timeout: u.timeout,
+			RetryFunc: func() error {
+				if retrying {
+					persisted, obj, err := u.sendChunk(ctx, session, start, 0, 0, size)
+					if err != nil {
+						return err
+					}
+					if obj != nil {
+						res = obj
+						return nil
+					}
+					log.Printf("[DEBUG] Resuming upload of %s from offset %d", object.Name, persisted)
+					offset = persisted
+				}
+				retrying = true
+
+				length := size - offset
+				if chunkSize := u.requestChunkSize(); length > chunkSize {
+					length = chunkSize
+				}
+				persisted, obj, err := u.sendChunk(ctx, session, start, offset, length, size)
+				if err != nil {
+					return err
+				}
+				res = obj
+				offset = persisted
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if res != nil {
+			return res, nil
+		}
+		if offset <= before {
+			return nil, fmt.Errorf("upload of %s made no progress at offset %d", object.Name, offset)
+		}
+	}
+}
+
+// startSession starts a resumable upload session for an object of size bytes and
+// returns its URI
+func (u *storageObjectUpload) startSession(ctx context.Context, object *storage.Object, size int64) (string, error) {
+	body, err := json.Marshal(object)
+	if err != nil {
+		return "", err
+	}
+
+	uploadUrl := googleapi.ResolveRelative(u.config.StorageBasePath, "/upload/storage/v1/b/"+url.PathEscape(object.Bucket)+"/o")
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, uploadUrl+"?alt=json&prettyPrint=false&uploadType=resumable", bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	req.Header.Set("X-Upload-Content-Length", strconv.FormatInt(size, 10))
+	if object.ContentType != "" {
+		req.Header.Set("X-Upload-Content-Type", object.ContentType)
+	}
+
+	res, err := u.do(req)
+	if err != nil {
+		return "", err
+	}
+	defer googleapi.CloseBody(res)
+	if err := googleapi.CheckResponse(res); err != nil {
+		return "", err
+	}
+
+	session := res.Header.Get("Location")
+	if session == "" {
+		return "", fmt.Errorf("no resumable upload session was returned for %s", object.Name)
+	}
+	return session, nil
+}
+
+// sendChunk sends length bytes at offset of an upload of size bytes, starting at start
+// in the file. A chunk of length 0 queries the status of the upload. It returns the
+// offset persisted by Cloud Storage, or the object once the upload is complete.
+func (u *storageObjectUpload) sendChunk(ctx context.Context, session string, start, offset, length, size int64) (int64, *storage.Object, error) {
+	contentRange := fmt.Sprintf("bytes */%d", size)
+	var body io.Reader = http.NoBody
+	if length > 0 {
+		contentRange = fmt.Sprintf("bytes %d-%d/%d", offset, offset+length-1, size)
+		body = io.NewSectionReader(u.file, start+offset, length)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, session, body)
+	if err != nil {
+		return 0, nil, err
+	}
+	req.ContentLength = length
+	req.Header.Set("Content-Range", contentRange)
+	// Cloud Storage responds to incomplete uploads with a 308 status code, which
+	// net/http would treat as a redirect. This header replaces it with a 200.
+	req.Header.Set("X-GUploader-No-308", "yes")
+
+	res, err := u.do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer googleapi.CloseBody(res)
+
+	if res.StatusCode == http.StatusPermanentRedirect || res.Header.Get("X-Http-Status-Code-Override") == "308" {
+		persisted, err := storageUploadPersistedOffset(res.Header.Get("Range"))
+		return persisted, nil, err
+	}
+	if err := googleapi.CheckResponse(res); err != nil {
+		return 0, nil, err
+	}
+
+	obj := &storage.Object{}
+	if err := json.NewDecoder(res.Body).Decode(obj); err != nil {
+		return 0, nil, err
+	}
+	return size, obj, nil
+}
+
+func (u *storageObjectUpload) do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("User-Agent", u.userAgent)
+	if u.customerEncryption != nil {
+		setEncryptionHeaders(u.customerEncryption, req.Header)
+	}
+	return u.config.Client.Do(req)
+}
+
+// storageUploadPersistedOffset returns the number of bytes persisted by Cloud Storage
+// from the Range header of an incomplete upload, like "bytes=0-262143"
+func storageUploadPersistedOffset(header string) (int64, error) {
+	if header == "" {
+		return 0, nil
+	}
+	m := storageUploadRangeRegexp.FindStringSubmatch(header)
+	if m == nil {
+		return 0, fmt.Errorf("unexpected Range header %q of an incomplete upload", header)
+	}
+	last, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	return last + 1, nil
+}
+
+func validateStorageUploadChunkSize(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value <= 0 || value%storageUploadChunkGranularity != 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive multiple of %d (256 KiB), got %d", k, storageUploadChunkGranularity, value))
+	}
+	return
+}
+
+// newStorageObjectUpload returns the upload of a source file to an object, as configured
+// by the chunked and parallel composite upload fields of google_storage_bucket_object
+func newStorageObjectUpload(d *schema.ResourceData, config *transport_tpg.Config, userAgent string, object *storage.Object, file *os.File) (*storageObjectUpload, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	u := &storageObjectUpload{
+		config:             config,
+		userAgent:          userAgent,
+		timeout:            d.Timeout(schema.TimeoutCreate),
+		object:             object,
+		file:               file,
+		size:               info.Size(),
+		chunkSize:          int64(d.Get("upload_chunk_size").(int)),
+		compositeThreshold: int64(d.Get("parallel_composite_upload_threshold").(int)),
+		compositeParts:     storageCompositeUploadDefaultParts,
+	}
+	if v, ok := d.GetOk("parallel_composite_upload_parts"); ok {
+		u.compositeParts = v.(int)
+	}
+	if v, ok := d.GetOk("customer_encryption"); ok {
+		u.customerEncryption = expandCustomerEncryption(v.([]interface{}))
+	}
+	return u, nil
+}
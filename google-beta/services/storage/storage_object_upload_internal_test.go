@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/storage/v1"
+)
+
+func newTestStorageObjectUpload(t *testing.T, server *fakeStorageServer, data []byte) *storageObjectUpload {
+	p := filepath.Join(t.TempDir(), "source")
+	if err := os.WriteFile(p, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { file.Close() })
+
+	return &storageObjectUpload{
+		config:         server.config(),
+		userAgent:      "test",
+		timeout:        time.Minute,
+		object:         &storage.Object{Bucket: "my-bucket", Name: "images/disk.raw"},
+		file:           file,
+		size:           int64(len(data)),
+		compositeParts: storageCompositeUploadDefaultParts,
+	}
+}
+
+func testUploadData(size int) []byte {
+	data := make([]byte, size)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+	return data
+}
+
+func TestStorageObjectUpload_resumable(t *testing.T) {
+	server := newFakeStorageServer(t)
+	// The second chunk fails after 1000 of its bytes were persisted
+	server.failChunk = 2
+	server.failPersist = 1000
+	data := testUploadData(2*storageUploadChunkGranularity + 100)
+
+	upload := newTestStorageObjectUpload(t, server, data)
+	upload.chunkSize = storageUploadChunkGranularity
+	if !upload.resumable() || upload.composite() {
+		t.Fatalf("expected a resumable upload that isn't composite")
+	}
+
+	res, err := upload.Do()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.Crc32c != testCrc32c(data) {
+		t.Errorf("unexpected CRC32C %q", res.Crc32c)
+	}
+	if !bytes.Equal(server.contents["images/disk.raw"], data) {
+		t.Errorf("the uploaded object doesn't match the source")
+	}
+
+	// The failed chunk is resumed from the persisted offset, with the rest of the data
+	resumed := fmt.Sprintf("%d-%d", storageUploadChunkGranularity+1000, len(data)-1)
+	if want := []string{"0-262143", "262144-524287", resumed}; !reflect.DeepEqual(server.chunks, want) {
+		t.Errorf("expected chunks %v, got %v", want, server.chunks)
+	}
+	if len(server.sessions) != 1 {
+		t.Errorf("expected a single upload session, got %d", len(server.sessions))
+	}
+}
+
+func TestStorageObjectUpload_composite(t *testing.T) {
+	server := newFakeStorageServer(t)
+	data := testUploadData(1000)
+
+	upload := newTestStorageObjectUpload(t, server, data)
+	upload.compositeThreshold = 100
+	upload.compositeParts = 3
+	if !upload.composite() {
+		t.Fatalf("expected a composite upload")
+	}
+
+	res, err := upload.Do()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if res.ComponentCount != 3 || res.Crc32c != testCrc32c(data) {
+		t.Errorf("unexpected composite object %+v", res)
+	}
+	if !bytes.Equal(server.contents["images/disk.raw"], data) {
+		t.Errorf("the composed object doesn't match the source")
+	}
+
+	// The parts are composed in order, and deleted afterwards
+	if len(server.composed) != 3 {
+		t.Fatalf("expected 3 composed parts, got %v", server.composed)
+	}
+	for i, name := range server.composed {
+		if !strings.HasPrefix(name, storageCompositeUploadTempPrefix) || !strings.HasSuffix(name, fmt.Sprintf("_%d", i)) {
+			t.Errorf("unexpected name %q of part %d", name, i)
+		}
+	}
+	if len(server.objects) != 1 {
+		t.Errorf("expected the temporary objects to be deleted, got %d objects", len(server.objects))
+	}
+}
+
+func TestStorageObjectUpload_compositeChunks(t *testing.T) {
+	server := newFakeStorageServer(t)
+	// Each part is one byte larger than a chunk
+	partSize := int64(googleapi.DefaultUploadChunkSize + 1)
+	data := testUploadData(int(2 * partSize))
+
+	upload := newTestStorageObjectUpload(t, server, data)
+	upload.compositeThreshold = 100
+	upload.compositeParts = 2
+
+	if _, err := upload.Do(); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !bytes.Equal(server.contents["images/disk.raw"], data) {
+		t.Errorf("the composed object doesn't match the source")
+	}
+
+	// Without upload_chunk_size, the parts are still sent in bounded requests
+	sort.Strings(server.chunks)
+	chunk := fmt.Sprintf("0-%d", partSize-2)
+	rest := fmt.Sprintf("%d-%d", partSize-1, partSize-1)
+	if want := []string{chunk, chunk, rest, rest}; !reflect.DeepEqual(server.chunks, want) {
+		t.Errorf("expected chunks %v, got %v", want, server.chunks)
+	}
+}
+
+func TestStorageObjectUpload_crc32cMismatch(t *testing.T) {
+	server := newFakeStorageServer(t)
+	server.corrupt = true
+
+	upload := newTestStorageObjectUpload(t, server, testUploadData(1000))
+	upload.compositeThreshold = 100
+
+	_, err := upload.Do()
+	if err == nil || !strings.Contains(err.Error(), "doesn't match CRC32C") {
+		t.Fatalf("expected a CRC32C mismatch error, got %v", err)
+	}
+	if len(server.objects) != 0 {
+		t.Errorf("expected the mismatched object and the temporary objects to be deleted, got %d objects", len(server.objects))
+	}
+}
+
+func TestStorageUploadPersistedOffset(t *testing.T) {
+	cases := map[string]struct {
+		Header   string
+		Expected int64
+		Error    bool
+	}{
+		"nothing persisted": {
+			Header:   "",
+			Expected: 0,
+		},
+		"persisted bytes": {
+			Header:   "bytes=0-262143",
+			Expected: 262144,
+		},
+		"invalid": {
+			Header: "bytes=100-200",
+			Error:  true,
+		},
+	}
+
+	for tn, tc := range cases {
+		offset, err := storageUploadPersistedOffset(tc.Header)
+		if tc.Error {
+			if err == nil {
+				t.Errorf("%s: expected an error", tn)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", tn, err)
+		}
+		if offset != tc.Expected {
+			t.Errorf("%s: expected offset %d, got %d", tn, tc.Expected, offset)
+		}
+	}
+}
@@ -57,10 +57,17 @@ func dataSourceTpuTensorFlowVersionsRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	versionsRaw, err := tpgresource.PaginatedListRequest(project, url, userAgent, config, flattenTpuTensorflowVersions)
+	verObjList, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:    config,
+		Project:   project,
+		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "tensorflowVersions",
+	})
 	if err != nil {
 		return fmt.Errorf("Error listing TPU Tensorflow versions: %s", err)
 	}
+	versionsRaw := flattenTpuTensorflowVersions(verObjList)
 
 	versions := make([]string, len(versionsRaw))
 	for i, ver := range versionsRaw {
@@ -84,8 +91,7 @@ func dataSourceTpuTensorFlowVersionsRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func flattenTpuTensorflowVersions(resp map[string]interface{}) []interface{} {
-	verObjList := resp["tensorflowVersions"].([]interface{})
+func flattenTpuTensorflowVersions(verObjList []interface{}) []interface{} {
 	versions := make([]interface{}, len(verObjList))
 	for i, v := range verObjList {
 		verObj := v.(map[string]interface{})
@@ -103,7 +103,7 @@ func ResourceTPUNode() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			tpuNodeCustomizeDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_tpu_node"),
 			tpgresource.DefaultProviderProject,
 		),
 
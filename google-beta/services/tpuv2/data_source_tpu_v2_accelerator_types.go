@@ -57,10 +57,17 @@ func dataSourceTpuV2AcceleratorTypesRead(d *schema.ResourceData, meta interface{
 		return err
 	}
 
-	typesRaw, err := tpgresource.PaginatedListRequest(project, url, userAgent, config, flattenTpuV2AcceleratorTypes)
+	typeObjList, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:    config,
+		Project:   project,
+		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "acceleratorTypes",
+	})
 	if err != nil {
 		return fmt.Errorf("error listing TPU v2 accelerator types: %s", err)
 	}
+	typesRaw := flattenTpuV2AcceleratorTypes(typeObjList)
 
 	types := make([]string, len(typesRaw))
 	for i, typeRaw := range typesRaw {
@@ -84,8 +91,7 @@ func dataSourceTpuV2AcceleratorTypesRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func flattenTpuV2AcceleratorTypes(resp map[string]interface{}) []interface{} {
-	typeObjList := resp["acceleratorTypes"].([]interface{})
+func flattenTpuV2AcceleratorTypes(typeObjList []interface{}) []interface{} {
 	types := make([]interface{}, len(typeObjList))
 	for i, typ := range typeObjList {
 		typeObj := typ.(map[string]interface{})
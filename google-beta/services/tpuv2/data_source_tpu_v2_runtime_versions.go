@@ -57,10 +57,17 @@ func dataSourceTpuV2RuntimeVersionsRead(d *schema.ResourceData, meta interface{}
 		return err
 	}
 
-	versionsRaw, err := tpgresource.PaginatedListRequest(project, url, userAgent, config, flattenTpuV2RuntimeVersions)
+	verObjList, err := transport_tpg.ListAll(transport_tpg.ListRequestOptions{
+		Config:    config,
+		Project:   project,
+		UserAgent: userAgent,
+		RawURL:    url,
+		ListKey:   "runtimeVersions",
+	})
 	if err != nil {
 		return fmt.Errorf("error listing TPU v2 runtime versions: %s", err)
 	}
+	versionsRaw := flattenTpuV2RuntimeVersions(verObjList)
 
 	versions := make([]string, len(versionsRaw))
 	for i, ver := range versionsRaw {
@@ -84,8 +91,7 @@ func dataSourceTpuV2RuntimeVersionsRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
-func flattenTpuV2RuntimeVersions(resp map[string]interface{}) []interface{} {
-	verObjList := resp["runtimeVersions"].([]interface{})
+func flattenTpuV2RuntimeVersions(verObjList []interface{}) []interface{} {
 	versions := make([]interface{}, len(verObjList))
 	for i, v := range verObjList {
 		verObj := v.(map[string]interface{})
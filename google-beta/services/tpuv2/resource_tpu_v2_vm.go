@@ -99,7 +99,7 @@ func ResourceTpuV2Vm() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			acceleratorTypeCustomizeDiff,
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_tpu_v2_vm"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -46,7 +46,7 @@ func ResourceVertexAIDataset() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_dataset"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceVertexAIEndpoint() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_endpoint"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceVertexAIFeatureGroup() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_feature_group"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceVertexAIFeatureGroupFeature() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_feature_group_feature"),
 			tpgresource.DefaultProviderProject,
 		),
 
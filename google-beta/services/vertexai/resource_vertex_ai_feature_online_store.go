@@ -50,7 +50,7 @@ func ResourceVertexAIFeatureOnlineStore() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_feature_online_store"),
 			tpgresource.DefaultProviderProject,
 		),
 
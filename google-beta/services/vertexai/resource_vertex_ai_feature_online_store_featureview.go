@@ -51,7 +51,7 @@ func ResourceVertexAIFeatureOnlineStoreFeatureview() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_feature_online_store_featureview"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -50,7 +50,7 @@ func ResourceVertexAIFeaturestore() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_featurestore"),
 			tpgresource.DefaultProviderProject,
 		),
 
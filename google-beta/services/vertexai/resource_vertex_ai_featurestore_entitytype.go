@@ -51,7 +51,7 @@ func ResourceVertexAIFeaturestoreEntitytype() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_featurestore_entitytype"),
 		),
 
 		Schema: map[string]*schema.Schema{
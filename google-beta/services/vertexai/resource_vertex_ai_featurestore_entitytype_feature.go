@@ -51,7 +51,7 @@ func ResourceVertexAIFeaturestoreEntitytypeFeature() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_featurestore_entitytype_feature"),
 		),
 
 		Schema: map[string]*schema.Schema{
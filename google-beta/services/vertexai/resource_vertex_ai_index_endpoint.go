@@ -50,7 +50,7 @@ func ResourceVertexAIIndexEndpoint() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_index_endpoint"),
 			tpgresource.DefaultProviderProject,
 		),
 
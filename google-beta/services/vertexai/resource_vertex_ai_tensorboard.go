@@ -50,7 +50,7 @@ func ResourceVertexAITensorboard() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_vertex_ai_tensorboard"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -229,7 +229,7 @@ func ResourceWorkbenchInstance() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_workbench_instance"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -58,7 +58,7 @@ func ResourceWorkflowsWorkflow() *schema.Resource {
 			},
 		},
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_workflows_workflow"),
 			tpgresource.DefaultProviderProject,
 		),
 
@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceWorkstationsWorkstation() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceWorkstationsWorkstationCreate,
 		Read:   resourceWorkstationsWorkstationRead,
 		Update: resourceWorkstationsWorkstationUpdate,
@@ -49,8 +49,10 @@ func ResourceWorkstationsWorkstation() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_workstations_workstation"),
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -143,6 +145,13 @@ To send traffic to a different port, clients may prefix the host with the destin
 				Computed:    true,
 				Description: `Current state of the workstation.`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -164,6 +173,10 @@ To send traffic to a different port, clients may prefix the host with the destin
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceWorkstationsWorkstationCreate(d *schema.ResourceData, meta interface{}) error {
@@ -333,6 +346,9 @@ func resourceWorkstationsWorkstationRead(d *schema.ResourceData, meta interface{
 	if err := d.Set("effective_annotations", flattenWorkstationsWorkstationEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading Workstation: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenWorkstationsWorkstationTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading Workstation: %s", err)
+	}
 
 	return nil
 }
@@ -602,6 +618,10 @@ func flattenWorkstationsWorkstationEffectiveAnnotations(v interface{}, d *schema
 	return v
 }
 
+func flattenWorkstationsWorkstationTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandWorkstationsWorkstationDisplayName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -33,7 +33,7 @@ import (
 )
 
 func ResourceWorkstationsWorkstationCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceWorkstationsWorkstationClusterCreate,
 		Read:   resourceWorkstationsWorkstationClusterRead,
 		Update: resourceWorkstationsWorkstationClusterUpdate,
@@ -49,8 +49,10 @@ func ResourceWorkstationsWorkstationCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_workstations_workstation_cluster"),
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -228,6 +230,13 @@ May be sent on update and delete requests to ensure that the client has an up-to
 				Computed:    true,
 				Description: `The name of the cluster resource.`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -249,6 +258,10 @@ May be sent on update and delete requests to ensure that the client has an up-to
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceWorkstationsWorkstationClusterCreate(d *schema.ResourceData, meta interface{}) error {
@@ -457,6 +470,9 @@ func resourceWorkstationsWorkstationClusterRead(d *schema.ResourceData, meta int
 	if err := d.Set("effective_annotations", flattenWorkstationsWorkstationClusterEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenWorkstationsWorkstationClusterTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading WorkstationCluster: %s", err)
+	}
 
 	return nil
 }
@@ -851,6 +867,10 @@ func flattenWorkstationsWorkstationClusterEffectiveAnnotations(v interface{}, d
 	return v
 }
 
+func flattenWorkstationsWorkstationClusterTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandWorkstationsWorkstationClusterNetwork(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -34,7 +34,7 @@ import (
 )
 
 func ResourceWorkstationsWorkstationConfig() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		Create: resourceWorkstationsWorkstationConfigCreate,
 		Read:   resourceWorkstationsWorkstationConfigRead,
 		Update: resourceWorkstationsWorkstationConfigUpdate,
@@ -50,8 +50,10 @@ func ResourceWorkstationsWorkstationConfig() *schema.Resource {
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		SchemaVersion: 1,
+
 		CustomizeDiff: customdiff.All(
-			tpgresource.SetLabelsDiff,
+			tpgresource.SetLabelsDiffFor("google_workstations_workstation_config"),
 			tpgresource.SetAnnotationsDiff,
 			tpgresource.DefaultProviderProject,
 		),
@@ -584,6 +586,13 @@ May be sent on update and delete requests to ensure that the client has an up-to
 				Computed:    true,
 				Description: `Full name of this resource.`,
 			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Description: `The combination of annotations configured directly on the resource
+ and default annotations configured on the provider.`,
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
 			"terraform_labels": {
 				Type:     schema.TypeMap,
 				Computed: true,
@@ -605,6 +614,10 @@ May be sent on update and delete requests to ensure that the client has an up-to
 		},
 		UseJSONNumber: true,
 	}
+
+	r.StateUpgraders = append(r.StateUpgraders, tpgresource.TerraformAnnotationsStateUpgrader(r, 0, ""))
+
+	return r
 }
 
 func resourceWorkstationsWorkstationConfigCreate(d *schema.ResourceData, meta interface{}) error {
@@ -870,6 +883,9 @@ func resourceWorkstationsWorkstationConfigRead(d *schema.ResourceData, meta inte
 	if err := d.Set("effective_annotations", flattenWorkstationsWorkstationConfigEffectiveAnnotations(res["annotations"], d, config)); err != nil {
 		return fmt.Errorf("Error reading WorkstationConfig: %s", err)
 	}
+	if err := d.Set("terraform_annotations", flattenWorkstationsWorkstationConfigTerraformAnnotations(res["annotations"], d, config)); err != nil {
+		return fmt.Errorf("Error reading WorkstationConfig: %s", err)
+	}
 
 	return nil
 }
@@ -1825,6 +1841,10 @@ func flattenWorkstationsWorkstationConfigEffectiveAnnotations(v interface{}, d *
 	return v
 }
 
+func flattenWorkstationsWorkstationConfigTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config) interface{} {
+	return tpgresource.FlattenTerraformAnnotations(v, d, config, "")
+}
+
 func expandWorkstationsWorkstationConfigDisplayName(v interface{}, d tpgresource.TerraformResourceData, config *transport_tpg.Config) (interface{}, error) {
 	return v, nil
 }
@@ -259,7 +259,7 @@ func iamMemberIsCaseSensitive(member string) bool {
 		strings.HasPrefix(member, "principalHierarchy:")
 }
 
-// normalizeIamMemberCasing returns the case adjusted value of an iamMember
+// NormalizeIamMemberCasing returns the case adjusted value of an iamMember
 // this is important as iam will ignore casing unless it is one of the following
 // member types: principalSet, principal, principalHierarchy
 // members are in <type>:<value> format
@@ -268,7 +268,7 @@ func iamMemberIsCaseSensitive(member string) bool {
 // so lowercase the value unless iamMemberIsCaseSensitive and leave the type alone
 // since Dec '19 members can be prefixed with "deleted:" to indicate the principal
 // has been deleted
-func normalizeIamMemberCasing(member string) string {
+func NormalizeIamMemberCasing(member string) string {
 	var pieces []string
 	if strings.HasPrefix(member, "deleted:") {
 		pieces = strings.SplitN(member, ":", 3)
@@ -306,7 +306,7 @@ func createIamBindingsMap(bindings []*cloudresourcemanager.Binding) map[iamBindi
 		}
 		// Get each member (user/principal) for the binding
 		for _, m := range b.Members {
-			m = normalizeIamMemberCasing(m)
+			m = NormalizeIamMemberCasing(m)
 			// Add the member
 			members[m] = struct{}{}
 		}
@@ -3,7 +3,10 @@
 package tpgiamresource
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"time"
 
 	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
@@ -43,19 +46,61 @@ func combineBatchIamPolicyModifiers(currV interface{}, toAddV interface{}) (inte
 	return append(currModifiers, newModifiers...), nil
 }
 
+// sendBatchModifyIamPolicy applies the batched modifiers in a single
+// read-modify-write of the IAM policy. A modifier returning an error only
+// fails its own request: the policy is written with the other modifiers
+// applied, and the error is reported in the request's result.
 func sendBatchModifyIamPolicy(updater ResourceIamUpdater) transport_tpg.BatcherSendFunc {
 	return func(resourceName string, body interface{}) (interface{}, error) {
 		modifiers, ok := body.([]iamPolicyModifyFunc)
 		if !ok {
 			return nil, fmt.Errorf("provider error: expected data to be type []iamPolicyModifyFunc, got %v with type %T", body, body)
 		}
-		return nil, iamPolicyReadModifyWrite(updater, func(policy *cloudresourcemanager.Policy) error {
-			for _, modifyF := range modifiers {
-				if err := modifyF(policy); err != nil {
-					return err
+
+		results := make(transport_tpg.BatchRequestResults, len(modifiers))
+		err := iamPolicyReadModifyWrite(updater, func(policy *cloudresourcemanager.Policy) error {
+			applied := 0
+			for i, modifyF := range modifiers {
+				results[i].Err = applyIamPolicyModifier(policy, modifyF)
+				if results[i].Err == nil {
+					applied++
 				}
 			}
+			if applied == 0 {
+				return errNoIamPolicyModifierApplied
+			}
 			return nil
 		})
+		if err == errNoIamPolicyModifierApplied {
+			log.Printf("[DEBUG] No modifier of the batch could be applied to the IAM policy of %s, leaving it unchanged", resourceName)
+			return results, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return results, nil
+	}
+}
+
+// errNoIamPolicyModifierApplied stops a batched read-modify-write without
+// writing the policy when all of its modifiers failed.
+var errNoIamPolicyModifierApplied = errors.New("no IAM policy modifier applied")
+
+// applyIamPolicyModifier applies modifyF to policy if it succeeds on a copy
+// of policy first, so a modifier failing halfway leaves policy unchanged. The
+// copy is only used to check for errors as it doesn't keep the
+// ForceSendFields of policy.
+func applyIamPolicyModifier(policy *cloudresourcemanager.Policy, modifyF iamPolicyModifyFunc) error {
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	dryRun := &cloudresourcemanager.Policy{}
+	if err := json.Unmarshal(b, dryRun); err != nil {
+		return err
+	}
+	if err := modifyF(dryRun); err != nil {
+		return err
 	}
+	return modifyF(policy)
 }
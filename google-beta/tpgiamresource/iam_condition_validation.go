@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgiamresource
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+)
+
+var (
+	iamConditionResourceType = cel.OpaqueType("google.iam.Resource")
+	iamConditionApiType      = cel.OpaqueType("google.iam.Api")
+	iamConditionRequestType  = cel.OpaqueType("google.iam.Request")
+	iamConditionOriginType   = cel.OpaqueType("google.iam.Origin")
+
+	undeclaredReferenceRegexp = regexp.MustCompile(`^undeclared reference to '([^']+)'`)
+)
+
+// iamConditionEnv returns the CEL environment IAM condition expressions are
+// checked in. It declares the attributes and functions IAM supports, see
+// https://cloud.google.com/iam/docs/conditions-attribute-reference
+var iamConditionEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		// Date/time attributes
+		cel.Variable("request.time", cel.TimestampType),
+
+		// Resource attributes. Qualified names like resource.name take
+		// precedence over field selection on resource, which only has functions.
+		cel.Variable("resource", iamConditionResourceType),
+		cel.Variable("resource.name", cel.StringType),
+		cel.Variable("resource.type", cel.StringType),
+		cel.Variable("resource.service", cel.StringType),
+		cel.Function("matchTag",
+			cel.MemberOverload("resource_match_tag_string_string", []*cel.Type{iamConditionResourceType, cel.StringType, cel.StringType}, cel.BoolType)),
+		cel.Function("matchTagId",
+			cel.MemberOverload("resource_match_tag_id_string_string", []*cel.Type{iamConditionResourceType, cel.StringType, cel.StringType}, cel.BoolType)),
+		cel.Function("hasTagKey",
+			cel.MemberOverload("resource_has_tag_key_string", []*cel.Type{iamConditionResourceType, cel.StringType}, cel.BoolType)),
+		cel.Function("hasTagKeyId",
+			cel.MemberOverload("resource_has_tag_key_id_string", []*cel.Type{iamConditionResourceType, cel.StringType}, cel.BoolType)),
+		cel.Function("extract",
+			cel.MemberOverload("string_extract_string", []*cel.Type{cel.StringType, cel.StringType}, cel.StringType)),
+
+		// API attributes
+		cel.Variable("api", iamConditionApiType),
+		cel.Function("getAttribute",
+			cel.MemberOverload("api_get_attribute_string_dyn", []*cel.Type{iamConditionApiType, cel.StringType, cel.DynType}, cel.DynType)),
+		cel.Function("hasOnly",
+			cel.MemberOverload("list_has_only_list", []*cel.Type{cel.ListType(cel.StringType), cel.ListType(cel.StringType)}, cel.BoolType)),
+
+		// Request attributes of Identity-Aware Proxy and Access Context Manager.
+		// Like resource, request and origin only have the attributes declared
+		// with qualified names. The claims of request.auth.claims depend on the
+		// identity provider, e.g. request.auth.claims.googleGroups.
+		cel.Variable("request", iamConditionRequestType),
+		cel.Function("matchAccessLevels",
+			cel.MemberOverload("request_match_access_levels_string_list", []*cel.Type{iamConditionRequestType, cel.StringType, cel.ListType(cel.StringType)}, cel.BoolType)),
+		cel.Variable("request.host", cel.StringType),
+		cel.Variable("request.path", cel.StringType),
+		cel.Variable("request.auth.access_levels", cel.ListType(cel.StringType)),
+		cel.Variable("request.auth.claims", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("levels", cel.ListType(cel.StringType)),
+		cel.Variable("origin", iamConditionOriginType),
+		cel.Variable("origin.ip", cel.StringType),
+		cel.Variable("origin.region_code", cel.StringType),
+		cel.Function("inIpRange",
+			cel.Overload("in_ip_range_string_string", []*cel.Type{cel.StringType, cel.StringType}, cel.BoolType)),
+		cel.Variable("destination.ip", cel.StringType),
+		cel.Variable("destination.port", cel.IntType),
+	)
+})
+
+// undeclaredAttributesOnly returns whether all the issues of an expression are
+// references to top-level attributes that aren't declared in iamConditionEnv.
+// Unknown functions and fields of declared attributes aren't included.
+func undeclaredAttributesOnly(parsed *cel.Ast, iss *cel.Issues) bool {
+	idents := make(map[string]bool)
+	celast.PostOrderVisit(parsed.NativeRep().Expr(), celast.NewExprVisitor(func(e celast.Expr) {
+		if e.Kind() == celast.IdentKind {
+			idents[e.AsIdent()] = true
+		}
+	}))
+
+	for _, e := range iss.Errors() {
+		m := undeclaredReferenceRegexp.FindStringSubmatch(e.Message)
+		if m == nil || !idents[m[1]] {
+			return false
+		}
+	}
+	return true
+}
+
+// isGetAttributeCall returns whether an expression is a call of api.getAttribute,
+// whose type is the type of the attribute it reads.
+func isGetAttributeCall(checked *cel.Ast) bool {
+	e := checked.NativeRep().Expr()
+	return e.Kind() == celast.CallKind && e.AsCall().FunctionName() == "getAttribute"
+}
+
+// ValidateIamConditionExpression checks that an IAM condition expression is
+// valid CEL and evaluates to a boolean. References to top-level attributes the
+// provider doesn't know are warnings rather than errors, as IAM may support more
+// than iamConditionEnv declares.
+func ValidateIamConditionExpression(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+
+	env, err := iamConditionEnv()
+	if err != nil {
+		return nil, []error{fmt.Errorf("error validating %s: %v", k, err)}
+	}
+
+	parsed, iss := env.Parse(v)
+	if iss.Err() != nil {
+		return nil, []error{fmt.Errorf("invalid value for %s (%q is not a valid IAM condition expression):\n%s", k, v, iss.Err())}
+	}
+	checked, iss := env.Check(parsed)
+	if iss.Err() != nil && undeclaredAttributesOnly(parsed, iss) {
+		return []string{fmt.Sprintf("%s references attributes the provider doesn't know, IAM may reject it (%q):\n%s", k, v, iss.Err())}, nil
+	}
+	if iss.Err() != nil {
+		return nil, []error{fmt.Errorf("invalid value for %s (%q is not a valid IAM condition expression):\n%s", k, v, iss.Err())}
+	}
+
+	// The type of an attribute read with api.getAttribute is only known to IAM
+	t := checked.OutputType()
+	if t.String() == cel.DynType.String() && isGetAttributeCall(checked) {
+		return nil, nil
+	}
+	if t.String() != cel.BoolType.String() {
+		return nil, []error{fmt.Errorf("invalid value for %s (IAM condition expressions must evaluate to bool, %q evaluates to %s)", k, v, t)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgiamresource
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateIamConditionExpression(t *testing.T) {
+	cases := map[string]struct {
+		Expression    string
+		ExpectWarning string
+		ExpectError   string
+	}{
+		"time": {
+			Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`,
+		},
+		"time in a time zone": {
+			Expression: `request.time.getHours("Europe/Berlin") >= 9 && request.time.getHours("Europe/Berlin") <= 17`,
+		},
+		"resource name": {
+			Expression: `resource.name.startsWith("projects/_/buckets/my-bucket/objects/reports/")`,
+		},
+		"resource type and service": {
+			Expression: `resource.type == "storage.googleapis.com/Bucket" || resource.service == "storage.googleapis.com"`,
+		},
+		"resource name extract": {
+			Expression: `resource.name.extract("/databases/{name}/") == "my-database"`,
+		},
+		"resource tags": {
+			Expression: `resource.matchTag("123456789012/env", "prod") && resource.hasTagKeyId("tagKeys/123")`,
+		},
+		"api attribute": {
+			Expression: `api.getAttribute("iam.googleapis.com/modifiedGrantsByRole", []).hasOnly(["roles/viewer"])`,
+		},
+		"access levels": {
+			Expression: `request.matchAccessLevels("123456789012", ["accessPolicies/123/accessLevels/corp"])`,
+		},
+		"IAP access levels": {
+			Expression: `"accessPolicies/123/accessLevels/corp" in request.auth.access_levels`,
+		},
+		"IAP email claim": {
+			Expression: `request.auth.claims.email == "a@b.com"`,
+		},
+		"IAP group claim": {
+			Expression: `"x" in request.auth.claims.googleGroups`,
+		},
+		"IAP host and path": {
+			Expression: `request.host == "hello.example.com" && request.path.startsWith("/admin")`,
+		},
+		"origin ip range": {
+			Expression: `inIpRange(origin.ip, "10.0.0.0/8")`,
+		},
+		"origin region": {
+			Expression: `origin.region_code == "US"`,
+		},
+		"levels": {
+			Expression: `"accessPolicies/123/accessLevels/corp" in levels`,
+		},
+		"constant": {
+			Expression: `true`,
+		},
+		"syntax error": {
+			Expression:  `request.time < `,
+			ExpectError: "Syntax error",
+		},
+		"unknown attribute": {
+			Expression:    `resourse.name == "my-bucket"`,
+			ExpectWarning: "resourse",
+		},
+		"type error": {
+			Expression:  `request.time < "2030-01-01T00:00:00Z"`,
+			ExpectError: "found no matching overload",
+		},
+		"not a boolean": {
+			Expression:  `resource.name`,
+			ExpectError: "must evaluate to bool",
+		},
+		"misspelled request attribute": {
+			Expression:  `request.tme < timestamp("2030-01-01T00:00:00Z")`,
+			ExpectError: "does not support field selection",
+		},
+		"unknown function": {
+			Expression:  `request.time.getHourz() > 3`,
+			ExpectError: "undeclared reference to 'getHourz'",
+		},
+		"claim that isn't compared": {
+			Expression:  `request.auth.claims.email`,
+			ExpectError: "must evaluate to bool",
+		},
+		"attribute read from the API": {
+			Expression: `api.getAttribute("iam.googleapis.com/someBooleanAttribute", false)`,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			warns, errs := ValidateIamConditionExpression(tc.Expression, "condition.0.expression")
+			if tc.ExpectWarning != "" {
+				if len(warns) != 1 || !strings.Contains(warns[0], tc.ExpectWarning) {
+					t.Errorf("expected a warning containing %q, got %v", tc.ExpectWarning, warns)
+				}
+			} else if len(warns) > 0 {
+				t.Errorf("unexpected warnings: %v", warns)
+			}
+			if tc.ExpectError == "" {
+				if len(errs) > 0 {
+					t.Errorf("unexpected errors: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0].Error(), tc.ExpectError) {
+				t.Errorf("expected an error containing %q, got %v", tc.ExpectError, errs)
+			}
+		})
+	}
+}
@@ -28,7 +28,7 @@ var iamBindingSchema = map[string]*schema.Schema{
 		Elem: &schema.Schema{
 			Type:             schema.TypeString,
 			DiffSuppressFunc: tpgresource.CaseDiffSuppress,
-			ValidateFunc:     validateIAMMember,
+			ValidateFunc:     ValidateIAMMember,
 		},
 		Set: func(v interface{}) int {
 			return schema.HashString(strings.ToLower(v.(string)))
@@ -42,9 +42,10 @@ var iamBindingSchema = map[string]*schema.Schema{
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"expression": {
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: ValidateIamConditionExpression,
 				},
 				"title": {
 					Type:     schema.TypeString,
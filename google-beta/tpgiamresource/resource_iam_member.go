@@ -25,7 +25,7 @@ func iamMemberCaseDiffSuppress(k, old, new string, d *schema.ResourceData) bool
 	return tpgresource.CaseDiffSuppress(k, old, new, d)
 }
 
-func validateIAMMember(i interface{}, k string) ([]string, []error) {
+func ValidateIAMMember(i interface{}, k string) ([]string, []error) {
 	v, ok := i.(string)
 	if !ok {
 		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
@@ -56,7 +56,7 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 		Required:         true,
 		ForceNew:         true,
 		DiffSuppressFunc: iamMemberCaseDiffSuppress,
-		ValidateFunc:     validateIAMMember,
+		ValidateFunc:     ValidateIAMMember,
 	},
 	"condition": {
 		Type:     schema.TypeList,
@@ -66,9 +66,10 @@ var IamMemberBaseSchema = map[string]*schema.Schema{
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"expression": {
-					Type:     schema.TypeString,
-					Required: true,
-					ForceNew: true,
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: ValidateIamConditionExpression,
 				},
 				"title": {
 					Type:     schema.TypeString,
@@ -115,7 +116,7 @@ func iamMemberImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser
 		if err := d.Set("role", role); err != nil {
 			return nil, fmt.Errorf("Error setting role: %s", err)
 		}
-		if err := d.Set("member", normalizeIamMemberCasing(member)); err != nil {
+		if err := d.Set("member", NormalizeIamMemberCasing(member)); err != nil {
 			return nil, fmt.Errorf("Error setting member: %s", err)
 		}
 
@@ -126,7 +127,7 @@ func iamMemberImport(newUpdaterFunc NewResourceIamUpdaterFunc, resourceIdParser
 
 		// Set the ID again so that the ID matches the ID it would have if it had been created via TF.
 		// Use the current ID in case it changed in the ResourceIdParserFunc.
-		d.SetId(d.Id() + "/" + role + "/" + normalizeIamMemberCasing(member))
+		d.SetId(d.Id() + "/" + role + "/" + NormalizeIamMemberCasing(member))
 
 		// Read the upstream policy so we can set the full condition.
 		updater, err := newUpdaterFunc(d, config)
@@ -227,7 +228,7 @@ func resourceIamMemberCreate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBat
 		if err != nil {
 			return err
 		}
-		d.SetId(updater.GetResourceId() + "/" + memberBind.Role + "/" + normalizeIamMemberCasing(memberBind.Members[0]))
+		d.SetId(updater.GetResourceId() + "/" + memberBind.Role + "/" + NormalizeIamMemberCasing(memberBind.Members[0]))
 		if k := conditionKeyFromCondition(memberBind.Condition); !k.Empty() {
 			d.SetId(d.Id() + "/" + k.String())
 		}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgiamresource
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-google-beta/google-beta/tpgresource"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+// ResourceIamMemberRemove returns a resource ensuring a member doesn't hold a
+// role on the parent resource. If a condition is set, only the binding with
+// that condition is affected; otherwise the member is removed from every
+// binding of the role, conditional or not.
+//
+// The resource owns nothing: deleting it leaves the policy unchanged. Reading
+// it removes it from state if the member holds the role again, so the next
+// plan shows the removal is needed.
+func ResourceIamMemberRemove(parentSpecificSchema map[string]*schema.Schema, newUpdaterFunc NewResourceIamUpdaterFunc, options ...func(*IamSettings)) *schema.Resource {
+	settings := NewIamSettings(options...)
+
+	return &schema.Resource{
+		Create: resourceIamMemberRemoveCreate(newUpdaterFunc, settings.EnableBatching),
+		Read:   resourceIamMemberRemoveRead(newUpdaterFunc),
+		Delete: resourceIamMemberRemoveDelete,
+
+		// if non-empty, this will be used to send a deprecation message when the
+		// resource is used.
+		DeprecationMessage: settings.DeprecationMessage,
+
+		Schema:        tpgresource.MergeSchemas(IamMemberBaseSchema, parentSpecificSchema),
+		UseJSONNumber: true,
+	}
+}
+
+// bindingsHoldingMember returns the bindings of role that member must be
+// removed from, each with member as its only member. If condition is nil,
+// every binding of role matches.
+func bindingsHoldingMember(bindings []*cloudresourcemanager.Binding, role, member string, condition *cloudresourcemanager.Expr) []*cloudresourcemanager.Binding {
+	member = NormalizeIamMemberCasing(member)
+	eCondition := conditionKeyFromCondition(condition)
+
+	var matches []*cloudresourcemanager.Binding
+	for _, b := range bindings {
+		if b.Role != role {
+			continue
+		}
+		if condition != nil && conditionKeyFromCondition(b.Condition) != eCondition {
+			continue
+		}
+		for _, m := range b.Members {
+			if NormalizeIamMemberCasing(m) == member {
+				matches = append(matches, &cloudresourcemanager.Binding{
+					Role:      b.Role,
+					Members:   []string{m},
+					Condition: b.Condition,
+				})
+				break
+			}
+		}
+	}
+	return matches
+}
+
+func resourceIamMemberRemoveCreate(newUpdaterFunc NewResourceIamUpdaterFunc, enableBatching bool) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		memberBind := getResourceIamMember(d)
+		modifyF := func(ep *cloudresourcemanager.Policy) error {
+			toRemove := bindingsHoldingMember(ep.Bindings, memberBind.Role, memberBind.Members[0], memberBind.Condition)
+			if len(toRemove) == 0 {
+				log.Printf("[DEBUG]: Member %q doesn't hold role %q on %s, no removal necessary", memberBind.Members[0], memberBind.Role, updater.DescribeResource())
+				return nil
+			}
+			ep.Bindings = subtractFromBindings(ep.Bindings, toRemove...)
+			ep.Version = IamPolicyVersion
+			return nil
+		}
+		if enableBatching {
+			err = BatchRequestModifyIamPolicy(updater, modifyF, config,
+				fmt.Sprintf("Remove IAM Members %s %+v for %s", memberBind.Role, memberBind.Members[0], updater.DescribeResource()))
+		} else {
+			err = iamPolicyReadModifyWrite(updater, modifyF)
+		}
+		if err != nil {
+			return err
+		}
+		d.SetId(updater.GetResourceId() + "/" + memberBind.Role + "/" + NormalizeIamMemberCasing(memberBind.Members[0]))
+		if k := conditionKeyFromCondition(memberBind.Condition); !k.Empty() {
+			d.SetId(d.Id() + "/" + k.String())
+		}
+		return resourceIamMemberRemoveRead(newUpdaterFunc)(d, meta)
+	}
+}
+
+func resourceIamMemberRemoveRead(newUpdaterFunc NewResourceIamUpdaterFunc) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		config := meta.(*transport_tpg.Config)
+
+		updater, err := newUpdaterFunc(d, config)
+		if err != nil {
+			return err
+		}
+
+		eMember := getResourceIamMember(d)
+		p, err := iamPolicyReadWithRetry(updater)
+		if err != nil {
+			return transport_tpg.HandleNotFoundError(err, d, fmt.Sprintf("Resource %q with IAM Member Remove: Role %q Member %q", updater.DescribeResource(), eMember.Role, eMember.Members[0]))
+		}
+		log.Print(spew.Sprintf("[DEBUG]: Retrieved policy for %s: %#v\n", updater.DescribeResource(), p))
+
+		if held := bindingsHoldingMember(p.Bindings, eMember.Role, eMember.Members[0], eMember.Condition); len(held) > 0 {
+			log.Printf("[WARN]: Member %q holds role %q on %s again in %d binding(s), removing from state so it is removed again.", eMember.Members[0], eMember.Role, updater.DescribeResource(), len(held))
+			d.SetId("")
+			return nil
+		}
+
+		if err := d.Set("etag", p.Etag); err != nil {
+			return fmt.Errorf("Error setting etag: %s", err)
+		}
+		return nil
+	}
+}
+
+func resourceIamMemberRemoveDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG]: Removing %s from state, the IAM policy is left unchanged", d.Id())
+	d.SetId("")
+	return nil
+}
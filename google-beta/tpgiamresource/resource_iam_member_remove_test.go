@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgiamresource
+
+import (
+	"testing"
+
+	"google.golang.org/api/cloudresourcemanager/v1"
+)
+
+func TestIamMemberRemove_BindingsHoldingMember(t *testing.T) {
+	condition := &cloudresourcemanager.Expr{
+		Title:      "expires",
+		Expression: `request.time < timestamp("2030-01-01T00:00:00Z")`,
+	}
+	input := []*cloudresourcemanager.Binding{
+		{
+			Role:    "role-1",
+			Members: []string{"user:Member-1@example.com", "member-2"},
+		},
+		{
+			Role:      "role-1",
+			Members:   []string{"user:member-1@example.com"},
+			Condition: condition,
+		},
+		{
+			Role:    "role-2",
+			Members: []string{"user:member-1@example.com"},
+		},
+	}
+
+	testCases := map[string]struct {
+		role      string
+		member    string
+		condition *cloudresourcemanager.Expr
+		expect    []*cloudresourcemanager.Binding
+	}{
+		"member is removed from every binding of the role": {
+			role:   "role-1",
+			member: "user:member-1@example.com",
+			expect: []*cloudresourcemanager.Binding{
+				{
+					Role:    "role-1",
+					Members: []string{"member-2"},
+				},
+				{
+					Role:    "role-2",
+					Members: []string{"user:member-1@example.com"},
+				},
+			},
+		},
+		"member is removed from the binding with the condition": {
+			role:      "role-1",
+			member:    "user:member-1@example.com",
+			condition: condition,
+			expect: []*cloudresourcemanager.Binding{
+				{
+					Role:    "role-1",
+					Members: []string{"user:member-1@example.com", "member-2"},
+				},
+				{
+					Role:    "role-2",
+					Members: []string{"user:member-1@example.com"},
+				},
+			},
+		},
+		"member without the role is a no-op": {
+			role:   "role-2",
+			member: "member-2",
+			expect: input,
+		},
+	}
+
+	for tn, tc := range testCases {
+		t.Run(tn, func(t *testing.T) {
+			remove := bindingsHoldingMember(input, tc.role, tc.member, tc.condition)
+			got := subtractFromBindings(input, remove...)
+			if !CompareBindings(got, tc.expect) {
+				t.Errorf("Unexpected bindings after removing %q from %q.\nActual: %s\nExpected: %s\n",
+					tc.member, tc.role, DebugPrintBindings(got), DebugPrintBindings(tc.expect))
+			}
+		})
+	}
+}
@@ -186,7 +186,7 @@ func validateIamPolicy(i interface{}, k string) (s []string, es []error) {
 	} else {
 		for i, binding := range policy.Bindings {
 			for j, member := range binding.Members {
-				_, memberErrors := validateIAMMember(member, fmt.Sprintf("bindings.%d.members.%d", i, j))
+				_, memberErrors := ValidateIAMMember(member, fmt.Sprintf("bindings.%d.members.%d", i, j))
 				es = append(es, memberErrors...)
 			}
 		}
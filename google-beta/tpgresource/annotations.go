@@ -5,30 +5,52 @@ package tpgresource
 import (
 	"context"
 	"fmt"
+	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 )
 
+// SetAnnotationsDiff is called in the CustomizeDiff of the resources to set
+// the fields "terraform_annotations" and "effective_annotations" in the plan.
+// "terraform_annotations" has the annotations of the resource merged with the
+// provider's default annotations, and the annotations of the resource take
+// precedence.
 func SetAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	raw := d.Get("annotations")
 	if raw == nil {
 		return nil
 	}
 
+	if d.Get("terraform_annotations") == nil {
+		return fmt.Errorf("`terraform_annotations` field is not present in the resource schema.")
+	}
+
 	if d.Get("effective_annotations") == nil {
 		return fmt.Errorf("`effective_annotations` field is not present in the resource schema.")
 	}
 
-	// If "annotations" field is computed, set "effective_annotations" to computed.
+	// If "annotations" field is computed, set "terraform_annotations" and "effective_annotations" to computed.
 	// https://github.com/hashicorp/terraform-provider-google/issues/16217
 	if !d.GetRawPlan().GetAttr("annotations").IsWhollyKnown() {
+		if err := d.SetNewComputed("terraform_annotations"); err != nil {
+			return fmt.Errorf("error setting terraform_annotations to computed: %w", err)
+		}
+
 		if err := d.SetNewComputed("effective_annotations"); err != nil {
 			return fmt.Errorf("error setting effective_annotations to computed: %w", err)
 		}
 		return nil
 	}
 
-	o, n := d.GetChange("annotations")
+	config := meta.(*transport_tpg.Config)
+
+	terraformAnnotations := mergeDefaultAnnotations(config, raw.(map[string]interface{}))
+	if err := d.SetNew("terraform_annotations", terraformAnnotations); err != nil {
+		return fmt.Errorf("error setting new terraform_annotations diff: %w", err)
+	}
+
+	o, n := d.GetChange("terraform_annotations")
 	effectiveAnnotations := d.Get("effective_annotations").(map[string]interface{})
 
 	for k, v := range n.(map[string]interface{}) {
@@ -56,9 +78,10 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 
 	// Fix the bug that the computed and nested "annotations" field disappears from the terraform plan.
 	// https://github.com/hashicorp/terraform-provider-google/issues/17756
-	// The bug is introduced by SetNew on "metadata" field with the object including "effective_annotations".
-	// "effective_annotations" cannot be set directly due to a bug that SetNew doesn't work on nested fields
-	// in terraform sdk.
+	// The bug is introduced by SetNew on "metadata" field with the object including terraform_annotations
+	// and effective_annotations.
+	// "terraform_annotations" and "effective_annotations" cannot be set directly due to a bug that SetNew
+	// doesn't work on nested fields in terraform sdk.
 	// https://github.com/hashicorp/terraform-plugin-sdk/issues/459
 	values := d.GetRawPlan().GetAttr("metadata").AsValueSlice()
 	if len(values) > 0 && !values[0].GetAttr("annotations").IsWhollyKnown() {
@@ -70,11 +93,24 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 		return nil
 	}
 
+	if d.Get("metadata.0.terraform_annotations") == nil {
+		return fmt.Errorf("`metadata.0.terraform_annotations` field is not present in the resource schema.")
+	}
+
 	if d.Get("metadata.0.effective_annotations") == nil {
 		return fmt.Errorf("`metadata.0.effective_annotations` field is not present in the resource schema.")
 	}
 
-	o, n := d.GetChange("metadata.0.annotations")
+	config := meta.(*transport_tpg.Config)
+
+	original := l[0].(map[string]interface{})
+
+	original["terraform_annotations"] = mergeDefaultAnnotations(config, raw.(map[string]interface{}))
+	if err := d.SetNew("metadata", []interface{}{original}); err != nil {
+		return fmt.Errorf("error setting new metadata diff: %w", err)
+	}
+
+	o, n := d.GetChange("metadata.0.terraform_annotations")
 	effectiveAnnotations := d.Get("metadata.0.effective_annotations").(map[string]interface{})
 
 	for k, v := range n.(map[string]interface{}) {
@@ -87,9 +123,7 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 		}
 	}
 
-	original := l[0].(map[string]interface{})
 	original["effective_annotations"] = effectiveAnnotations
-
 	if err := d.SetNew("metadata", []interface{}{original}); err != nil {
 		return fmt.Errorf("error setting new metadata diff: %w", err)
 	}
@@ -97,7 +131,65 @@ func SetMetadataAnnotationsDiff(_ context.Context, d *schema.ResourceDiff, meta
 	return nil
 }
 
-// Sets the "annotations" field with the value of the field "effective_annotations" for data sources.
+// Merge provider default annotations with the user defined annotations in the resource
+// to get terraform managed annotations
+func mergeDefaultAnnotations(config *transport_tpg.Config, annotations map[string]interface{}) map[string]string {
+	terraformAnnotations := make(map[string]string)
+	for k, v := range config.DefaultAnnotations {
+		terraformAnnotations[k] = v
+	}
+
+	for k, v := range annotations {
+		terraformAnnotations[k] = v.(string)
+	}
+	return terraformAnnotations
+}
+
+// FlattenTerraformAnnotations is called in the READ method of the resources to
+// get the field "terraform_annotations" from all of the annotations returned
+// by the API, keeping only those managed by Terraform. "prefix" is the path of
+// the object holding the annotations, such as "metadata.0.", or empty.
+//
+// Until "terraform_annotations" is set in the state, as when it is first read
+// after being added to the resource, the annotations in the configuration of
+// the resource and the provider's default annotations are the ones managed.
+func FlattenTerraformAnnotations(v interface{}, d *schema.ResourceData, config *transport_tpg.Config, prefix string) interface{} {
+	if v == nil {
+		return v
+	}
+
+	annotations := make(map[string]interface{})
+	switch t := v.(type) {
+	case map[string]interface{}:
+		annotations = t
+	case map[string]string:
+		for k, s := range t {
+			annotations[k] = s
+		}
+	}
+
+	transformed := make(map[string]interface{})
+	if l, ok := d.GetOk(prefix + "terraform_annotations"); ok {
+		for k := range l.(map[string]interface{}) {
+			transformed[k] = annotations[k]
+		}
+		return transformed
+	}
+
+	managed := make(map[string]interface{})
+	if l, ok := d.GetOk(prefix + "annotations"); ok {
+		managed = l.(map[string]interface{})
+	}
+	for k := range mergeDefaultAnnotations(config, managed) {
+		if v, ok := annotations[k]; ok {
+			transformed[k] = v
+		}
+	}
+
+	return transformed
+}
+
+// Sets the "annotations" field and "terraform_annotations" with the value of the field "effective_annotations" for data sources.
 // When reading data source, as the annotations field is unavailable in the configuration of the data source,
 // the "annotations" field will be empty. With this funciton, the labels "annotations" will have all of annotations in the resource.
 func SetDataSourceAnnotations(d *schema.ResourceData) error {
@@ -113,5 +205,68 @@ func SetDataSourceAnnotations(d *schema.ResourceData) error {
 		return fmt.Errorf("Error setting annotations in data source: %s", err)
 	}
 
+	if d.Get("terraform_annotations") == nil {
+		return fmt.Errorf("`terraform_annotations` field is not present in the resource schema.")
+	}
+	if err := d.Set("terraform_annotations", effectiveAnnotations); err != nil {
+		return fmt.Errorf("Error setting terraform_annotations in data source: %s", err)
+	}
+
 	return nil
 }
+
+// Upgrade the field "terraform_annotations" in the state to have the value of field "annotations"
+// when it is not set but "annotations" field is set in the state
+func TerraformAnnotationsStateUpgrade(rawState map[string]interface{}) (map[string]interface{}, error) {
+	log.Printf("[DEBUG] Attributes before migration: %#v", rawState)
+	log.Printf("[DEBUG] Attributes before migration terraform_annotations: %#v", rawState["terraform_annotations"])
+
+	if rawState["terraform_annotations"] == nil && rawState["annotations"] != nil {
+		rawState["terraform_annotations"] = rawState["annotations"]
+	}
+
+	log.Printf("[DEBUG] Attributes after migration: %#v", rawState)
+	log.Printf("[DEBUG] Attributes after migration terraform_annotations: %#v", rawState["terraform_annotations"])
+
+	return rawState, nil
+}
+
+// TerraformAnnotationsStateUpgrader returns the state upgrader of the resource
+// r from the schema version before "terraform_annotations" was added to it.
+// The schema of that version is the schema of r without the field. block is
+// the name of the single nested block holding the annotations, e.g. "metadata",
+// or "" when they are top-level fields.
+func TerraformAnnotationsStateUpgrader(r *schema.Resource, version int, block string) schema.StateUpgrader {
+	prior := &schema.Resource{Schema: withoutTerraformAnnotations(r.Schema)}
+	if block != "" {
+		nested := *r.Schema[block]
+		nested.Elem = &schema.Resource{Schema: withoutTerraformAnnotations(nested.Elem.(*schema.Resource).Schema)}
+		prior.Schema[block] = &nested
+	}
+
+	return schema.StateUpgrader{
+		Type: prior.CoreConfigSchema().ImpliedType(),
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			if block == "" {
+				return TerraformAnnotationsStateUpgrade(rawState)
+			}
+			if rawBlock, ok := rawState[block].([]interface{}); ok && len(rawBlock) > 0 && rawBlock[0] != nil {
+				if _, err := TerraformAnnotationsStateUpgrade(rawBlock[0].(map[string]interface{})); err != nil {
+					return nil, err
+				}
+			}
+			return rawState, nil
+		},
+		Version: version,
+	}
+}
+
+func withoutTerraformAnnotations(s map[string]*schema.Schema) map[string]*schema.Schema {
+	without := make(map[string]*schema.Schema, len(s))
+	for k, v := range s {
+		if k != "terraform_annotations" {
+			without[k] = v
+		}
+	}
+	return without
+}
@@ -0,0 +1,319 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func testAnnotationsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"annotations": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_annotations": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: SetAnnotationsDiff,
+	}
+}
+
+// testFlatmap returns the flatmap state attributes of the map field k.
+func testFlatmap(k string, m map[string]string) map[string]string {
+	attributes := map[string]string{}
+	if m == nil {
+		return attributes
+	}
+	attributes[k+".%"] = strconv.Itoa(len(m))
+	for mk, mv := range m {
+		attributes[k+"."+mk] = mv
+	}
+	return attributes
+}
+
+// testMapFromFlatmap returns the map field k of flatmap state attributes.
+func testMapFromFlatmap(k string, attributes map[string]string) map[string]string {
+	m := map[string]string{}
+	for ak, av := range attributes {
+		if strings.HasPrefix(ak, k+".") && ak != k+".%" {
+			m[strings.TrimPrefix(ak, k+".")] = av
+		}
+	}
+	return m
+}
+
+func TestSetAnnotationsDiff(t *testing.T) {
+	cases := map[string]struct {
+		DefaultAnnotations         map[string]string
+		Annotations                map[string]string
+		StateTerraformAnnotations  map[string]string
+		StateEffectiveAnnotations  map[string]string
+		ExpectTerraformAnnotations map[string]string
+		ExpectEffectiveAnnotations map[string]string
+	}{
+		"default annotations are merged into a new resource": {
+			DefaultAnnotations:         map[string]string{"owner": "platform", "runbook": "default"},
+			Annotations:                map[string]string{"runbook": "secrets"},
+			ExpectTerraformAnnotations: map[string]string{"owner": "platform", "runbook": "secrets"},
+			ExpectEffectiveAnnotations: map[string]string{"owner": "platform", "runbook": "secrets"},
+		},
+		"annotations set by other clients are kept": {
+			DefaultAnnotations:         map[string]string{"owner": "platform"},
+			Annotations:                map[string]string{},
+			StateTerraformAnnotations:  map[string]string{},
+			StateEffectiveAnnotations:  map[string]string{"system": "set"},
+			ExpectTerraformAnnotations: map[string]string{"owner": "platform"},
+			ExpectEffectiveAnnotations: map[string]string{"owner": "platform", "system": "set"},
+		},
+		"removed default annotations are removed": {
+			Annotations:                map[string]string{"runbook": "secrets"},
+			StateTerraformAnnotations:  map[string]string{"owner": "platform", "runbook": "secrets"},
+			StateEffectiveAnnotations:  map[string]string{"owner": "platform", "runbook": "secrets", "system": "set"},
+			ExpectTerraformAnnotations: map[string]string{"runbook": "secrets"},
+			ExpectEffectiveAnnotations: map[string]string{"runbook": "secrets", "system": "set"},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			r := testAnnotationsResource()
+
+			state := &terraform.InstanceState{Attributes: map[string]string{}}
+			if tc.StateEffectiveAnnotations != nil {
+				state.ID = "id"
+				for k, v := range testFlatmap("terraform_annotations", tc.StateTerraformAnnotations) {
+					state.Attributes[k] = v
+				}
+				for k, v := range testFlatmap("effective_annotations", tc.StateEffectiveAnnotations) {
+					state.Attributes[k] = v
+				}
+			}
+
+			annotations := map[string]interface{}{}
+			rawAnnotations := map[string]cty.Value{}
+			for k, v := range tc.Annotations {
+				annotations[k] = v
+				rawAnnotations[k] = cty.StringVal(v)
+			}
+			rawPlanAnnotations := cty.MapValEmpty(cty.String)
+			if len(rawAnnotations) > 0 {
+				rawPlanAnnotations = cty.MapVal(rawAnnotations)
+			}
+			state.RawPlan = cty.ObjectVal(map[string]cty.Value{
+				"annotations": rawPlanAnnotations,
+			})
+
+			config := &transport_tpg.Config{DefaultAnnotations: tc.DefaultAnnotations}
+			diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"annotations": annotations,
+			}), config)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			planned := state.MergeDiff(diff)
+			if got := testMapFromFlatmap("terraform_annotations", planned.Attributes); !reflect.DeepEqual(got, tc.ExpectTerraformAnnotations) {
+				t.Errorf("want terraform_annotations %v, got %v", tc.ExpectTerraformAnnotations, got)
+			}
+			if got := testMapFromFlatmap("effective_annotations", planned.Attributes); !reflect.DeepEqual(got, tc.ExpectEffectiveAnnotations) {
+				t.Errorf("want effective_annotations %v, got %v", tc.ExpectEffectiveAnnotations, got)
+			}
+		})
+	}
+}
+
+func TestFlattenTerraformAnnotations(t *testing.T) {
+	cases := map[string]struct {
+		DefaultAnnotations        map[string]string
+		Annotations               map[string]interface{}
+		StateTerraformAnnotations map[string]interface{}
+		ApiAnnotations            interface{}
+		Expect                    map[string]interface{}
+	}{
+		"annotations in terraform_annotations are kept": {
+			StateTerraformAnnotations: map[string]interface{}{"owner": "platform"},
+			ApiAnnotations:            map[string]interface{}{"owner": "platform", "system": "set"},
+			Expect:                    map[string]interface{}{"owner": "platform"},
+		},
+		"configured and default annotations are kept before terraform_annotations is set": {
+			DefaultAnnotations: map[string]string{"owner": "platform", "team": "infra"},
+			Annotations:        map[string]interface{}{"runbook": "secrets"},
+			ApiAnnotations:     map[string]interface{}{"owner": "platform", "runbook": "secrets", "system": "set"},
+			Expect:             map[string]interface{}{"owner": "platform", "runbook": "secrets"},
+		},
+		"DCL annotations are supported": {
+			StateTerraformAnnotations: map[string]interface{}{"owner": "platform"},
+			ApiAnnotations:            map[string]string{"owner": "platform", "system": "set"},
+			Expect:                    map[string]interface{}{"owner": "platform"},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, testAnnotationsResource().Schema, map[string]interface{}{})
+			if tc.Annotations != nil {
+				if err := d.Set("annotations", tc.Annotations); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+			if tc.StateTerraformAnnotations != nil {
+				if err := d.Set("terraform_annotations", tc.StateTerraformAnnotations); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			config := &transport_tpg.Config{DefaultAnnotations: tc.DefaultAnnotations}
+			got := FlattenTerraformAnnotations(tc.ApiAnnotations, d, config, "")
+			if !reflect.DeepEqual(got, tc.Expect) {
+				t.Errorf("want %v, got %v", tc.Expect, got)
+			}
+		})
+	}
+}
+
+func TestTerraformAnnotationsStateUpgrade(t *testing.T) {
+	rawState := map[string]interface{}{
+		"annotations": map[string]interface{}{"owner": "platform"},
+	}
+	upgraded, err := TerraformAnnotationsStateUpgrade(rawState)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(upgraded["terraform_annotations"], rawState["annotations"]) {
+		t.Errorf("want terraform_annotations to be set from annotations, got %v", upgraded["terraform_annotations"])
+	}
+
+	rawState = map[string]interface{}{
+		"annotations":           map[string]interface{}{"owner": "platform"},
+		"terraform_annotations": map[string]interface{}{"owner": "platform", "team": "infra"},
+	}
+	upgraded, err = TerraformAnnotationsStateUpgrade(rawState)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(upgraded["terraform_annotations"].(map[string]interface{})) != 2 {
+		t.Errorf("want terraform_annotations to be unchanged, got %v", upgraded["terraform_annotations"])
+	}
+}
+
+func TestTerraformAnnotationsStateUpgrader(t *testing.T) {
+	cases := map[string]struct {
+		Resource func() *schema.Resource
+		Block    string
+		State    map[string]interface{}
+		Want     map[string]interface{}
+	}{
+		"top-level annotations": {
+			Resource: testAnnotationsResource,
+			State: map[string]interface{}{
+				"annotations": map[string]interface{}{"owner": "platform"},
+			},
+			Want: map[string]interface{}{
+				"annotations":           map[string]interface{}{"owner": "platform"},
+				"terraform_annotations": map[string]interface{}{"owner": "platform"},
+			},
+		},
+		"annotations in a metadata block": {
+			Resource: func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"metadata": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     testAnnotationsResource(),
+						},
+					},
+				}
+			},
+			Block: "metadata",
+			State: map[string]interface{}{
+				"name": "service",
+				"metadata": []interface{}{
+					map[string]interface{}{"annotations": map[string]interface{}{"owner": "platform"}},
+				},
+			},
+			Want: map[string]interface{}{
+				"name": "service",
+				"metadata": []interface{}{
+					map[string]interface{}{
+						"annotations":           map[string]interface{}{"owner": "platform"},
+						"terraform_annotations": map[string]interface{}{"owner": "platform"},
+					},
+				},
+			},
+		},
+		"no metadata block": {
+			Resource: func() *schema.Resource {
+				return &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metadata": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem:     testAnnotationsResource(),
+						},
+					},
+				}
+			},
+			Block: "metadata",
+			State: map[string]interface{}{"metadata": []interface{}{}},
+			Want:  map[string]interface{}{"metadata": []interface{}{}},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			r := tc.Resource()
+			r.SchemaVersion = 1
+			r.StateUpgraders = []schema.StateUpgrader{TerraformAnnotationsStateUpgrader(r, 0, tc.Block)}
+			if err := r.InternalValidate(nil, true); err != nil {
+				t.Fatalf("invalid resource: %s", err)
+			}
+
+			priorType := r.StateUpgraders[0].Type
+			if tc.Block != "" {
+				priorType = priorType.AttributeType(tc.Block).ElementType()
+			}
+			if priorType.HasAttribute("terraform_annotations") {
+				t.Errorf("want the prior schema version not to have terraform_annotations, got %#v", priorType)
+			}
+			if r.CoreConfigSchema().ImpliedType().Equals(r.StateUpgraders[0].Type) {
+				t.Errorf("want the prior schema version to differ from the current one")
+			}
+
+			upgraded, err := r.StateUpgraders[0].Upgrade(context.Background(), tc.State, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(upgraded, tc.Want) {
+				t.Errorf("want %#v, got %#v", tc.Want, upgraded)
+			}
+		})
+	}
+}
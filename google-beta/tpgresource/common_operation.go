@@ -3,6 +3,7 @@
 package tpgresource
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -139,6 +140,16 @@ func CommonRefreshFunc(w Waiter) resource.StateRefreshFunc {
 }
 
 func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterval time.Duration) error {
+	return OperationWaitContext(context.Background(), w, activity, timeout, pollInterval)
+}
+
+// OperationWaitContext is OperationWait, returning once ctx is done rather
+// than waiting for the timeout, e.g. once Terraform is interrupted. A nil ctx
+// is context.Background().
+func OperationWaitContext(ctx context.Context, w Waiter, activity string, timeout time.Duration, pollInterval time.Duration) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	if OperationDone(w) {
 		return w.Error()
 	}
@@ -151,7 +162,7 @@ func OperationWait(w Waiter, activity string, timeout time.Duration, pollInterva
 		MinTimeout:   2 * time.Second,
 		PollInterval: pollInterval,
 	}
-	opRaw, err := c.WaitForState()
+	opRaw, err := c.WaitForStateContext(ctx)
 	if err != nil {
 		return fmt.Errorf("Error waiting for %s: %w", activity, err)
 	}
@@ -3,6 +3,7 @@
 package tpgresource
 
 import (
+	"context"
 	"net/url"
 	"testing"
 	"time"
@@ -69,3 +70,34 @@ func TestOperationWait_TimeoutsShouldRetry(t *testing.T) {
 			expectedRunCount, testWaiter.runCount)
 	}
 }
+
+// PendingTestWaiter is an operation that never completes.
+type PendingTestWaiter struct {
+	TestWaiter
+}
+
+func (PendingTestWaiter) State() string {
+	return "RUNNING"
+}
+
+func (PendingTestWaiter) QueryOp() (interface{}, error) {
+	return "my return value", nil
+}
+
+func (PendingTestWaiter) PendingStates() []string {
+	return []string{"RUNNING"}
+}
+
+func TestOperationWaitContext_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := OperationWaitContext(ctx, &PendingTestWaiter{}, "my-activity", 10*time.Minute, 0*time.Second)
+	if err == nil {
+		t.Errorf("expected an error waiting for a cancelled operation, got none")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the wait to stop promptly after cancellation, took %s", elapsed)
+	}
+}
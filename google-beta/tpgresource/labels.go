@@ -58,6 +58,18 @@ func SetDataSourceLabels(d *schema.ResourceData) error {
 }
 
 func SetLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setLabelsDiff(d, meta, "")
+}
+
+// SetLabelsDiffFor returns SetLabelsDiff for resources of type resourceType,
+// e.g. google_storage_bucket, to name them in label policy violations.
+func SetLabelsDiffFor(resourceType string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		return setLabelsDiff(d, meta, resourceType)
+	}
+}
+
+func setLabelsDiff(d *schema.ResourceDiff, meta interface{}, resourceType string) error {
 	raw := d.Get("labels")
 	if raw == nil {
 		return nil
@@ -109,6 +121,10 @@ func SetLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{})
 		terraformLabels[k] = v.(string)
 	}
 
+	if err := checkLabelPolicy(d, config, resourceType, terraformLabels, true); err != nil {
+		return err
+	}
+
 	if err := d.SetNew("terraform_labels", terraformLabels); err != nil {
 		return fmt.Errorf("error setting new terraform_labels diff: %w", err)
 	}
@@ -134,6 +150,19 @@ func SetLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{})
 }
 
 func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	return setMetadataLabelsDiff(d, meta, "")
+}
+
+// SetMetadataLabelsDiffFor returns SetMetadataLabelsDiff for resources of type
+// resourceType, e.g. google_cloud_run_service, to name them in label policy
+// violations.
+func SetMetadataLabelsDiffFor(resourceType string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		return setMetadataLabelsDiff(d, meta, resourceType)
+	}
+}
+
+func setMetadataLabelsDiff(d *schema.ResourceDiff, meta interface{}, resourceType string) error {
 	l := d.Get("metadata").([]interface{})
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -188,6 +217,11 @@ func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 		terraformLabels[k] = v.(string)
 	}
 
+	// Labels in "metadata" follow the syntax of Kubernetes labels rather than Google Cloud labels.
+	if err := checkLabelPolicy(d, config, resourceType, terraformLabels, false); err != nil {
+		return err
+	}
+
 	original := l[0].(map[string]interface{})
 
 	original["terraform_labels"] = terraformLabels
@@ -216,6 +250,35 @@ func SetMetadataLabelsDiff(_ context.Context, d *schema.ResourceDiff, meta inter
 	return nil
 }
 
+// checkLabelPolicy evaluates the provider's label policy against the labels
+// managed by Terraform, and fails the plan if they violate it.
+func checkLabelPolicy(d *schema.ResourceDiff, config *transport_tpg.Config, resourceType string, terraformLabels map[string]string, checkSyntax bool) error {
+	if config.LabelPolicy == nil {
+		return nil
+	}
+
+	violations := config.LabelPolicy.Violations(terraformLabels, checkSyntax)
+	if len(violations) == 0 {
+		return nil
+	}
+	return fmt.Errorf("The labels of %s violate the provider label_policy:\n  - %s", diffResourceName(d, resourceType), strings.Join(violations, "\n  - "))
+}
+
+// diffResourceName names the resource of a diff in errors as well as it can,
+// as Terraform doesn't send resource addresses to providers: by its type and
+// its configured name, e.g. google_storage_bucket "my-bucket".
+func diffResourceName(d *schema.ResourceDiff, resourceType string) string {
+	if resourceType == "" {
+		resourceType = "resource"
+	}
+	if v, ok := d.GetOk("name"); ok {
+		if name, ok := v.(string); ok && name != "" {
+			return fmt.Sprintf("%s %q", resourceType, name)
+		}
+	}
+	return resourceType
+}
+
 // Upgrade the field "labels" in the state to exclude the labels with the labels prefix
 // and the field "effective_labels" to have all of labels, including the labels with the labels prefix
 func LabelsStateUpgrade(rawState map[string]interface{}, labesPrefix string) (map[string]interface{}, error) {
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func testLabelsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"terraform_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_labels": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: SetLabelsDiff,
+	}
+}
+
+func TestSetLabelsDiff_LabelPolicy(t *testing.T) {
+	cases := map[string]struct {
+		Name          string
+		DefaultLabels map[string]string
+		Labels        map[string]string
+		ExpectError   string
+	}{
+		"labels following the policy": {
+			Labels: map[string]string{"env": "prod"},
+		},
+		"default labels count towards the policy": {
+			DefaultLabels: map[string]string{"env": "prod"},
+			Labels:        map[string]string{},
+		},
+		"violations fail the plan": {
+			Labels:      map[string]string{"owner": "me"},
+			ExpectError: `required label "env" is missing`,
+		},
+		"violations name the resource": {
+			Name:        "my-resource",
+			Labels:      map[string]string{"owner": "me"},
+			ExpectError: `The labels of google_test_resource "my-resource" violate`,
+		},
+		"violations name the type of resources without a name": {
+			Labels:      map[string]string{"owner": "me"},
+			ExpectError: `The labels of google_test_resource violate`,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			r := testLabelsResource()
+			r.CustomizeDiff = SetLabelsDiffFor("google_test_resource")
+			state := &terraform.InstanceState{Attributes: map[string]string{}}
+
+			labels := map[string]interface{}{}
+			rawLabels := map[string]cty.Value{}
+			for k, v := range tc.Labels {
+				labels[k] = v
+				rawLabels[k] = cty.StringVal(v)
+			}
+			rawPlanLabels := cty.MapValEmpty(cty.String)
+			if len(rawLabels) > 0 {
+				rawPlanLabels = cty.MapVal(rawLabels)
+			}
+			state.RawPlan = cty.ObjectVal(map[string]cty.Value{
+				"labels": rawPlanLabels,
+			})
+
+			policy := &transport_tpg.LabelPolicy{
+				RequiredKeys: []string{"env"},
+				DeniedKeys:   []string{"owner"},
+			}
+			if err := policy.Validate(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			config := &transport_tpg.Config{DefaultLabels: tc.DefaultLabels, LabelPolicy: policy}
+			raw := map[string]interface{}{"labels": labels}
+			if tc.Name != "" {
+				raw["name"] = tc.Name
+			}
+			diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(raw), config)
+			if tc.ExpectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.ExpectError) {
+					t.Fatalf("expected an error containing %q, got %v", tc.ExpectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expect := map[string]string{}
+			for k, v := range tc.DefaultLabels {
+				expect[k] = v
+			}
+			for k, v := range tc.Labels {
+				expect[k] = v
+			}
+			planned := state.MergeDiff(diff)
+			if got := testMapFromFlatmap("terraform_labels", planned.Attributes); !reflect.DeepEqual(got, expect) {
+				t.Errorf("want terraform_labels %v, got %v", expect, got)
+			}
+		})
+	}
+}
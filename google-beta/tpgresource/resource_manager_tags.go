@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+// SetResourceManagerTagsDiff returns a CustomizeDiffFunc that merges the
+// provider default Resource Manager tags with the tags configured in the field
+// "key" into "effective_resource_manager_tags". Tags configured on the resource
+// take precedence over the defaults for the same tag key.
+func SetResourceManagerTagsDiff(key string) schema.CustomizeDiffFunc {
+	return SetEffectiveResourceManagerTagsDiff(key, "effective_resource_manager_tags")
+}
+
+// SetEffectiveResourceManagerTagsDiff is SetResourceManagerTagsDiff for
+// resources with tags in several fields, which merges the tags of the field
+// "key" into the field "effectiveKey".
+func SetEffectiveResourceManagerTagsDiff(key, effectiveKey string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Get(effectiveKey) == nil {
+			return fmt.Errorf("`%s` field is not present in the resource schema.", effectiveKey)
+		}
+
+		// If the configured tags are computed, set the effective tags to computed.
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed(effectiveKey); err != nil {
+				return fmt.Errorf("error setting %s to computed: %w", effectiveKey, err)
+			}
+			return nil
+		}
+
+		config := meta.(*transport_tpg.Config)
+
+		effectiveTags := make(map[string]string)
+		for k, v := range config.DefaultResourceManagerTags {
+			effectiveTags[k] = v
+		}
+
+		if tags, ok := d.Get(key).(map[string]interface{}); ok {
+			for k, v := range tags {
+				effectiveTags[k] = v.(string)
+			}
+		}
+
+		if err := d.SetNew(effectiveKey, effectiveTags); err != nil {
+			return fmt.Errorf("error setting new %s diff: %w", effectiveKey, err)
+		}
+
+		return nil
+	}
+}
+
+// WithoutDefaultResourceManagerTags returns the tags read from a resource
+// without the provider default tags that aren't configured on it, for resources
+// whose configured tags are read back with the defaults merged in. The defaults
+// only show up in the effective tags of the resource.
+func WithoutDefaultResourceManagerTags(config *transport_tpg.Config, tags, configured map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	for k, v := range tags {
+		if _, ok := configured[k]; !ok && config.DefaultResourceManagerTags[k] == v {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// ReadEffectiveResourceManagerTags returns the Resource Manager tags bound
+// directly to a resource, rather than inherited from its ancestors, as a map of
+// tag key ids to tag value ids. The resource is identified by its full
+// resource name, e.g.
+// //compute.googleapis.com/projects/my-project/zones/us-central1-a/instances/123,
+// and the tags are read from the tags endpoint of its location.
+func ReadEffectiveResourceManagerTags(config *transport_tpg.Config, userAgent, location, fullResourceName string) (map[string]string, error) {
+	basePath := strings.Replace(config.TagsLocationBasePath, "{{location}}", location, 1)
+	url, err := transport_tpg.AddQueryParams(basePath+"effectiveTags", map[string]string{"parent": fullResourceName})
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+	pageUrl := url
+	for {
+		res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+			Config:    config,
+			Method:    "GET",
+			RawURL:    pageUrl,
+			UserAgent: userAgent,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error reading the tags of %s: %w", fullResourceName, err)
+		}
+
+		effectiveTags, _ := res["effectiveTags"].([]interface{})
+		for _, raw := range effectiveTags {
+			tag, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if inherited, _ := tag["inherited"].(bool); inherited {
+				continue
+			}
+			key, _ := tag["tagKey"].(string)
+			value, _ := tag["tagValue"].(string)
+			if key != "" && value != "" {
+				tags[key] = value
+			}
+		}
+
+		pageToken, _ := res["nextPageToken"].(string)
+		if pageToken == "" {
+			return tags, nil
+		}
+		pageUrl, err = transport_tpg.AddQueryParams(url, map[string]string{"pageToken": pageToken})
+		if err != nil {
+			return nil, err
+		}
+	}
+}
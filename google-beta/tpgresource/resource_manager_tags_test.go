@@ -0,0 +1,137 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package tpgresource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+func testResourceManagerTagsResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"resource_manager_tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"effective_resource_manager_tags": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+		CustomizeDiff: SetResourceManagerTagsDiff("resource_manager_tags"),
+	}
+}
+
+func TestSetResourceManagerTagsDiff(t *testing.T) {
+	cases := map[string]struct {
+		DefaultTags map[string]string
+		Tags        map[string]string
+		Expect      map[string]string
+	}{
+		"no default tags": {
+			Tags:   map[string]string{"tagKeys/1": "tagValues/1"},
+			Expect: map[string]string{"tagKeys/1": "tagValues/1"},
+		},
+		"default tags are merged": {
+			DefaultTags: map[string]string{"tagKeys/1": "tagValues/1", "tagKeys/2": "tagValues/2"},
+			Tags:        map[string]string{"tagKeys/2": "tagValues/3"},
+			Expect:      map[string]string{"tagKeys/1": "tagValues/1", "tagKeys/2": "tagValues/3"},
+		},
+		"default tags apply without configured tags": {
+			DefaultTags: map[string]string{"tagKeys/1": "tagValues/1"},
+			Expect:      map[string]string{"tagKeys/1": "tagValues/1"},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			r := testResourceManagerTagsResource()
+			state := &terraform.InstanceState{Attributes: map[string]string{}}
+
+			tags := map[string]interface{}{}
+			for k, v := range tc.Tags {
+				tags[k] = v
+			}
+			state.RawPlan = cty.ObjectVal(map[string]cty.Value{
+				"resource_manager_tags": cty.MapValEmpty(cty.String),
+			})
+
+			config := &transport_tpg.Config{DefaultResourceManagerTags: tc.DefaultTags}
+			diff, err := r.SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+				"resource_manager_tags": tags,
+			}), config)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			planned := state.MergeDiff(diff)
+			if got := testMapFromFlatmap("effective_resource_manager_tags", planned.Attributes); !reflect.DeepEqual(got, tc.Expect) {
+				t.Errorf("want effective_resource_manager_tags %v, got %v", tc.Expect, got)
+			}
+		})
+	}
+}
+
+func TestReadEffectiveResourceManagerTags(t *testing.T) {
+	var parents []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/us-central1-a/v3/effectiveTags" {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		parents = append(parents, r.URL.Query().Get("parent"))
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("pageToken") == "" {
+			w.Write([]byte(`{"effectiveTags": [
+				{"tagKey": "tagKeys/1", "tagValue": "tagValues/1"},
+				{"tagKey": "tagKeys/2", "tagValue": "tagValues/2", "inherited": true}
+			], "nextPageToken": "next"}`))
+			return
+		}
+		w.Write([]byte(`{"effectiveTags": [{"tagKey": "tagKeys/3", "tagValue": "tagValues/3"}]}`))
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{
+		Client:               ts.Client(),
+		TagsLocationBasePath: ts.URL + "/{{location}}/v3/",
+	}
+	parent := "//compute.googleapis.com/projects/project/zones/us-central1-a/instances/123"
+	tags, err := ReadEffectiveResourceManagerTags(config, "test", "us-central1-a", parent)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expect := map[string]string{"tagKeys/1": "tagValues/1", "tagKeys/3": "tagValues/3"}
+	if !reflect.DeepEqual(tags, expect) {
+		t.Errorf("expected the tags bound to the resource %v, got %v", expect, tags)
+	}
+	if !reflect.DeepEqual(parents, []string{parent, parent}) {
+		t.Errorf("expected both pages to be read for %s, got %v", parent, parents)
+	}
+}
+
+func TestWithoutDefaultResourceManagerTags(t *testing.T) {
+	config := &transport_tpg.Config{DefaultResourceManagerTags: map[string]string{"tagKeys/1": "tagValues/1", "tagKeys/2": "tagValues/2"}}
+	tags := map[string]interface{}{"tagKeys/1": "tagValues/1", "tagKeys/2": "tagValues/3", "tagKeys/3": "tagValues/3", "tagKeys/4": "tagValues/4"}
+	configured := map[string]interface{}{"tagKeys/3": "tagValues/3", "tagKeys/4": "tagValues/5"}
+
+	// The default tagKeys/1 is dropped, the overridden default tagKeys/2 and
+	// the configured tags are kept
+	expected := map[string]interface{}{"tagKeys/2": "tagValues/3", "tagKeys/3": "tagValues/3", "tagKeys/4": "tagValues/4"}
+	if got := WithoutDefaultResourceManagerTags(config, tags, configured); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -4,6 +4,7 @@ package tpgresource
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
 	"regexp"
 	"strings"
@@ -118,7 +119,7 @@ func (d *ResourceDiffMock) ForceNew(key string) error {
 // This function isn't a test of transport.go; instead, it is used as an alternative
 // to ReplaceVars inside tests.
 func ReplaceVarsForTest(config *transport_tpg.Config, rs *terraform.ResourceState, linkTmpl string) (string, error) {
-	re := regexp.MustCompile("{{([[:word:]]+)}}")
+	re := regexp.MustCompile("{{([%[:word:]]+)}}")
 	var project, region, zone string
 
 	if strings.Contains(linkTmpl, "{{project}}") {
@@ -133,8 +134,13 @@ func ReplaceVarsForTest(config *transport_tpg.Config, rs *terraform.ResourceStat
 		zone = GetResourceNameFromSelfLink(rs.Primary.Attributes["zone"])
 	}
 
-	replaceFunc := func(s string) string {
+	var replaceFunc func(s string) string
+	replaceFunc = func(s string) string {
 		m := re.FindStringSubmatch(s)[1]
+		// Prepending '%' to the field name URL-encodes the value, as in ReplaceVars
+		if strings.HasPrefix(m, "%") {
+			return url.PathEscape(replaceFunc("{{" + strings.TrimPrefix(m, "%") + "}}"))
+		}
 		if m == "project" {
 			return project
 		}
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"golang.org/x/exp/maps"
 	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -465,40 +466,30 @@ func ServiceAccountFQN(serviceAccount string, d TerraformResourceData, config *t
 	return fmt.Sprintf("projects/-/serviceAccounts/%s@%s.iam.gserviceaccount.com", serviceAccount, project), nil
 }
 
+// PaginatedListRequest returns the flattened items of every page of a list.
+//
+// Deprecated: use transport_tpg.ListAll or transport_tpg.NewListIterator,
+// which flatten individual items and support list keys, filters and
+// aggregated lists.
 func PaginatedListRequest(project, baseUrl, userAgent string, config *transport_tpg.Config, flattener func(map[string]interface{}) []interface{}) ([]interface{}, error) {
-	res, err := transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
+	it := transport_tpg.NewListIterator(transport_tpg.ListRequestOptions{
 		Config:    config,
-		Method:    "GET",
 		Project:   project,
-		RawURL:    baseUrl,
 		UserAgent: userAgent,
+		RawURL:    baseUrl,
 	})
-	if err != nil {
-		return nil, err
-	}
 
-	ls := flattener(res)
-	pageToken, ok := res["pageToken"]
-	for ok {
-		if pageToken.(string) == "" {
-			break
+	ls := make([]interface{}, 0)
+	for {
+		res, err := it.NextPage()
+		if err == iterator.Done {
+			return ls, nil
 		}
-		url := fmt.Sprintf("%s?pageToken=%s", baseUrl, pageToken.(string))
-		res, err = transport_tpg.SendRequest(transport_tpg.SendRequestOptions{
-			Config:    config,
-			Method:    "GET",
-			Project:   project,
-			RawURL:    url,
-			UserAgent: userAgent,
-		})
 		if err != nil {
 			return nil, err
 		}
-		ls = append(ls, flattener(res))
-		pageToken, ok = res["pageToken"]
+		ls = append(ls, flattener(res)...)
 	}
-
-	return ls, nil
 }
 
 func GetInterconnectAttachmentLink(config *transport_tpg.Config, project, region, ic, userAgent string) (string, error) {
@@ -3,7 +3,10 @@
 package tpgresource_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"strings"
 	"testing"
@@ -1255,3 +1258,36 @@ func TestReplaceVars(t *testing.T) {
 		})
 	}
 }
+
+func TestPaginatedListRequest(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("view") != "FULL" {
+			t.Errorf("expected existing query parameters to be kept, got %q", r.URL.RawQuery)
+		}
+		page := map[string]interface{}{
+			"versions":      []interface{}{"a", "b"},
+			"nextPageToken": "page2",
+		}
+		if r.URL.Query().Get("pageToken") == "page2" {
+			page = map[string]interface{}{
+				"versions": []interface{}{"c"},
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(page)
+	}))
+	defer ts.Close()
+
+	config := &transport_tpg.Config{Client: ts.Client()}
+	flattener := func(resp map[string]interface{}) []interface{} {
+		return resp["versions"].([]interface{})
+	}
+
+	ls, err := tpgresource.PaginatedListRequest("", ts.URL+"/v1/versions?view=FULL", "test", config, flattener)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := []interface{}{"a", "b", "c"}; !reflect.DeepEqual(ls, expected) {
+		t.Errorf("expected the items of every page %v, got %v", expected, ls)
+	}
+}
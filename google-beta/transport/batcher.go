@@ -49,12 +49,19 @@ type (
 		// SendF function determines how to actually send a batched request to a
 		// third party service. The arguments given to this function are
 		// (ResourceName, Body) where Body may have been combined with other request
-		// Bodies.
+		// Bodies. It may return BatchRequestResults to give each combined request
+		// its own result.
 		SendF BatcherSendFunc
 
 		// ID for debugging request. This should be specific to a single request
 		// (i.e. per Terraform resource)
 		DebugId string
+
+		// MaxBatchSize is the maximum number of requests combined into a single
+		// batch, e.g. to stay within a per-request limit of the API. Once a
+		// started batch is full it is sent right away and following requests
+		// start a new batch. Zero means no limit.
+		MaxBatchSize int
 	}
 
 	// BatcherCombineFunc is a function type for combine existing batches and additional batch data
@@ -62,6 +69,20 @@ type (
 
 	// BatcherSendFunc is a function type for sending a batch request
 	BatcherSendFunc func(resourceName string, body interface{}) (interface{}, error)
+
+	// BatchRequestResult is the result of a single request combined into a
+	// batch.
+	BatchRequestResult struct {
+		Body interface{}
+		Err  error
+	}
+
+	// BatchRequestResults can be returned by a BatcherSendFunc in place of a
+	// single response to report partial failures. It holds the result of each
+	// combined request, in the order the requests were combined, so a request
+	// failing doesn't fail the other requests of the batch. An error returned
+	// along with it fails the whole batch.
+	BatchRequestResults []BatchRequestResult
 )
 
 // batchResponse bundles an API response (data, error) tuple.
@@ -74,6 +95,21 @@ func (br *batchResponse) IsError() bool {
 	return br.err != nil
 }
 
+// forRequest returns the response for the request at index idx of the n
+// requests combined into the batch that returned br.
+func (br batchResponse) forRequest(idx, n int) batchResponse {
+	results, ok := br.body.(BatchRequestResults)
+	if br.IsError() || !ok {
+		return br
+	}
+	if len(results) != n {
+		return batchResponse{
+			err: fmt.Errorf("provider error: batch combining %d requests returned %d results", n, len(results)),
+		}
+	}
+	return batchResponse{body: results[idx].Body, err: results[idx].Err}
+}
+
 // startedBatch refers to a registered batch to group batch requests coming in.
 // The timer manages the time after which a given batch is sent.
 type startedBatch struct {
@@ -165,7 +201,8 @@ func (b *RequestBatcher) SendRequestWithTimeout(batchKey string, request *BatchR
 	}
 	if !b.EnableBatching {
 		log.Printf("[DEBUG] Batching is disabled, sending single request for %q", request.DebugId)
-		return request.SendF(request.ResourceName, request.Body)
+		resp := request.send().forRequest(0, 1)
+		return resp.body, resp.err
 	}
 
 	respCh, err := b.registerBatchRequest(batchKey, request)
@@ -217,7 +254,17 @@ func (b *RequestBatcher) registerBatchRequest(batchKey string, newRequest *Batch
 
 	// If batch already exists, combine this request into existing request.
 	if batch, ok := b.batches[batchKey]; ok {
-		return batch.addRequest(newRequest)
+		if !batch.isFull() {
+			return batch.addRequest(newRequest)
+		}
+		// Send the full batch now. If its timer already fired, the batch is
+		// about to be sent and popped so the request is still combined into it.
+		if !batch.timer.Stop() {
+			return batch.addRequest(newRequest)
+		}
+		log.Printf("[DEBUG] Batch %q reached its maximum size of %d requests, sending it", batchKey, batch.MaxBatchSize)
+		delete(b.batches, batchKey)
+		go b.sendBatchWithSingleRetry(batchKey, batch)
 	}
 
 	// Batch doesn't exist for given batch key - create a new batch.
@@ -239,6 +286,7 @@ func (b *RequestBatcher) registerBatchRequest(batchKey string, newRequest *Batch
 			CombineF:     newRequest.CombineF,
 			SendF:        newRequest.SendF,
 			DebugId:      fmt.Sprintf("Combined batch for started batch %q", batchKey),
+			MaxBatchSize: newRequest.MaxBatchSize,
 		},
 		batchKey:    batchKey,
 		subscribers: []batchSubscriber{sub},
@@ -249,7 +297,7 @@ func (b *RequestBatcher) registerBatchRequest(batchKey string, newRequest *Batch
 		batch := b.popBatch(batchKey)
 		if batch == nil {
 			log.Printf("[ERROR] batch should have been added to saved batches - just run as single request %q", newRequest.DebugId)
-			respCh <- newRequest.send()
+			respCh <- newRequest.send().forRequest(0, 1)
 			close(respCh)
 		} else {
 			b.sendBatchWithSingleRetry(batchKey, batch)
@@ -263,13 +311,30 @@ func (b *RequestBatcher) sendBatchWithSingleRetry(batchKey string, batch *starte
 	log.Printf("[DEBUG] Sending batch %q combining %d requests)", batchKey, len(batch.subscribers))
 	resp := batch.send()
 
-	// If the batch failed and combines more than one request, retry each single request.
+	// If the batch failed and combines more than one request, resend it in
+	// smaller batches to find the requests causing the failure.
 	if resp.IsError() && len(batch.subscribers) > 1 {
 		log.Printf("[DEBUG] Batch failed with error: %v", resp.err)
-		log.Printf("[DEBUG] Sending each request in batch separately")
-		for _, sub := range batch.subscribers {
+		b.sendBisected(batchKey, batch.subscribers)
+		return
+	}
+
+	// Send result to all subscribers
+	respondToSubscribers(batch.subscribers, resp)
+}
+
+// sendBisected resends the requests of a failed batch as two halves, each
+// combined again from the original single requests. Halves that fail are
+// bisected again until the failing requests are isolated, so one bad request
+// doesn't fail the others and a batch of n requests with a single bad request
+// takes about 2*log2(n) requests to resolve rather than n.
+func (b *RequestBatcher) sendBisected(batchKey string, subscribers []batchSubscriber) {
+	mid := len(subscribers) / 2
+	for _, half := range [][]batchSubscriber{subscribers[:mid], subscribers[mid:]} {
+		if len(half) == 1 {
+			sub := half[0]
 			log.Printf("[DEBUG] Retrying single request %q", sub.singleRequest.DebugId)
-			singleResp := sub.singleRequest.send()
+			singleResp := sub.singleRequest.send().forRequest(0, 1)
 			log.Printf("[DEBUG] Retried single request %q returned response: %v", sub.singleRequest.DebugId, singleResp)
 
 			if singleResp.IsError() {
@@ -279,13 +344,56 @@ func (b *RequestBatcher) sendBatchWithSingleRetry(batchKey string, batch *starte
 			}
 			sub.respCh <- singleResp
 			close(sub.respCh)
+			continue
 		}
-	} else {
-		// Send result to all subscribers
-		for _, sub := range batch.subscribers {
-			sub.respCh <- resp
-			close(sub.respCh)
+
+		req, err := combineSubscriberRequests(half)
+		if err != nil {
+			// The requests were combined before, so this is unexpected. Fall
+			// back to bisecting until single requests are sent.
+			log.Printf("[WARN] Unable to recombine %d requests of batch %q: %s", len(half), batchKey, err)
+			b.sendBisected(batchKey, half)
+			continue
 		}
+
+		log.Printf("[DEBUG] Resending %d requests of batch %q", len(half), batchKey)
+		resp := req.send()
+		if resp.IsError() {
+			log.Printf("[DEBUG] Resent batch of %d requests failed with error: %v", len(half), resp.err)
+			b.sendBisected(batchKey, half)
+			continue
+		}
+		respondToSubscribers(half, resp)
+	}
+}
+
+// combineSubscriberRequests combines the original requests of subscribers
+// into a new batch request.
+func combineSubscriberRequests(subscribers []batchSubscriber) (*BatchRequest, error) {
+	first := subscribers[0].singleRequest
+	req := &BatchRequest{
+		ResourceName: first.ResourceName,
+		Body:         first.Body,
+		CombineF:     first.CombineF,
+		SendF:        first.SendF,
+		DebugId:      fmt.Sprintf("Resent batch of %d requests starting with %q", len(subscribers), first.DebugId),
+	}
+	for _, sub := range subscribers[1:] {
+		body, err := req.CombineF(req.Body, sub.singleRequest.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = body
+	}
+	return req, nil
+}
+
+// respondToSubscribers sends the response of a batch to each of the
+// subscribers it combines.
+func respondToSubscribers(subscribers []batchSubscriber, resp batchResponse) {
+	for i, sub := range subscribers {
+		sub.respCh <- resp.forRequest(i, len(subscribers))
+		close(sub.respCh)
 	}
 }
 
@@ -305,6 +413,12 @@ func (b *RequestBatcher) popBatch(batchKey string) *startedBatch {
 	return batch
 }
 
+// isFull returns whether the batch already combines as many requests as
+// allowed by its MaxBatchSize.
+func (batch *startedBatch) isFull() bool {
+	return batch.MaxBatchSize > 0 && len(batch.subscribers) >= batch.MaxBatchSize
+}
+
 func (batch *startedBatch) addRequest(newRequest *BatchRequest) (<-chan batchResponse, error) {
 	log.Printf("[DEBUG] Adding batch request %q to existing batch %q", newRequest.DebugId, batch.batchKey)
 	if batch.CombineF == nil {
@@ -336,3 +450,44 @@ func (req *BatchRequest) send() batchResponse {
 	v, err := req.SendF(req.ResourceName, req.Body)
 	return batchResponse{v, err}
 }
+
+// NewBatcherCombineFunc returns a BatcherCombineFunc combining request bodies
+// of type T with combine, so implementations don't need to assert the type of
+// the bodies themselves.
+func NewBatcherCombineFunc[T any](combine func(body T, toAdd T) (T, error)) BatcherCombineFunc {
+	return func(bodyV interface{}, toAddV interface{}) (interface{}, error) {
+		body, ok := bodyV.(T)
+		if !ok {
+			return nil, fmt.Errorf("provider error in batch combiner: expected data to be type %T, got %v with type %T", *new(T), bodyV, bodyV)
+		}
+		toAdd, ok := toAddV.(T)
+		if !ok {
+			return nil, fmt.Errorf("provider error in batch combiner: expected data to be type %T, got %v with type %T", *new(T), toAddV, toAddV)
+		}
+		return combine(body, toAdd)
+	}
+}
+
+// NewBatcherSendFunc returns a BatcherSendFunc sending request bodies of type
+// T with send.
+func NewBatcherSendFunc[T any](send func(resourceName string, body T) (interface{}, error)) BatcherSendFunc {
+	return func(resourceName string, bodyV interface{}) (interface{}, error) {
+		body, ok := bodyV.(T)
+		if !ok {
+			return nil, fmt.Errorf("provider error: expected batch data to be type %T, got %v with type %T", *new(T), bodyV, bodyV)
+		}
+		return send(resourceName, body)
+	}
+}
+
+// CombineSlicesBatcherFunc returns a BatcherCombineFunc for request bodies
+// that are slices of E, appending the elements of each request to the batch.
+func CombineSlicesBatcherFunc[E any]() BatcherCombineFunc {
+	return NewBatcherCombineFunc(func(body []E, toAdd []E) ([]E, error) {
+		// Copy the batch body so appending never modifies the body of the
+		// single request it was created from, which may be resent alone.
+		combined := make([]E, 0, len(body)+len(toAdd))
+		combined = append(combined, body...)
+		return append(combined, toAdd...), nil
+	})
+}
@@ -9,6 +9,7 @@ import (
 	"log"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -294,3 +295,325 @@ func testBasicCountBatches(t *testing.T, testName string, numBatches int) {
 		}(i)
 	}
 }
+
+func TestRequestBatcher_typedCombine(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(1) * time.Second,
+			EnableBatching: true,
+		})
+
+	var mu sync.Mutex
+	var sent [][]string
+	testSendBatch := NewBatcherSendFunc(func(_ string, body []string) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, body)
+		return len(body), nil
+	})
+
+	numRequests := 5
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			req := &BatchRequest{
+				DebugId:      fmt.Sprintf("typedCombine %d", idx),
+				ResourceName: "test-resource",
+				Body:         []string{fmt.Sprintf("item-%d", idx)},
+				CombineF:     CombineSlicesBatcherFunc[string](),
+				SendF:        testSendBatch,
+			}
+
+			respV, err := testBatcher.SendRequestWithTimeout("typedCombine", req, time.Duration(6)*time.Second)
+			if err != nil {
+				t.Errorf("got unexpected error %s", err)
+			}
+			if respV != numRequests {
+				t.Errorf("expected the response of a batch of %d requests, got %v", numRequests, respV)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if len(sent) != 1 || len(sent[0]) != numRequests {
+		t.Errorf("expected 1 batch combining %d items, got %v", numRequests, sent)
+	}
+}
+
+func TestRequestBatcher_typedWrongBodyType(t *testing.T) {
+	combineF := CombineSlicesBatcherFunc[string]()
+	if _, err := combineF([]string{"a"}, 1); err == nil {
+		t.Errorf("expected an error combining bodies of different types")
+	}
+
+	sendF := NewBatcherSendFunc(func(_ string, _ []string) (interface{}, error) {
+		return nil, nil
+	})
+	if _, err := sendF("test-resource", 1); err == nil {
+		t.Errorf("expected an error sending a body of the wrong type")
+	}
+}
+
+func TestRequestBatcher_splitOnMaxBatchSize(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(2) * time.Second,
+			EnableBatching: true,
+		})
+
+	var mu sync.Mutex
+	var batchSizes []int
+	testSendBatch := NewBatcherSendFunc(func(_ string, body []int) (interface{}, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		batchSizes = append(batchSizes, len(body))
+		return len(body), nil
+	})
+
+	maxBatchSize := 3
+	numRequests := 7
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		// Register requests in order so batches fill up deterministically.
+		time.Sleep(10 * time.Millisecond)
+		go func(idx int) {
+			defer wg.Done()
+
+			req := &BatchRequest{
+				DebugId:      fmt.Sprintf("splitOnMaxBatchSize %d", idx),
+				ResourceName: "test-resource",
+				Body:         []int{idx},
+				CombineF:     CombineSlicesBatcherFunc[int](),
+				SendF:        testSendBatch,
+				MaxBatchSize: maxBatchSize,
+			}
+
+			respV, err := testBatcher.SendRequestWithTimeout("splitOnMaxBatchSize", req, time.Duration(6)*time.Second)
+			if err != nil {
+				t.Errorf("got unexpected error %s", err)
+			}
+			if size, ok := respV.(int); !ok || size > maxBatchSize {
+				t.Errorf("expected a batch of at most %d requests, got %v", maxBatchSize, respV)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	total := 0
+	for _, size := range batchSizes {
+		if size > maxBatchSize {
+			t.Errorf("expected batches of at most %d requests, got %v", maxBatchSize, batchSizes)
+		}
+		total += size
+	}
+	if len(batchSizes) != 3 || total != numRequests {
+		t.Errorf("expected %d requests to be sent in 3 batches, got %v", numRequests, batchSizes)
+	}
+}
+
+func TestRequestBatcher_errFanOut(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(1) * time.Second,
+			EnableBatching: true,
+		})
+
+	// The batch fails as a whole, and so does each request for an odd index
+	// when retried alone.
+	testSendBatch := NewBatcherSendFunc(func(_ string, body []int) (interface{}, error) {
+		if len(body) > 1 {
+			return nil, fmt.Errorf("batch of %d requests failed", len(body))
+		}
+		if body[0]%2 == 1 {
+			return nil, fmt.Errorf("request %d failed", body[0])
+		}
+		return body[0], nil
+	})
+
+	numRequests := 4
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			req := &BatchRequest{
+				DebugId:      fmt.Sprintf("errFanOut %d", idx),
+				ResourceName: "test-resource",
+				Body:         []int{idx},
+				CombineF:     CombineSlicesBatcherFunc[int](),
+				SendF:        testSendBatch,
+			}
+
+			respV, err := testBatcher.SendRequestWithTimeout("errFanOut", req, time.Duration(6)*time.Second)
+			if idx%2 == 1 {
+				expectedErrMsg := fmt.Sprintf("request %d failed", idx)
+				if err == nil || !strings.Contains(err.Error(), expectedErrMsg) {
+					t.Errorf("expected request %d to fail with its own error %q, got %v", idx, expectedErrMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected request %d to succeed, got error: %v", idx, err)
+			}
+			if respV != idx {
+				t.Errorf("expected request %d to get its own response, got %v", idx, respV)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestRequestBatcher_partialResults(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(1) * time.Second,
+			EnableBatching: true,
+		})
+
+	var sendCount int32
+	// Requests for an odd index fail without failing the rest of the batch.
+	testSendBatch := NewBatcherSendFunc(func(_ string, body []int) (interface{}, error) {
+		atomic.AddInt32(&sendCount, 1)
+		results := make(BatchRequestResults, len(body))
+		for i, v := range body {
+			if v%2 == 1 {
+				results[i].Err = fmt.Errorf("request %d failed", v)
+			} else {
+				results[i].Body = v
+			}
+		}
+		return results, nil
+	})
+
+	numRequests := 4
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			req := &BatchRequest{
+				DebugId:      fmt.Sprintf("partialResults %d", idx),
+				ResourceName: "test-resource",
+				Body:         []int{idx},
+				CombineF:     CombineSlicesBatcherFunc[int](),
+				SendF:        testSendBatch,
+			}
+
+			respV, err := testBatcher.SendRequestWithTimeout("partialResults", req, time.Duration(6)*time.Second)
+			if idx%2 == 1 {
+				expectedErrMsg := fmt.Sprintf("request %d failed", idx)
+				if err == nil || !strings.Contains(err.Error(), expectedErrMsg) {
+					t.Errorf("expected request %d to fail with its own error %q, got %v", idx, expectedErrMsg, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected request %d to succeed, got error: %v", idx, err)
+			}
+			if respV != idx {
+				t.Errorf("expected request %d to get its own response, got %v", idx, respV)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if sendCount != 1 {
+		t.Errorf("expected partial failures to be reported by a single batch, got %d requests", sendCount)
+	}
+}
+
+func TestRequestBatcher_partialResultsMismatch(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(1) * time.Second,
+			EnableBatching: false,
+		})
+
+	req := &BatchRequest{
+		DebugId:      "partialResultsMismatch",
+		ResourceName: "test-resource",
+		Body:         []int{0},
+		CombineF:     CombineSlicesBatcherFunc[int](),
+		SendF: func(_ string, _ interface{}) (interface{}, error) {
+			return BatchRequestResults{{Body: 0}, {Body: 1}}, nil
+		},
+	}
+
+	_, err := testBatcher.SendRequestWithTimeout("partialResultsMismatch", req, time.Duration(1)*time.Second)
+	if err == nil || !strings.Contains(err.Error(), "returned 2 results") {
+		t.Errorf("expected an error for a mismatched number of results, got %v", err)
+	}
+}
+
+func TestRequestBatcher_bisectOnError(t *testing.T) {
+	testBatcher := NewRequestBatcher(
+		"testBatcher",
+		context.Background(),
+		&BatchingConfig{
+			SendAfter:      time.Duration(1) * time.Second,
+			EnableBatching: true,
+		})
+
+	badIdx := 5
+	var sendCount int32
+	// Any batch containing the bad request fails as a whole.
+	testSendBatch := NewBatcherSendFunc(func(_ string, body []int) (interface{}, error) {
+		atomic.AddInt32(&sendCount, 1)
+		for _, v := range body {
+			if v == badIdx {
+				return nil, fmt.Errorf("batch contains bad request %d", badIdx)
+			}
+		}
+		return len(body), nil
+	})
+
+	numRequests := 16
+	wg := sync.WaitGroup{}
+	wg.Add(numRequests)
+	for i := 0; i < numRequests; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			req := &BatchRequest{
+				DebugId:      fmt.Sprintf("bisectOnError %d", idx),
+				ResourceName: "test-resource",
+				Body:         []int{idx},
+				CombineF:     CombineSlicesBatcherFunc[int](),
+				SendF:        testSendBatch,
+			}
+
+			_, err := testBatcher.SendRequestWithTimeout("bisectOnError", req, time.Duration(6)*time.Second)
+			if idx == badIdx {
+				if err == nil {
+					t.Errorf("expected the bad request %d to fail", idx)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("expected request %d to succeed, got error: %v", idx, err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	// 1 combined batch, then 2 halves at each of the 4 levels of bisection.
+	if sendCount != 9 {
+		t.Errorf("expected the bad request to be isolated in 9 requests, got %d", sendCount)
+	}
+}
@@ -3,6 +3,7 @@
 package transport
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"sync"
@@ -36,15 +37,25 @@ func SuccessPollResult() PollResult {
 
 func PollingWaitTime(pollF PollReadFunc, checkResponse PollCheckResponseFunc, activity string,
 	timeout time.Duration, targetOccurrences int) error {
+	return PollingWaitTimeContext(context.Background(), pollF, checkResponse, activity, timeout, targetOccurrences)
+}
+
+// PollingWaitTimeContext is PollingWaitTime, returning once ctx is done
+// rather than waiting for the timeout. A nil ctx is context.Background().
+func PollingWaitTimeContext(ctx context.Context, pollF PollReadFunc, checkResponse PollCheckResponseFunc, activity string,
+	timeout time.Duration, targetOccurrences int) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	log.Printf("[DEBUG] %s: Polling until expected state is read", activity)
 	log.Printf("[DEBUG] Target occurrences: %d", targetOccurrences)
 	if targetOccurrences == 1 {
-		return resource.Retry(timeout, func() *resource.RetryError {
+		return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
 			readResp, readErr := pollF()
 			return checkResponse(readResp, readErr)
 		})
 	}
-	return RetryWithTargetOccurrences(timeout, targetOccurrences, func() *resource.RetryError {
+	return RetryWithTargetOccurrencesContext(ctx, timeout, targetOccurrences, func() *resource.RetryError {
 		readResp, readErr := pollF()
 		return checkResponse(readResp, readErr)
 	})
@@ -54,6 +65,13 @@ func PollingWaitTime(pollF PollReadFunc, checkResponse PollCheckResponseFunc, ac
 // a function until it returns the specified amount of target occurrences continuously.
 // Adapted from the Retry function in the go SDK.
 func RetryWithTargetOccurrences(timeout time.Duration, targetOccurrences int,
+	f resource.RetryFunc) error {
+	return RetryWithTargetOccurrencesContext(context.Background(), timeout, targetOccurrences, f)
+}
+
+// RetryWithTargetOccurrencesContext is RetryWithTargetOccurrences, returning
+// once ctx is done rather than waiting for the timeout.
+func RetryWithTargetOccurrencesContext(ctx context.Context, timeout time.Duration, targetOccurrences int,
 	f resource.RetryFunc) error {
 	// These are used to pull the error out of the function; need a mutex to
 	// avoid a data race.
@@ -86,7 +104,7 @@ func RetryWithTargetOccurrences(timeout time.Duration, targetOccurrences int,
 		},
 	}
 
-	_, waitErr := c.WaitForState()
+	_, waitErr := c.WaitForStateContext(ctx)
 
 	// Need to acquire the lock here to be able to avoid race using resultErr as
 	// the return value
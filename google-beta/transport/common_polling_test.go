@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPollingWaitTimeContext_Cancelled(t *testing.T) {
+	pollF := func() (map[string]interface{}, error) {
+		return map[string]interface{}{}, nil
+	}
+	checkResponse := func(_ map[string]interface{}, _ error) PollResult {
+		return PendingStatusPollResult("pending")
+	}
+
+	cases := map[string]int{
+		"single occurrence":    1,
+		"multiple occurrences": 3,
+	}
+	for tn, targetOccurrences := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(100*time.Millisecond, cancel)
+
+			start := time.Now()
+			err := PollingWaitTimeContext(ctx, pollF, checkResponse, "test polling", 10*time.Minute, targetOccurrences)
+			if err == nil {
+				t.Errorf("expected an error, got none")
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("expected polling to stop promptly after cancellation, took %s", elapsed)
+			}
+		})
+	}
+}
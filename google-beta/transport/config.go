@@ -171,10 +171,18 @@ type Config struct {
 	UniverseDomain                            string
 	Scopes                                    []string
 	BatchingConfig                            *BatchingConfig
+	RetryPolicy                               *RetryPolicy
+	RequestRateLimits                         map[string]float64
+	RequestTracing                            *RequestTracingConfig
+	RequestCache                              *RequestCacheConfig
+	Recording                                 *RecordingConfig
 	UserProjectOverride                       bool
 	RequestReason                             string
 	RequestTimeout                            time.Duration
 	DefaultLabels                             map[string]string
+	DefaultAnnotations                        map[string]string
+	DefaultResourceManagerTags                map[string]string
+	LabelPolicy                               *LabelPolicy
 	AddTerraformAttributionLabel              bool
 	TerraformAttributionLabelAdditionStrategy string
 	// PollInterval is passed to resource.StateChangeConf in common_operation.go
@@ -337,6 +345,7 @@ type Config struct {
 
 	RequestBatcherServiceUsage *RequestBatcher
 	RequestBatcherIam          *RequestBatcher
+	RequestBatcherDns          *RequestBatcher
 }
 
 const AccessApprovalBasePathKey = "AccessApproval"
@@ -1433,9 +1442,25 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 
 	c.Context = ctx
 
-	tokenSource, err := c.getTokenSource(c.Scopes, false)
-	if err != nil {
-		return err
+	var recorder *RequestRecorder
+	if c.Recording != nil {
+		var err error
+		recorder, err = GetRequestRecorder(c.Recording)
+		if err != nil {
+			return err
+		}
+	}
+
+	var tokenSource oauth2.TokenSource
+	if c.Recording.IsReplay() {
+		// Replayed requests are answered from recorded interactions, so no credentials are needed.
+		tokenSource = RecordingReplayTokenSource()
+	} else {
+		var err error
+		tokenSource, err = c.getTokenSource(c.Scopes, false)
+		if err != nil {
+			return err
+		}
 	}
 
 	c.tokenSource = tokenSource
@@ -1449,23 +1474,48 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	}
 
 	// Userinfo is fetched before request logging is enabled to reduce additional noise.
-	err = c.logGoogleIdentities()
-	if err != nil {
-		return err
+	// It isn't recorded, so it can't be fetched when replaying.
+	if !c.Recording.IsReplay() {
+		err = c.logGoogleIdentities()
+		if err != nil {
+			return err
+		}
 	}
 
 	// 2. Logging Transport - ensure we log HTTP requests to GCP APIs.
 	loggingTransport := logging.NewTransport("Google", client.Transport)
 
-	// 3. Retry Transport - retries common temporary errors
+	// 3. Rate Limit Transport - throttles requests per service if configured.
+	// Keep it beneath the retry transport so each retried request is throttled as well.
+	var rateLimitedTransport http.RoundTripper = loggingTransport
+	if len(c.RequestRateLimits) > 0 {
+		rateLimitedTransport = NewTransportWithRateLimits(loggingTransport, c.RequestRateLimits, BasePathResolver(c))
+	}
+
+	// Request tracing records each attempt beneath the retry transport, so retries
+	// show up as attempts of the traced request.
+	var tracer *RequestTracer
+	if c.RequestTracing != nil {
+		tracer = GetRequestTracer(c.RequestTracing)
+		rateLimitedTransport = tracer.AttemptTransport(rateLimitedTransport)
+	}
+
+	// 4. Retry Transport - retries common temporary errors
 	// Keep order for wrapping logging so we log each retried request as well.
 	// This value should be used if needed to create shallow copies with additional retry predicates.
 	// See ClientWithAdditionalRetries
-	retryTransport := NewTransportWithDefaultRetries(loggingTransport)
+	retryTransport := NewTransportWithDefaultRetries(rateLimitedTransport, c.RetryPolicy)
+
+	// 5. Request Cache Transport - reuses responses to identical read requests if configured.
+	// Keep it above the retry transport so a cached response is the final result of a request.
+	var cachedTransport http.RoundTripper = retryTransport
+	if c.RequestCache != nil {
+		cachedTransport = NewTransportWithRequestCache(retryTransport, c.RequestCache)
+	}
 
-	// 4. Header Transport - outer wrapper to inject additional headers we want to apply
+	// 6. Header Transport - outer wrapper to inject additional headers we want to apply
 	// before making requests
-	headerTransport := NewTransportWithHeaders(retryTransport)
+	headerTransport := NewTransportWithHeaders(cachedTransport)
 	if c.RequestReason != "" {
 		headerTransport.Set("X-Goog-Request-Reason", c.RequestReason)
 	}
@@ -1479,6 +1529,17 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	// Set final transport value.
 	client.Transport = headerTransport
 
+	// 7. Recording Transport - records each request and its response, or answers it from
+	// recorded interactions when replaying, if configured.
+	if recorder != nil {
+		client.Transport = recorder.Transport(client.Transport)
+	}
+
+	// 8. Trace Transport - records each request, including its retries, if configured.
+	if tracer != nil {
+		client.Transport = tracer.Transport(client.Transport)
+	}
+
 	// This timeout is a timeout per HTTP request, not per logical operation.
 	client.Timeout = c.synchronousTimeout()
 
@@ -1487,7 +1548,11 @@ func (c *Config) LoadAndValidate(ctx context.Context) error {
 	c.Region = GetRegionFromRegionSelfLink(c.Region)
 	c.RequestBatcherServiceUsage = NewRequestBatcher("Service Usage", ctx, c.BatchingConfig)
 	c.RequestBatcherIam = NewRequestBatcher("IAM", ctx, c.BatchingConfig)
+	c.RequestBatcherDns = NewRequestBatcher("DNS", ctx, c.BatchingConfig)
 	c.PollInterval = 10 * time.Second
+	if c.Recording.IsReplay() {
+		c.PollInterval = RecordingReplayPollInterval
+	}
 
 	// gRPC Logging setup
 	logger := logrus.StandardLogger()
@@ -1605,6 +1670,12 @@ func (c *Config) getTokenSource(clientScopes []string, initialCredentialsOnly bo
 	return creds.TokenSource, nil
 }
 
+// TokenSource returns the token source of the provider's credentials, for clients
+// of APIs the provider doesn't call itself, like the Kubernetes API of a cluster.
+func (c *Config) TokenSource() oauth2.TokenSource {
+	return c.tokenSource
+}
+
 // Methods to create new services from config
 // Some base paths below need the version and possibly more of the path
 // set on them. The client libraries are inconsistent about which values they need;
@@ -1740,7 +1811,7 @@ func (c *Config) NewSqlAdminClient(userAgent string) *sqladmin.Service {
 func (c *Config) NewPubsubClient(userAgent string) *pubsub.Service {
 	pubsubClientBasePath := RemoveBasePathVersion(c.PubsubBasePath)
 	log.Printf("[INFO] Instantiating Google Pubsub client for path %s", pubsubClientBasePath)
-	wrappedPubsubClient := ClientWithAdditionalRetries(c.Client, PubsubTopicProjectNotReady)
+	wrappedPubsubClient := ClientWithAdditionalRetries(c.Client, c.RetryPolicy, PubsubTopicProjectNotReady)
 	clientPubsub, err := pubsub.NewService(c.Context, option.WithHTTPClient(wrappedPubsubClient))
 	if err != nil {
 		log.Printf("[WARN] Error creating client pubsub: %s", err)
@@ -1923,7 +1994,7 @@ func (c *Config) NewSourceRepoClient(userAgent string) *sourcerepo.Service {
 func (c *Config) NewBigQueryClient(userAgent string) *bigquery.Service {
 	bigQueryClientBasePath := c.BigQueryBasePath
 	log.Printf("[INFO] Instantiating Google Cloud BigQuery client for path %s", bigQueryClientBasePath)
-	wrappedBigQueryClient := ClientWithAdditionalRetries(c.Client, IamMemberMissing)
+	wrappedBigQueryClient := ClientWithAdditionalRetries(c.Client, c.RetryPolicy, IamMemberMissing)
 	clientBigQuery, err := bigquery.NewService(c.Context, option.WithHTTPClient(wrappedBigQueryClient))
 	if err != nil {
 		log.Printf("[WARN] Error creating client big query: %s", err)
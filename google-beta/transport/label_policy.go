@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// maxGoogleLabels is the maximum number of labels of a resource.
+const maxGoogleLabels = 64
+
+var (
+	googleLabelKeyRegexp   = regexp.MustCompile(`^[\p{Ll}\p{Lo}][\p{Ll}\p{Lo}\p{N}_-]{0,62}$`)
+	googleLabelValueRegexp = regexp.MustCompile(`^[\p{Ll}\p{Lo}\p{N}_-]{0,63}$`)
+)
+
+// LabelPolicy is a set of rules the labels managed by Terraform must follow,
+// configured with the provider `label_policy` block.
+type LabelPolicy struct {
+	RequiredKeys []string
+	// AllowedValues maps label keys to the regular expressions their whole
+	// values must match.
+	AllowedValues map[string]string
+	DeniedKeys    []string
+	MaxLabels     int
+
+	allowedValues map[string]*regexp.Regexp
+}
+
+// Violations returns the rules broken by labels, sorted. If checkSyntax is
+// set, labels are also checked against the syntax of Google Cloud labels.
+func (p *LabelPolicy) Violations(labels map[string]string, checkSyntax bool) []string {
+	var violations []string
+
+	for _, k := range p.RequiredKeys {
+		if _, ok := labels[k]; !ok {
+			violations = append(violations, fmt.Sprintf("required label %q is missing", k))
+		}
+	}
+
+	for _, k := range p.DeniedKeys {
+		if _, ok := labels[k]; ok {
+			violations = append(violations, fmt.Sprintf("label %q is not allowed", k))
+		}
+	}
+
+	for k, re := range p.allowedValues {
+		if v, ok := labels[k]; ok && !re.MatchString(v) {
+			violations = append(violations, fmt.Sprintf("label %q has value %q, which doesn't match %q", k, v, p.AllowedValues[k]))
+		}
+	}
+
+	maxLabels := p.MaxLabels
+	if maxLabels == 0 || maxLabels > maxGoogleLabels {
+		maxLabels = maxGoogleLabels
+	}
+	if len(labels) > maxLabels {
+		violations = append(violations, fmt.Sprintf("%d labels are set, but at most %d are allowed", len(labels), maxLabels))
+	}
+
+	if checkSyntax {
+		for k, v := range labels {
+			if !googleLabelKeyRegexp.MatchString(k) {
+				violations = append(violations, fmt.Sprintf("label key %q must start with a lowercase letter and only contain up to 63 lowercase letters, digits, underscores and dashes", k))
+			}
+			if !googleLabelValueRegexp.MatchString(v) {
+				violations = append(violations, fmt.Sprintf("label %q has value %q, which must only contain up to 63 lowercase letters, digits, underscores and dashes", k, v))
+			}
+		}
+	}
+
+	sort.Strings(violations)
+	return violations
+}
+
+// ExpandProviderLabelPolicy returns the label policy for the provider
+// `label_policy` block, or nil if it is unset.
+func ExpandProviderLabelPolicy(v interface{}) (*LabelPolicy, error) {
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return nil, nil
+	}
+
+	cfgV := ls[0].(map[string]interface{})
+	p := &LabelPolicy{
+		AllowedValues: map[string]string{},
+		MaxLabels:     cfgV["max_labels"].(int),
+	}
+	for _, k := range cfgV["required_keys"].([]interface{}) {
+		p.RequiredKeys = append(p.RequiredKeys, k.(string))
+	}
+	for _, k := range cfgV["denied_keys"].([]interface{}) {
+		p.DeniedKeys = append(p.DeniedKeys, k.(string))
+	}
+	for k, v := range cfgV["allowed_values"].(map[string]interface{}) {
+		p.AllowedValues[k] = v.(string)
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks that the label policy is usable, and compiles the regular
+// expressions of allowed values.
+func (p *LabelPolicy) Validate() error {
+	p.allowedValues = make(map[string]*regexp.Regexp, len(p.AllowedValues))
+	for k, v := range p.AllowedValues {
+		re, err := regexp.Compile(`^(?:` + v + `)$`)
+		if err != nil {
+			return fmt.Errorf("label_policy.allowed_values[%q] is not a valid regular expression: %s", k, err)
+		}
+		p.allowedValues[k] = re
+	}
+	if p.MaxLabels < 0 {
+		return fmt.Errorf("label_policy.max_labels must not be negative, got %d", p.MaxLabels)
+	}
+	for _, k := range p.RequiredKeys {
+		for _, d := range p.DeniedKeys {
+			if k == d {
+				return fmt.Errorf("label_policy requires and denies label %q", k)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLabelPolicy_Violations(t *testing.T) {
+	cases := map[string]struct {
+		Policy      LabelPolicy
+		Labels      map[string]string
+		CheckSyntax bool
+		Expect      []string
+	}{
+		"no violations": {
+			Policy: LabelPolicy{
+				RequiredKeys:  []string{"env"},
+				AllowedValues: map[string]string{"env": "dev|prod"},
+				DeniedKeys:    []string{"owner"},
+			},
+			Labels:      map[string]string{"env": "prod", "team": "infra"},
+			CheckSyntax: true,
+		},
+		"required label missing": {
+			Policy: LabelPolicy{RequiredKeys: []string{"env", "team"}},
+			Labels: map[string]string{"team": "infra"},
+			Expect: []string{`required label "env" is missing`},
+		},
+		"denied label present": {
+			Policy: LabelPolicy{DeniedKeys: []string{"owner"}},
+			Labels: map[string]string{"owner": "me"},
+			Expect: []string{`label "owner" is not allowed`},
+		},
+		"value must match the whole pattern": {
+			Policy: LabelPolicy{AllowedValues: map[string]string{"env": "dev|prod"}},
+			Labels: map[string]string{"env": "production"},
+			Expect: []string{`label "env" has value "production", which doesn't match "dev|prod"`},
+		},
+		"too many labels": {
+			Policy: LabelPolicy{MaxLabels: 1},
+			Labels: map[string]string{"env": "prod", "team": "infra"},
+			Expect: []string{"2 labels are set, but at most 1 are allowed"},
+		},
+		"syntax is checked": {
+			Labels:      map[string]string{"Env": "prod", "team": "Infra"},
+			CheckSyntax: true,
+			Expect: []string{
+				`label "team" has value "Infra", which must only contain up to 63 lowercase letters, digits, underscores and dashes`,
+				`label key "Env" must start with a lowercase letter and only contain up to 63 lowercase letters, digits, underscores and dashes`,
+			},
+		},
+		"syntax isn't checked": {
+			Labels: map[string]string{"app.kubernetes.io/name": "Web"},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			if err := tc.Policy.Validate(); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got := tc.Policy.Violations(tc.Labels, tc.CheckSyntax); !reflect.DeepEqual(got, tc.Expect) {
+				t.Errorf("want %q, got %q", tc.Expect, got)
+			}
+		})
+	}
+}
+
+func TestExpandProviderLabelPolicy(t *testing.T) {
+	p, err := ExpandProviderLabelPolicy([]interface{}{})
+	if err != nil || p != nil {
+		t.Fatalf("expected no policy for an unset block, got %#v, %v", p, err)
+	}
+
+	block := func(required, denied []interface{}, allowed map[string]interface{}) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"required_keys":  required,
+				"denied_keys":    denied,
+				"allowed_values": allowed,
+				"max_labels":     0,
+			},
+		}
+	}
+
+	p, err = ExpandProviderLabelPolicy(block([]interface{}{"env"}, []interface{}{}, map[string]interface{}{"env": "dev|prod"}))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if got := p.Violations(map[string]string{"env": "test"}, false); len(got) != 1 {
+		t.Errorf("expected the expanded policy to check allowed values, got %q", got)
+	}
+
+	cases := map[string]struct {
+		Block  []interface{}
+		Expect string
+	}{
+		"invalid regular expression": {
+			Block:  block([]interface{}{}, []interface{}{}, map[string]interface{}{"env": "("}),
+			Expect: "is not a valid regular expression",
+		},
+		"required and denied": {
+			Block:  block([]interface{}{"owner"}, []interface{}{"owner"}, map[string]interface{}{}),
+			Expect: `requires and denies label "owner"`,
+		},
+	}
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			_, err := ExpandProviderLabelPolicy(tc.Block)
+			if err == nil || !strings.Contains(err.Error(), tc.Expect) {
+				t.Errorf("expected an error containing %q, got %v", tc.Expect, err)
+			}
+		})
+	}
+}
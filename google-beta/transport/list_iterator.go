@@ -0,0 +1,192 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"google.golang.org/api/iterator"
+)
+
+// DefaultListKey is the field holding the items of most list responses.
+const DefaultListKey = "items"
+
+// ListRequestOptions describes a paginated list request sent with
+// SendRequest.
+type ListRequestOptions struct {
+	Config    *Config
+	Project   string
+	UserAgent string
+	// RawURL is the URL of the list method, which may already have query
+	// parameters.
+	RawURL string
+	// ListKey is the response field holding the items of a page. Defaults to
+	// "items".
+	ListKey string
+	// Aggregated is set for aggregatedList methods, whose ListKey field maps
+	// each scope, such as "zones/us-central1-a", to an object holding the
+	// items of that scope under AggregatedItemsKey.
+	Aggregated         bool
+	AggregatedItemsKey string
+	// PageSize and Filter are sent as the pageSize and filter query
+	// parameters if set.
+	PageSize int
+	Filter   string
+	// Context cancels the page requests, and is checked before each page is
+	// requested, so a cancelled context stops the iteration.
+	Context              context.Context
+	ErrorRetryPredicates []RetryErrorPredicateFunc
+	ErrorAbortPredicates []RetryErrorPredicateFunc
+}
+
+// ListIterator iterates over the items of a paginated list, requesting pages
+// as needed. Next returns iterator.Done after the last item, like the
+// iterators of the Google API client libraries.
+type ListIterator struct {
+	opt ListRequestOptions
+
+	pageToken string
+	done      bool
+	items     []interface{}
+}
+
+func NewListIterator(opt ListRequestOptions) *ListIterator {
+	if opt.ListKey == "" {
+		opt.ListKey = DefaultListKey
+	}
+	if opt.Context == nil {
+		opt.Context = context.Background()
+	}
+	return &ListIterator{opt: opt}
+}
+
+// NextPage requests the next page of the list and returns the raw response,
+// or iterator.Done if the last page was already returned.
+func (it *ListIterator) NextPage() (map[string]interface{}, error) {
+	if it.done {
+		return nil, iterator.Done
+	}
+	if err := it.opt.Context.Err(); err != nil {
+		return nil, err
+	}
+
+	params := map[string]string{}
+	if it.pageToken != "" {
+		params["pageToken"] = it.pageToken
+	}
+	if it.opt.PageSize > 0 {
+		params["pageSize"] = strconv.Itoa(it.opt.PageSize)
+	}
+	if it.opt.Filter != "" {
+		params["filter"] = it.opt.Filter
+	}
+	url, err := AddQueryParams(it.opt.RawURL, params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := SendRequest(SendRequestOptions{
+		Config:               it.opt.Config,
+		Method:               "GET",
+		Project:              it.opt.Project,
+		RawURL:               url,
+		UserAgent:            it.opt.UserAgent,
+		ErrorRetryPredicates: it.opt.ErrorRetryPredicates,
+		ErrorAbortPredicates: it.opt.ErrorAbortPredicates,
+		Context:              it.opt.Context,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		res = map[string]interface{}{}
+	}
+
+	token, _ := res["nextPageToken"].(string)
+	it.pageToken = token
+	it.done = token == ""
+	return res, nil
+}
+
+// Next returns the next item of the list, or iterator.Done if there are no
+// more items.
+func (it *ListIterator) Next() (interface{}, error) {
+	for len(it.items) == 0 {
+		page, err := it.NextPage()
+		if err != nil {
+			return nil, err
+		}
+		items, err := it.PageItems(page)
+		if err != nil {
+			return nil, err
+		}
+		it.items = items
+	}
+
+	item := it.items[0]
+	it.items = it.items[1:]
+	return item, nil
+}
+
+// PageItems returns the items of a page returned by NextPage. The items of
+// aggregated lists are returned ordered by scope.
+func (it *ListIterator) PageItems(page map[string]interface{}) ([]interface{}, error) {
+	v, ok := page[it.opt.ListKey]
+	if !ok || v == nil {
+		return nil, nil
+	}
+
+	if !it.opt.Aggregated {
+		items, ok := v.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected %q to be a list in the list response, got %T", it.opt.ListKey, v)
+		}
+		return items, nil
+	}
+
+	scopes, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("expected %q to be a map of scopes in the aggregated list response, got %T", it.opt.ListKey, v)
+	}
+	keys := make([]string, 0, len(scopes))
+	for k := range scopes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var items []interface{}
+	for _, k := range keys {
+		scope, ok := scopes[k].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		// Scopes without items only hold a warning.
+		if scopeItems, ok := scope[it.opt.AggregatedItemsKey].([]interface{}); ok {
+			items = append(items, scopeItems...)
+		}
+	}
+	return items, nil
+}
+
+// All returns the remaining items of the list.
+func (it *ListIterator) All() ([]interface{}, error) {
+	items := make([]interface{}, 0)
+	for {
+		item, err := it.Next()
+		if err == iterator.Done {
+			return items, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
+
+// ListAll returns all items of a paginated list.
+func ListAll(opt ListRequestOptions) ([]interface{}, error) {
+	return NewListIterator(opt).All()
+}
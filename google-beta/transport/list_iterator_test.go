@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sync"
+	"testing"
+
+	"google.golang.org/api/iterator"
+)
+
+// setUpListServer returns a server answering with the given pages in order,
+// keyed by the pageToken query parameter, and recording the query of every
+// request it receives.
+func setUpListServer(t *testing.T, pages map[string]map[string]interface{}) (*httptest.Server, *[]url.Values) {
+	var mu sync.Mutex
+	var queries []url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.Query())
+		mu.Unlock()
+
+		page, ok := pages[r.URL.Query().Get("pageToken")]
+		if !ok {
+			t.Errorf("unexpected page token %q", r.URL.Query().Get("pageToken"))
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(page)
+	}))
+	return ts, &queries
+}
+
+func TestListAll_Pages(t *testing.T) {
+	ts, queries := setUpListServer(t, map[string]map[string]interface{}{
+		"": {
+			"secrets":       []interface{}{"a", "b"},
+			"nextPageToken": "page2",
+		},
+		"page2": {
+			"secrets":       []interface{}{"c"},
+			"nextPageToken": "page3",
+		},
+		// The last page of some APIs omits the list key.
+		"page3": {},
+	})
+	defer ts.Close()
+
+	items, err := ListAll(ListRequestOptions{
+		Config:    &Config{Client: ts.Client()},
+		UserAgent: "test",
+		RawURL:    ts.URL + "/v1/projects/p/secrets?orderBy=name",
+		ListKey:   "secrets",
+		PageSize:  2,
+		Filter:    "labels.env=prod",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []interface{}{"a", "b", "c"}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected items %v, got %v", expected, items)
+	}
+
+	if len(*queries) != 3 {
+		t.Fatalf("expected 3 requests, got %d", len(*queries))
+	}
+	for i, q := range *queries {
+		if q.Get("orderBy") != "name" || q.Get("pageSize") != "2" || q.Get("filter") != "labels.env=prod" {
+			t.Errorf("request %d: expected existing and list query parameters to be sent, got %v", i, q)
+		}
+	}
+	if got := (*queries)[1].Get("pageToken"); got != "page2" {
+		t.Errorf("expected the second request to send pageToken page2, got %q", got)
+	}
+}
+
+func TestListAll_Aggregated(t *testing.T) {
+	ts, _ := setUpListServer(t, map[string]map[string]interface{}{
+		"": {
+			"items": map[string]interface{}{
+				"regions/us-east1": map[string]interface{}{
+					"addresses": []interface{}{"b"},
+				},
+				"regions/europe-west1": map[string]interface{}{
+					"warning": map[string]interface{}{"code": "NO_RESULTS_ON_PAGE"},
+				},
+				"regions/asia-east1": map[string]interface{}{
+					"addresses": []interface{}{"a"},
+				},
+			},
+			"nextPageToken": "page2",
+		},
+		"page2": {
+			"items": map[string]interface{}{
+				"regions/us-west1": map[string]interface{}{
+					"addresses": []interface{}{"c"},
+				},
+			},
+		},
+	})
+	defer ts.Close()
+
+	items, err := ListAll(ListRequestOptions{
+		Config:             &Config{Client: ts.Client()},
+		UserAgent:          "test",
+		RawURL:             ts.URL + "/compute/beta/projects/p/aggregated/addresses",
+		Aggregated:         true,
+		AggregatedItemsKey: "addresses",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []interface{}{"a", "b", "c"}; !reflect.DeepEqual(items, expected) {
+		t.Errorf("expected items %v, got %v", expected, items)
+	}
+}
+
+func TestListIterator_ContextCancelled(t *testing.T) {
+	ts, queries := setUpListServer(t, map[string]map[string]interface{}{
+		"": {
+			"items":         []interface{}{"a"},
+			"nextPageToken": "page2",
+		},
+		"page2": {
+			"items": []interface{}{"b"},
+		},
+	})
+	defer ts.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	it := NewListIterator(ListRequestOptions{
+		Config:    &Config{Client: ts.Client()},
+		UserAgent: "test",
+		RawURL:    ts.URL + "/v1/things",
+		Context:   ctx,
+	})
+
+	item, err := it.Next()
+	if err != nil || item != "a" {
+		t.Fatalf("expected the first item, got %v, %v", item, err)
+	}
+	cancel()
+	if _, err := it.Next(); err != context.Canceled {
+		t.Errorf("expected iteration to stop with context.Canceled, got %v", err)
+	}
+	if len(*queries) != 1 {
+		t.Errorf("expected no request after the context was cancelled, got %d requests", len(*queries))
+	}
+}
+
+func TestListIterator_Done(t *testing.T) {
+	ts, _ := setUpListServer(t, map[string]map[string]interface{}{
+		"": {},
+	})
+	defer ts.Close()
+
+	it := NewListIterator(ListRequestOptions{
+		Config:    &Config{Client: ts.Client()},
+		UserAgent: "test",
+		RawURL:    ts.URL + "/v1/things",
+	})
+	for i := 0; i < 2; i++ {
+		if _, err := it.Next(); err != iterator.Done {
+			t.Errorf("expected iterator.Done for an empty list, got %v", err)
+		}
+	}
+}
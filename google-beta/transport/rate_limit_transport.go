@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+// A http.RoundTripper that throttles requests per API service using a token
+// bucket, so that large plans stay under per-minute API quotas instead of
+// relying on retries of 429/403 quota errors.
+//
+// Services are identified by their base path, e.g. Config.ComputeBasePath,
+// and configured in the provider block keyed by the same name used for the
+// service's custom endpoint:
+//
+//	provider "google" {
+//	  request_rate_limits = {
+//	    compute = 20
+//	    pubsub  = 5
+//	  }
+//	}
+//
+// The transport sits beneath the retry transport so every retried attempt
+// also consumes a token.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"net/http"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimitClock abstracts time so token buckets can be tested with a fake
+// clock.
+type rateLimitClock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// tokenBucket hands out tokens at a fixed rate, allowing up to burst tokens
+// to accumulate while idle.
+type tokenBucket struct {
+	mu     sync.Mutex
+	clock  rateLimitClock
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, clock rateLimitClock) *tokenBucket {
+	burst := math.Max(1, math.Ceil(rate))
+	return &tokenBucket{
+		clock:  clock,
+		rate:   rate,
+		burst:  burst,
+		tokens: burst,
+		last:   clock.Now(),
+	}
+}
+
+// reserve takes a token, possibly going into debt, and returns how long the
+// caller must wait before the token is actually available. Callers are
+// served in the order they reserve.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// cancel returns a token taken by reserve that ended up unused.
+func (b *tokenBucket) cancel() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.tokens = math.Min(b.burst, b.tokens+1)
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	wait := b.reserve()
+	if wait <= 0 {
+		return nil
+	}
+
+	select {
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	case <-b.clock.After(wait):
+		return nil
+	}
+}
+
+// rateLimitBuckets holds the token bucket of each rate limited service. Buckets
+// are shared within the provider process, so requests of the SDK and plugin
+// framework clients count against the same limit.
+type rateLimitBuckets struct {
+	mu      sync.Mutex
+	clock   rateLimitClock
+	buckets map[string]*tokenBucket
+}
+
+var sharedRateLimitBuckets = newRateLimitBuckets(realClock{})
+
+func newRateLimitBuckets(clock rateLimitClock) *rateLimitBuckets {
+	return &rateLimitBuckets{
+		clock:   clock,
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// get returns the bucket of a service with the given rate, creating it on first use.
+func (b *rateLimitBuckets) get(service string, rate float64) *tokenBucket {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := fmt.Sprintf("%s/%g", service, rate)
+	if bucket, ok := b.buckets[key]; ok {
+		return bucket
+	}
+	bucket := newTokenBucket(rate, b.clock)
+	b.buckets[key] = bucket
+	return bucket
+}
+
+type serviceRateLimiter struct {
+	service string
+	match   *regexp.Regexp
+	// prefixLen is used to prefer the most specific base path when several
+	// services share a host, e.g. cloudbuild v1 and v2.
+	prefixLen int
+	bucket    *tokenBucket
+}
+
+type rateLimitTransport struct {
+	internal http.RoundTripper
+	buckets  *rateLimitBuckets
+
+	limits          map[string]float64
+	resolveBasePath func(service string) string
+
+	once     sync.Once
+	limiters []*serviceRateLimiter
+}
+
+// NewTransportWithRateLimits constructs a rateLimitTransport that throttles
+// requests to each service in limits to the given requests per second.
+// Limits are keyed by base path key, e.g. "Compute", and
+// resolveBasePath is called lazily on the first request so base paths can be
+// set after the transport is created. Transports with the same limit for a
+// service share its token bucket.
+func NewTransportWithRateLimits(t http.RoundTripper, limits map[string]float64, resolveBasePath func(service string) string) *rateLimitTransport {
+	return &rateLimitTransport{
+		internal:        t,
+		buckets:         sharedRateLimitBuckets,
+		limits:          limits,
+		resolveBasePath: resolveBasePath,
+	}
+}
+
+func (t *rateLimitTransport) init() {
+	for service, rate := range t.limits {
+		basePath := t.resolveBasePath(service)
+		if basePath == "" {
+			log.Printf("[WARN] Rate Limit Transport: no base path found for service %q, requests will not be limited", service)
+			continue
+		}
+		t.limiters = append(t.limiters, &serviceRateLimiter{
+			service:   service,
+			match:     basePathMatcher(basePath),
+			prefixLen: len(basePath),
+			bucket:    t.buckets.get(service, rate),
+		})
+	}
+	sort.Slice(t.limiters, func(i, j int) bool {
+		return t.limiters[i].prefixLen > t.limiters[j].prefixLen
+	})
+}
+
+// RoundTrip implements the RoundTripper interface method.
+// It waits for a token of the service the request is sent to, if that
+// service is rate limited, before sending the request.
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.once.Do(t.init)
+
+	u := req.URL.String()
+	for _, l := range t.limiters {
+		if !l.match.MatchString(u) {
+			continue
+		}
+		if err := l.bucket.Wait(req.Context()); err != nil {
+			return nil, fmt.Errorf("waiting for %s rate limit: %w", l.service, err)
+		}
+		break
+	}
+	return t.internal.RoundTrip(req)
+}
+
+var basePathTemplateRegex = regexp.MustCompile(`\\\{\\\{[^}]*\\\}\\\}`)
+
+// basePathMatcher returns a regexp matching URLs under basePath. Templated
+// segments like {{location}} match any single path or host segment.
+func basePathMatcher(basePath string) *regexp.Regexp {
+	quoted := regexp.QuoteMeta(basePath)
+	return regexp.MustCompile("^" + basePathTemplateRegex.ReplaceAllString(quoted, `[^/.]+`))
+}
+
+// RateLimitServiceKey normalizes a service name so that the custom endpoint
+// style name ("cloud_run_v2") and the base path key ("CloudRunV2") match.
+func RateLimitServiceKey(service string) string {
+	return strings.ToLower(strings.ReplaceAll(service, "_", ""))
+}
+
+// ExpandProviderRateLimits validates the provider `request_rate_limits`
+// map and returns it keyed by base path key, e.g. "Compute".
+func ExpandProviderRateLimits(v map[string]interface{}) (map[string]float64, error) {
+	if len(v) == 0 {
+		return nil, nil
+	}
+
+	knownServices := make(map[string]string, len(DefaultBasePaths))
+	for key := range DefaultBasePaths {
+		knownServices[RateLimitServiceKey(key)] = key
+	}
+
+	limits := make(map[string]float64, len(v))
+	for service, rateV := range v {
+		key, ok := knownServices[RateLimitServiceKey(service)]
+		if !ok {
+			return nil, fmt.Errorf("unknown service %q in request_rate_limits", service)
+		}
+		rate := rateV.(float64)
+		if rate <= 0 {
+			return nil, fmt.Errorf("request_rate_limits for service %q must be greater than 0, got %v", service, rate)
+		}
+		limits[key] = rate
+	}
+	return limits, nil
+}
+
+// BasePathResolver returns a function resolving a base path key, e.g.
+// "Compute", to the value of the matching "<key>BasePath" field of cfg,
+// which must be a pointer to a struct such as *Config.
+func BasePathResolver(cfg interface{}) func(service string) string {
+	return func(service string) string {
+		f := reflect.ValueOf(cfg).Elem().FieldByName(service + "BasePath")
+		if !f.IsValid() || f.Kind() != reflect.String {
+			return ""
+		}
+		return f.String()
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeRateLimitClock advances virtual time whenever a caller waits on it, so
+// tests can measure how long requests would have been throttled without
+// sleeping.
+type fakeRateLimitClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeRateLimitClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeRateLimitClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+	return ch
+}
+
+// setUpRateLimitTransportServerClient returns a client whose rate limited
+// services all have base path basePathSuffix on the test server.
+func setUpRateLimitTransportServerClient(limits map[string]float64, basePathSuffix string) (*httptest.Server, *http.Client, *fakeRateLimitClock, *int32) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	clock := &fakeRateLimitClock{now: time.Unix(0, 0)}
+	rt := NewTransportWithRateLimits(http.DefaultTransport, limits, func(service string) string {
+		return ts.URL + basePathSuffix
+	})
+	rt.buckets = newRateLimitBuckets(clock)
+
+	client := ts.Client()
+	client.Transport = rt
+	return ts, client, clock, &hits
+}
+
+func TestRateLimitTransport_ThrottlesService(t *testing.T) {
+	ts, client, clock, hits := setUpRateLimitTransportServerClient(map[string]float64{ComputeBasePathKey: 2}, "/compute/beta/")
+	defer ts.Close()
+	start := clock.Now()
+
+	for i := 0; i < 6; i++ {
+		resp, err := client.Get(ts.URL + "/compute/beta/projects/p/zones/z/instances")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if *hits != 6 {
+		t.Errorf("expected 6 requests to reach the server, got %d", *hits)
+	}
+	// A burst of 2 is sent immediately, the remaining 4 requests take 0.5s each.
+	if elapsed := clock.Now().Sub(start); elapsed != 2*time.Second {
+		t.Errorf("expected requests to be throttled for 2s, got %s", elapsed)
+	}
+}
+
+func TestRateLimitTransport_SharedBetweenTransports(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	// Like the transports of the SDK and plugin framework providers
+	clock := &fakeRateLimitClock{now: time.Unix(0, 0)}
+	buckets := newRateLimitBuckets(clock)
+	var clients []*http.Client
+	for i := 0; i < 2; i++ {
+		rt := NewTransportWithRateLimits(http.DefaultTransport, map[string]float64{ComputeBasePathKey: 2}, func(service string) string {
+			return ts.URL + "/compute/beta/"
+		})
+		rt.buckets = buckets
+		client := ts.Client()
+		client.Transport = rt
+		clients = append(clients, client)
+	}
+	start := clock.Now()
+
+	for i := 0; i < 6; i++ {
+		resp, err := clients[i%2].Get(ts.URL + "/compute/beta/projects/p/zones/z/instances")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if hits != 6 {
+		t.Errorf("expected 6 requests to reach the server, got %d", hits)
+	}
+	// The requests of both transports count against the same limit of 2 per second
+	if elapsed := clock.Now().Sub(start); elapsed != 2*time.Second {
+		t.Errorf("expected requests to be throttled for 2s, got %s", elapsed)
+	}
+}
+
+func TestRateLimitTransport_OtherServicesUnthrottled(t *testing.T) {
+	ts, client, clock, hits := setUpRateLimitTransportServerClient(map[string]float64{ComputeBasePathKey: 1}, "/compute/beta/")
+	defer ts.Close()
+	start := clock.Now()
+
+	for i := 0; i < 5; i++ {
+		resp, err := client.Get(ts.URL + "/pubsub/v1/projects/p/topics")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if *hits != 5 {
+		t.Errorf("expected 5 requests to reach the server, got %d", *hits)
+	}
+	if elapsed := clock.Now().Sub(start); elapsed != 0 {
+		t.Errorf("expected requests to another service not to be throttled, got %s", elapsed)
+	}
+}
+
+func TestRateLimitTransport_ContextCancelled(t *testing.T) {
+	bucket := newTokenBucket(1, realClock{})
+	if err := bucket.Wait(context.Background()); err != nil {
+		t.Fatalf("expected first token to be available, got: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := bucket.Wait(ctx); err == nil {
+		t.Fatalf("expected an error waiting with a cancelled context")
+	}
+}
+
+func TestTokenBucket_RefillsWhileIdle(t *testing.T) {
+	clock := &fakeRateLimitClock{now: time.Unix(0, 0)}
+	bucket := newTokenBucket(4, clock)
+
+	for i := 0; i < 4; i++ {
+		if wait := bucket.reserve(); wait != 0 {
+			t.Fatalf("expected burst token %d to be available immediately, got wait %s", i, wait)
+		}
+	}
+	if wait := bucket.reserve(); wait != 250*time.Millisecond {
+		t.Fatalf("expected to wait 250ms for the next token, got %s", wait)
+	}
+
+	// After idling for a long time, tokens are capped at the burst size.
+	clock.now = clock.now.Add(time.Minute)
+	for i := 0; i < 4; i++ {
+		if wait := bucket.reserve(); wait != 0 {
+			t.Fatalf("expected refilled token %d to be available immediately, got wait %s", i, wait)
+		}
+	}
+	if wait := bucket.reserve(); wait == 0 {
+		t.Fatalf("expected tokens to be capped at the burst size")
+	}
+}
+
+func TestBasePathMatcher(t *testing.T) {
+	cases := map[string]struct {
+		BasePath string
+		URL      string
+		Expected bool
+	}{
+		"matches a URL under the base path": {
+			BasePath: "https://compute.googleapis.com/compute/beta/",
+			URL:      "https://compute.googleapis.com/compute/beta/projects/p/global/networks?alt=json",
+			Expected: true,
+		},
+		"does not match another version of the API": {
+			BasePath: "https://cloudbuild.googleapis.com/v1/",
+			URL:      "https://cloudbuild.googleapis.com/v2/projects/p/locations/l/connections",
+			Expected: false,
+		},
+		"matches templated host segments": {
+			BasePath: "https://{{location}}-run.googleapis.com/",
+			URL:      "https://us-central1-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/p/services",
+			Expected: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			if got := basePathMatcher(tc.BasePath).MatchString(tc.URL); got != tc.Expected {
+				t.Errorf("expected match to be %v for %q against %q", tc.Expected, tc.URL, tc.BasePath)
+			}
+		})
+	}
+}
+
+func TestExpandProviderRateLimits(t *testing.T) {
+	limits, err := ExpandProviderRateLimits(map[string]interface{}{
+		"compute":      10.0,
+		"cloud_run_v2": 2.5,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limits[ComputeBasePathKey] != 10 || limits[CloudRunV2BasePathKey] != 2.5 {
+		t.Errorf("unexpected limits: %v", limits)
+	}
+
+	if _, err := ExpandProviderRateLimits(map[string]interface{}{"not_a_service": 1.0}); err == nil {
+		t.Errorf("expected an error for an unknown service")
+	}
+	if _, err := ExpandProviderRateLimits(map[string]interface{}{"compute": 0.0}); err == nil {
+		t.Errorf("expected an error for a non-positive rate")
+	}
+}
+
+func TestBasePathResolver(t *testing.T) {
+	config := &Config{ComputeBasePath: "https://compute.googleapis.com/compute/beta/"}
+	resolve := BasePathResolver(config)
+
+	if got := resolve(ComputeBasePathKey); got != config.ComputeBasePath {
+		t.Errorf("expected %q, got %q", config.ComputeBasePath, got)
+	}
+	if got := resolve("NotAService"); got != "" {
+		t.Errorf("expected no base path for an unknown service, got %q", got)
+	}
+}
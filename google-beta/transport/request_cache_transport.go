@@ -0,0 +1,414 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+// A http.RoundTripper caching responses to read requests for the duration of
+// a provider run, configured with the provider `request_cache` block:
+//
+//	provider "google" {
+//	  request_cache {
+//	    ttl = "30s"
+//	  }
+//	}
+//
+// Many resources and data sources read the same parent objects, such as
+// networks, projects or IAM policies, during a plan. Identical GET requests
+// made while another is in flight share its response, and successful
+// responses are reused until they expire or a mutating request is sent for
+// the same resource path, its parents or its children. Since a mutation may
+// only be applied once its long-running operation completes, polling an
+// operation to completion drops every cached response.
+//
+// Long-running operations and media downloads are never cached, as they're
+// expected to change between reads or to be too large to keep in memory.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultRequestCacheTTL is how long cached responses are reused if the
+// request_cache block doesn't set a ttl.
+const DefaultRequestCacheTTL = 30 * time.Second
+
+type RequestCacheConfig struct {
+	TTL time.Duration
+}
+
+type cachedResponse struct {
+	status     string
+	statusCode int
+	proto      string
+	protoMajor int
+	protoMinor int
+	header     http.Header
+	body       []byte
+}
+
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        c.status,
+		StatusCode:    c.statusCode,
+		Proto:         c.proto,
+		ProtoMajor:    c.protoMajor,
+		ProtoMinor:    c.protoMinor,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
+
+type requestCacheEntry struct {
+	resourcePath string
+	expires      time.Time
+	response     *cachedResponse
+}
+
+type requestCacheTransport struct {
+	internal http.RoundTripper
+	clock    rateLimitClock
+	ttl      time.Duration
+
+	group singleflight.Group
+
+	mu         sync.Mutex
+	entries    map[string]*requestCacheEntry
+	generation uint64
+}
+
+var (
+	requestCachesMu sync.Mutex
+	requestCaches   []*requestCacheTransport
+)
+
+// NewTransportWithRequestCache constructs a requestCacheTransport reusing
+// responses to read requests sent through t for cfg.TTL. Mutating requests
+// sent through any cache transport of the provider process invalidate the
+// matching entries of all of them, so clients of the SDK and plugin framework
+// providers see each other's changes.
+func NewTransportWithRequestCache(t http.RoundTripper, cfg *RequestCacheConfig) *requestCacheTransport {
+	ct := &requestCacheTransport{
+		internal: t,
+		clock:    realClock{},
+		ttl:      cfg.TTL,
+		entries:  map[string]*requestCacheEntry{},
+	}
+
+	requestCachesMu.Lock()
+	defer requestCachesMu.Unlock()
+	requestCaches = append(requestCaches, ct)
+	return ct
+}
+
+// RoundTrip implements the RoundTripper interface method.
+func (t *requestCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !isCacheableRequest(req) {
+		if isOperationRequest(req) {
+			return t.roundTripOperation(req)
+		}
+		if !isReadOnlyRequest(req) {
+			resourcePath := requestResourcePath(req)
+			invalidateRequestCaches(resourcePath)
+			// Reads sent while the request is in flight may be cached with the
+			// resource as it was before, so invalidate them once it returns.
+			defer invalidateRequestCaches(resourcePath)
+		}
+		return t.internal.RoundTrip(req)
+	}
+
+	key, err := requestCacheKey(req)
+	if err != nil {
+		log.Printf("[WARN] Request Cache: unable to compute cache key, sending request uncached: %s", err)
+		return t.internal.RoundTrip(req)
+	}
+
+	if cached := t.get(key); cached != nil {
+		closeRequestBody(req)
+		log.Printf("[DEBUG] Request Cache: using cached response for %s %s", req.Method, req.URL)
+		return cached.response(req), nil
+	}
+
+	ch := t.group.DoChan(key, func() (interface{}, error) {
+		t.mu.Lock()
+		generation := t.generation
+		t.mu.Unlock()
+
+		detached, cancel := detachedRequest(req)
+		defer cancel()
+		resp, err := t.internal.RoundTrip(detached)
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		cached := &cachedResponse{
+			status:     resp.Status,
+			statusCode: resp.StatusCode,
+			proto:      resp.Proto,
+			protoMajor: resp.ProtoMajor,
+			protoMinor: resp.ProtoMinor,
+			header:     resp.Header,
+			body:       body,
+		}
+		if resp.StatusCode == http.StatusOK {
+			t.put(key, requestResourcePath(req), cached, generation)
+		}
+		return cached, nil
+	})
+
+	// Each caller only waits for the shared response as long as its own context
+	// allows, without canceling it for the others.
+	var res singleflight.Result
+	select {
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	case res = <-ch:
+	}
+	if res.Err != nil {
+		return nil, res.Err
+	}
+	if res.Shared {
+		closeRequestBody(req)
+		log.Printf("[DEBUG] Request Cache: shared in-flight response for %s %s", req.Method, req.URL)
+	}
+	return res.Val.(*cachedResponse).response(req), nil
+}
+
+// detachedRequest returns req with a context that isn't canceled with the
+// context of req, as its response is shared with the requests coalesced with
+// it. The deadline of req, e.g. the timeout of the client, still applies.
+func detachedRequest(req *http.Request) (*http.Request, context.CancelFunc) {
+	ctx := context.WithoutCancel(req.Context())
+	if deadline, ok := req.Context().Deadline(); ok {
+		ctx, cancel := context.WithDeadline(ctx, deadline)
+		return req.WithContext(ctx), cancel
+	}
+	return req.WithContext(ctx), func() {}
+}
+
+// roundTripOperation sends a request polling a long-running operation, and
+// drops every cached response once the operation is done. Operations don't
+// identify their target consistently across APIs, so it can't be narrowed down.
+func (t *requestCacheTransport) roundTripOperation(req *http.Request) (*http.Response, error) {
+	resp, err := t.internal.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if isDoneOperation(body) {
+		invalidateRequestCaches("")
+	}
+	return resp, nil
+}
+
+// isDoneOperation returns whether body is a done operation, either as a
+// google.longrunning.Operation or as an operation of an API with its own
+// operation type, like Compute Engine or Cloud SQL.
+func isDoneOperation(body []byte) bool {
+	op := struct {
+		Done   bool   `json:"done"`
+		Status string `json:"status"`
+	}{}
+	if err := json.Unmarshal(body, &op); err != nil {
+		return false
+	}
+	return op.Done || op.Status == "DONE"
+}
+
+// closeRequestBody closes the body of a request answered without being sent,
+// as required of a http.RoundTripper.
+func closeRequestBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+func (t *requestCacheTransport) get(key string) *cachedResponse {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	entry, ok := t.entries[key]
+	if !ok {
+		return nil
+	}
+	if !t.clock.Now().Before(entry.expires) {
+		delete(t.entries, key)
+		return nil
+	}
+	return entry.response
+}
+
+// put caches a response unless the cache was invalidated since the request
+// was sent, in which case the response may already be stale.
+func (t *requestCacheTransport) put(key, resourcePath string, resp *cachedResponse, generation uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.generation != generation {
+		return
+	}
+	t.entries[key] = &requestCacheEntry{
+		resourcePath: resourcePath,
+		expires:      t.clock.Now().Add(t.ttl),
+		response:     resp,
+	}
+}
+
+// invalidate drops the entries for resourcePath, its parents and its
+// children, e.g. creating an instance drops the cached list of instances. An
+// empty resourcePath drops every entry.
+func (t *requestCacheTransport) invalidate(resourcePath string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.generation++
+	for key, entry := range t.entries {
+		if resourcePath == "" || isSameOrNestedPath(entry.resourcePath, resourcePath) {
+			delete(t.entries, key)
+		}
+	}
+}
+
+func invalidateRequestCaches(resourcePath string) {
+	requestCachesMu.Lock()
+	caches := make([]*requestCacheTransport, len(requestCaches))
+	copy(caches, requestCaches)
+	requestCachesMu.Unlock()
+
+	for _, c := range caches {
+		c.invalidate(resourcePath)
+	}
+}
+
+func isSameOrNestedPath(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
+
+// readOnlyCustomMethods are custom methods sent as POST that don't modify
+// the resource.
+var readOnlyCustomMethods = map[string]bool{
+	":getIamPolicy":       true,
+	":testIamPermissions": true,
+}
+
+func customMethod(req *http.Request) string {
+	path := req.URL.Path
+	lastSegment := path[strings.LastIndex(path, "/")+1:]
+	if idx := strings.LastIndex(lastSegment, ":"); idx >= 0 {
+		return lastSegment[idx:]
+	}
+	return ""
+}
+
+func isReadOnlyRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		return readOnlyCustomMethods[customMethod(req)]
+	}
+	return false
+}
+
+func isOperationPath(path string) bool {
+	return strings.Contains(path, "/operations/") || strings.HasSuffix(path, "/operations")
+}
+
+// isOperationRequest returns whether req polls a long-running operation.
+func isOperationRequest(req *http.Request) bool {
+	if !strings.Contains(req.URL.Path, "/operations/") {
+		return false
+	}
+	return req.Method == http.MethodGet || (req.Method == http.MethodPost && customMethod(req) == ":wait")
+}
+
+func isCacheableRequest(req *http.Request) bool {
+	if req.Method != http.MethodGet && !(req.Method == http.MethodPost && customMethod(req) == ":getIamPolicy") {
+		return false
+	}
+	if req.Header.Get("Range") != "" || req.URL.Query().Get("alt") == "media" {
+		return false
+	}
+	return !isOperationPath(req.URL.Path)
+}
+
+// requestResourcePath returns the host and path of the resource req is sent
+// for, without its custom method.
+func requestResourcePath(req *http.Request) string {
+	path := req.URL.Host + req.URL.Path
+	if m := customMethod(req); m != "" {
+		path = strings.TrimSuffix(path, m)
+	}
+	return strings.TrimSuffix(path, "/")
+}
+
+// requestCacheKey identifies a read request by its method, URL, billing
+// project and body.
+func requestCacheKey(req *http.Request) (string, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n", req.Method, req.URL.String(), req.Header.Get("X-Goog-User-Project"))
+
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return "", fmt.Errorf("request.GetBody is not defined for non-empty Body")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return "", err
+		}
+		defer body.Close()
+		if _, err := io.Copy(h, body); err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ExpandProviderRequestCache expands the provider `request_cache` block. It
+// returns nil if the block is unset.
+func ExpandProviderRequestCache(v interface{}) (*RequestCacheConfig, error) {
+	ls := v.([]interface{})
+	if len(ls) == 0 {
+		return nil, nil
+	}
+
+	cfg := &RequestCacheConfig{TTL: DefaultRequestCacheTTL}
+	// An empty block is expanded as nil.
+	if ls[0] == nil {
+		return cfg, nil
+	}
+
+	cfgV := ls[0].(map[string]interface{})
+	if ttl, ok := cfgV["ttl"].(string); ok && ttl != "" {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing request_cache.ttl: %s", err)
+		}
+		cfg.TTL = d
+	}
+	return cfg, nil
+}
@@ -0,0 +1,304 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// setUpRequestCacheServerClient returns a client caching responses of a test
+// server that counts the requests it receives per method and path. Every
+// response body contains the number of requests received so far.
+func setUpRequestCacheServerClient(handlerDelay time.Duration) (*httptest.Server, *http.Client, *requestCacheTransport, *int32) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&hits, 1)
+		time.Sleep(handlerDelay)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(strings.Repeat("x", int(n))))
+	}))
+
+	ct := NewTransportWithRequestCache(http.DefaultTransport, &RequestCacheConfig{TTL: time.Minute})
+	client := ts.Client()
+	client.Transport = ct
+	return ts, client, ct, &hits
+}
+
+func doTestRequest(t *testing.T, client *http.Client, method, url, userProject string) string {
+	req, err := http.NewRequest(method, url, strings.NewReader(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if userProject != "" {
+		req.Header.Set("X-Goog-User-Project", userProject)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestRequestCacheTransport_ReusesResponses(t *testing.T) {
+	ts, client, _, hits := setUpRequestCacheServerClient(0)
+	defer ts.Close()
+
+	network := ts.URL + "/compute/v1/projects/p/global/networks/n"
+	first := doTestRequest(t, client, "GET", network, "")
+	second := doTestRequest(t, client, "GET", network, "")
+
+	if *hits != 1 {
+		t.Errorf("expected 1 request to reach the server, got %d", *hits)
+	}
+	if first != second {
+		t.Errorf("expected the cached body %q, got %q", first, second)
+	}
+
+	// A different billing project is cached separately.
+	doTestRequest(t, client, "GET", network, "other-project")
+	if *hits != 2 {
+		t.Errorf("expected a request with another user project to reach the server, got %d requests", *hits)
+	}
+}
+
+func TestRequestCacheTransport_CoalescesConcurrentRequests(t *testing.T) {
+	ts, client, _, hits := setUpRequestCacheServerClient(200 * time.Millisecond)
+	defer ts.Close()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			doTestRequest(t, client, "GET", ts.URL+"/v1/projects/p", "")
+		}()
+	}
+	wg.Wait()
+
+	if *hits != 1 {
+		t.Errorf("expected concurrent requests to share 1 request, got %d", *hits)
+	}
+}
+
+func TestRequestCacheTransport_MutationInvalidates(t *testing.T) {
+	ts, client, _, hits := setUpRequestCacheServerClient(0)
+	defer ts.Close()
+
+	instances := ts.URL + "/compute/v1/projects/p/zones/z/instances"
+	instance := instances + "/i"
+	other := ts.URL + "/compute/v1/projects/p/zones/z/disks/d"
+	doTestRequest(t, client, "GET", instances, "")
+	doTestRequest(t, client, "GET", instance, "")
+	doTestRequest(t, client, "GET", other, "")
+
+	doTestRequest(t, client, "POST", instance+"/setMetadata", "")
+	*hits = 0
+
+	doTestRequest(t, client, "GET", instances, "")
+	doTestRequest(t, client, "GET", instance, "")
+	doTestRequest(t, client, "GET", other, "")
+	if *hits != 2 {
+		t.Errorf("expected the instance and its parent list to be invalidated, got %d requests", *hits)
+	}
+}
+
+func TestRequestCacheTransport_ReadDuringMutation(t *testing.T) {
+	var hits int32
+	release := make(chan struct{})
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PUT" {
+			<-release
+		}
+		n := atomic.AddInt32(&hits, 1)
+		w.Write([]byte(strings.Repeat("x", int(n))))
+	}))
+	defer ts.Close()
+	client := ts.Client()
+	client.Transport = NewTransportWithRequestCache(http.DefaultTransport, &RequestCacheConfig{TTL: time.Minute})
+
+	bucket := ts.URL + "/storage/v1/b/bucket"
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		doTestRequest(t, client, "PUT", bucket, "")
+	}()
+
+	// Give the PUT time to be invalidating before the read is cached.
+	time.Sleep(50 * time.Millisecond)
+	during := doTestRequest(t, client, "GET", bucket, "")
+	close(release)
+	<-done
+
+	if after := doTestRequest(t, client, "GET", bucket, ""); after == during {
+		t.Errorf("expected the response read during the PUT not to be reused after it, got %q", after)
+	}
+}
+
+func TestRequestCacheTransport_OperationDoneInvalidates(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&hits, 1)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/operations/running"):
+			w.Write([]byte(`{"status":"RUNNING"}`))
+		case strings.HasSuffix(r.URL.Path, "/operations/compute"):
+			w.Write([]byte(`{"status":"DONE"}`))
+		case strings.HasSuffix(r.URL.Path, "/operations/longrunning:wait"):
+			w.Write([]byte(`{"name":"longrunning","done":true}`))
+		default:
+			w.Write([]byte(strings.Repeat("x", int(n))))
+		}
+	}))
+	defer ts.Close()
+	client := ts.Client()
+	client.Transport = NewTransportWithRequestCache(http.DefaultTransport, &RequestCacheConfig{TTL: time.Minute})
+
+	instance := ts.URL + "/compute/v1/projects/p/zones/z/instances/i"
+	cached := doTestRequest(t, client, "GET", instance, "")
+
+	doTestRequest(t, client, "GET", ts.URL+"/compute/v1/projects/p/zones/z/operations/running", "")
+	if got := doTestRequest(t, client, "GET", instance, ""); got != cached {
+		t.Errorf("expected a running operation not to invalidate the cache, got %q", got)
+	}
+
+	for _, op := range []struct {
+		method string
+		url    string
+	}{
+		{"GET", ts.URL + "/compute/v1/projects/p/zones/z/operations/compute"},
+		{"POST", ts.URL + "/v1/projects/p/locations/l/operations/longrunning:wait"},
+	} {
+		doTestRequest(t, client, op.method, op.url, "")
+		got := doTestRequest(t, client, "GET", instance, "")
+		if got == cached {
+			t.Errorf("expected the done operation %s to invalidate the cache", op.url)
+		}
+		cached = got
+	}
+}
+
+func TestRequestCacheTransport_SharedRequestOutlivesCanceledCaller(t *testing.T) {
+	ts, client, _, hits := setUpRequestCacheServerClient(200 * time.Millisecond)
+	defer ts.Close()
+
+	u := ts.URL + "/v1/projects/p"
+	ctx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error)
+	go func() {
+		req, _ := http.NewRequestWithContext(ctx, "GET", u, nil)
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+		leaderErr <- err
+	}()
+
+	// Let the first request start the fetch, then cancel it once the second
+	// one has joined.
+	time.Sleep(50 * time.Millisecond)
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+	if got := doTestRequest(t, client, "GET", u, ""); got != "x" {
+		t.Errorf("expected the shared response %q, got %q", "x", got)
+	}
+	if err := <-leaderErr; err == nil {
+		t.Errorf("expected the canceled request to fail")
+	}
+	if *hits != 1 {
+		t.Errorf("expected 1 request to reach the server, got %d", *hits)
+	}
+}
+
+func TestRequestCacheTransport_IamPolicies(t *testing.T) {
+	ts, client, _, hits := setUpRequestCacheServerClient(0)
+	defer ts.Close()
+
+	resource := ts.URL + "/v1/projects/p/topics/t"
+	doTestRequest(t, client, "POST", resource+":getIamPolicy", "")
+	doTestRequest(t, client, "POST", resource+":getIamPolicy", "")
+	if *hits != 1 {
+		t.Errorf("expected getIamPolicy to be cached, got %d requests", *hits)
+	}
+
+	doTestRequest(t, client, "POST", resource+":setIamPolicy", "")
+	doTestRequest(t, client, "POST", resource+":getIamPolicy", "")
+	if *hits != 3 {
+		t.Errorf("expected setIamPolicy to invalidate the cached policy, got %d requests", *hits)
+	}
+}
+
+func TestRequestCacheTransport_NotCached(t *testing.T) {
+	ts, client, _, hits := setUpRequestCacheServerClient(0)
+	defer ts.Close()
+
+	for _, u := range []string{
+		ts.URL + "/compute/v1/projects/p/global/operations/op",
+		ts.URL + "/storage/v1/b/bucket/o/object?alt=media",
+	} {
+		*hits = 0
+		doTestRequest(t, client, "GET", u, "")
+		doTestRequest(t, client, "GET", u, "")
+		if *hits != 2 {
+			t.Errorf("expected %s not to be cached, got %d requests", u, *hits)
+		}
+	}
+}
+
+func TestRequestCacheTransport_Expires(t *testing.T) {
+	ts, client, ct, hits := setUpRequestCacheServerClient(0)
+	defer ts.Close()
+	clock := &fakeRateLimitClock{now: time.Unix(0, 0)}
+	ct.clock = clock
+
+	u := ts.URL + "/v1/projects/p"
+	doTestRequest(t, client, "GET", u, "")
+	clock.now = clock.now.Add(59 * time.Second)
+	doTestRequest(t, client, "GET", u, "")
+	if *hits != 1 {
+		t.Errorf("expected the response to be cached within the ttl, got %d requests", *hits)
+	}
+	clock.now = clock.now.Add(time.Second)
+	doTestRequest(t, client, "GET", u, "")
+	if *hits != 2 {
+		t.Errorf("expected the response to expire after the ttl, got %d requests", *hits)
+	}
+}
+
+func TestExpandProviderRequestCache(t *testing.T) {
+	cfg, err := ExpandProviderRequestCache([]interface{}{})
+	if err != nil || cfg != nil {
+		t.Fatalf("expected no cache for an unset block, got %#v, %v", cfg, err)
+	}
+
+	cfg, err = ExpandProviderRequestCache([]interface{}{nil})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.TTL != DefaultRequestCacheTTL {
+		t.Errorf("expected an empty block to use the default ttl, got %s", cfg.TTL)
+	}
+
+	cfg, err = ExpandProviderRequestCache([]interface{}{map[string]interface{}{"ttl": "2m"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.TTL != 2*time.Minute {
+		t.Errorf("expected a ttl of 2m, got %s", cfg.TTL)
+	}
+}
@@ -0,0 +1,504 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+// Opt-in recording of the provider's API traffic to go-vcr cassettes, and
+// replay of recorded traffic without access to Google Cloud, configured with
+// the provider `recording` block:
+//
+//	provider "google" {
+//	  recording {
+//	    path = "/tmp/google-recording"
+//	    mode = "record"
+//	  }
+//	}
+//
+// In record mode, requests are sent as usual and each provider process
+// writes the requests it made and their responses to its own cassette in the
+// path directory, numbered in the order the processes started recording. The
+// cassette is saved periodically and when the provider exits.
+// Credentials and secrets are redacted from recorded requests and responses.
+//
+// In replay mode, no request leaves the provider: each request is answered
+// with the response recorded for the same method, URL and body. Since every
+// Terraform command runs new provider processes, a replaying process does not
+// know which recorded process it stands for. It considers every cassette,
+// narrows them down to those with a recorded interaction for each request it
+// makes, and answers from the earliest one left.
+
+package transport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dnaeon/go-vcr/cassette"
+	"golang.org/x/oauth2"
+)
+
+const (
+	RecordingModeRecord = "record"
+	RecordingModeReplay = "replay"
+)
+
+var RecordingModes = []string{RecordingModeRecord, RecordingModeReplay}
+
+// recordingRedacted replaces credentials and secrets in recorded
+// interactions.
+const recordingRedacted = "REDACTED"
+
+// RecordingReplayPollInterval is the interval operations are polled at when
+// replaying, as there is no point in waiting for recorded operations.
+const RecordingReplayPollInterval = 10 * time.Millisecond
+
+type RecordingConfig struct {
+	Path string
+	Mode string
+}
+
+// RecordingReplayTokenSource returns the token source used when replaying.
+// Replayed requests are never sent, so its token isn't a credential.
+func RecordingReplayTokenSource() oauth2.TokenSource {
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: recordingRedacted})
+}
+
+// IsReplay reports whether requests are answered from recorded interactions
+// rather than sent to Google Cloud.
+func (cfg *RecordingConfig) IsReplay() bool {
+	return cfg != nil && cfg.Mode == RecordingModeReplay
+}
+
+// RequestRecorder records API calls to a cassette, or replays them from the
+// cassettes recorded to a directory.
+type RequestRecorder struct {
+	path string
+	mode string
+
+	mu sync.Mutex
+	// cassette is the cassette requests are recorded to. It is created on
+	// the first recorded request.
+	cassette *cassette.Cassette
+	// dirty is set when interactions were recorded since the cassette was
+	// last saved
+	dirty bool
+	// candidates are the cassettes requests may be replayed from, in the
+	// order they were recorded.
+	candidates []*cassette.Cassette
+}
+
+type requestRecorderKey struct {
+	path string
+	mode string
+}
+
+var (
+	requestRecordersMu sync.Mutex
+	requestRecorders   = map[requestRecorderKey]*RequestRecorder{}
+)
+
+// requestRecordingSaveInterval is how often a changed cassette is saved
+const requestRecordingSaveInterval = 10 * time.Second
+
+// GetRequestRecorder returns the recorder for cfg. Recorders are shared
+// within the provider process, so the SDK and plugin framework clients
+// record to, or replay from, the same cassettes.
+func GetRequestRecorder(cfg *RecordingConfig) (*RequestRecorder, error) {
+	requestRecordersMu.Lock()
+	defer requestRecordersMu.Unlock()
+
+	key := requestRecorderKey{path: cfg.Path, mode: cfg.Mode}
+	if rr, ok := requestRecorders[key]; ok {
+		return rr, nil
+	}
+
+	rr := &RequestRecorder{
+		path: cfg.Path,
+		mode: cfg.Mode,
+	}
+	if cfg.IsReplay() {
+		candidates, err := loadRecordedCassettes(cfg.Path)
+		if err != nil {
+			return nil, err
+		}
+		rr.candidates = candidates
+	} else {
+		go func() {
+			for range time.Tick(requestRecordingSaveInterval) {
+				rr.Flush()
+			}
+		}()
+	}
+	requestRecorders[key] = rr
+	return rr, nil
+}
+
+// FlushRequestRecorders saves the cassettes of all recorders. It's called once
+// the provider server stops.
+func FlushRequestRecorders() {
+	requestRecordersMu.Lock()
+	defer requestRecordersMu.Unlock()
+
+	for _, rr := range requestRecorders {
+		rr.Flush()
+	}
+}
+
+// Flush saves the cassette of the recorder if interactions were recorded since
+// it was last saved.
+func (rr *RequestRecorder) Flush() {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	if !rr.dirty {
+		return
+	}
+	if err := rr.cassette.Save(); err != nil {
+		log.Printf("[WARN] Unable to record requests to %q: %s", rr.cassette.File, err)
+		return
+	}
+	rr.dirty = false
+}
+
+// loadRecordedCassettes loads the cassettes recorded to dir, in the order
+// they were recorded, skipping those without interactions.
+func loadRecordedCassettes(dir string) ([]*cassette.Cassette, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var cassettes []*cassette.Cassette
+	for _, f := range files {
+		c, err := cassette.Load(strings.TrimSuffix(f, ".yaml"))
+		if err != nil {
+			return nil, fmt.Errorf("Error loading recorded requests from %q: %s", f, err)
+		}
+		if len(c.Interactions) == 0 {
+			continue
+		}
+		c.Matcher = matchRecordedRequest
+		cassettes = append(cassettes, c)
+	}
+	if len(cassettes) == 0 {
+		return nil, fmt.Errorf("no recorded requests found in %q", dir)
+	}
+	return cassettes, nil
+}
+
+// Transport returns a transport recording each request sent through t, or
+// answering it from recorded interactions in replay mode.
+func (rr *RequestRecorder) Transport(t http.RoundTripper) http.RoundTripper {
+	return &requestRecordingTransport{recorder: rr, internal: t}
+}
+
+type requestRecordingTransport struct {
+	recorder *RequestRecorder
+	internal http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface method.
+func (t *requestRecordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.recorder.mode == RecordingModeReplay {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return t.recorder.replay(req, body)
+	}
+
+	resp, err := t.internal.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	t.recorder.record(&cassette.Interaction{
+		Request: cassette.Request{
+			Body:    sanitizeRecordedBody(body),
+			Headers: sanitizeRecordedHeaders(req.Header),
+			URL:     sanitizeRecordedURL(req.URL),
+			Method:  req.Method,
+		},
+		Response: cassette.Response{
+			Body:    sanitizeRecordedBody(respBody),
+			Headers: sanitizeRecordedHeaders(resp.Header),
+			Status:  resp.Status,
+			Code:    resp.StatusCode,
+		},
+	})
+	return resp, nil
+}
+
+// readRequestBody returns the body of req, leaving req.Body readable.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func (rr *RequestRecorder) record(i *cassette.Interaction) {
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	if rr.cassette == nil {
+		c, err := newRecordingCassette(rr.path)
+		if err != nil {
+			log.Printf("[WARN] Unable to record requests to %q: %s", rr.path, err)
+			return
+		}
+		rr.cassette = c
+	}
+
+	rr.cassette.AddInteraction(i)
+	rr.dirty = true
+}
+
+// newRecordingCassette reserves the next numbered cassette in dir.
+func newRecordingCassette(dir string) (*cassette.Cassette, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	for n := 1; ; n++ {
+		name := filepath.Join(dir, fmt.Sprintf("%03d", n))
+		f, err := os.OpenFile(name+".yaml", os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+		if errors.Is(err, os.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		f.Close()
+		return cassette.New(name), nil
+	}
+}
+
+type recordedBodyKey struct{}
+
+func (rr *RequestRecorder) replay(req *http.Request, body []byte) (*http.Response, error) {
+	req = req.WithContext(context.WithValue(req.Context(), recordedBodyKey{}, sanitizeRecordedBody(body)))
+
+	rr.mu.Lock()
+	defer rr.mu.Unlock()
+
+	var interaction *cassette.Interaction
+	var remaining []*cassette.Cassette
+	for _, c := range rr.candidates {
+		i, err := c.GetInteraction(req)
+		if err != nil {
+			continue
+		}
+		if interaction == nil {
+			interaction = i
+		}
+		remaining = append(remaining, c)
+	}
+	if interaction == nil {
+		return nil, fmt.Errorf("no recorded request in %q matches %s %s", rr.path, req.Method, sanitizeRecordedURL(req.URL))
+	}
+	rr.candidates = remaining
+
+	return &http.Response{
+		Status:        interaction.Response.Status,
+		StatusCode:    interaction.Response.Code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Response.Headers.Clone(),
+		Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		ContentLength: int64(len(interaction.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// matchRecordedRequest matches requests by method, URL and body. JSON bodies
+// are compared by value, and multipart bodies, which hold random boundaries,
+// are not compared.
+func matchRecordedRequest(r *http.Request, i cassette.Request) bool {
+	if r.Method != i.Method || sanitizeRecordedURL(r.URL) != i.URL {
+		return false
+	}
+	body, _ := r.Context().Value(recordedBodyKey{}).(string)
+	if body == i.Body {
+		return true
+	}
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		return true
+	}
+
+	reqJson, err := decodeRecordedJson(body)
+	if err != nil {
+		return false
+	}
+	recordedJson, err := decodeRecordedJson(i.Body)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(reqJson, recordedJson)
+}
+
+func decodeRecordedJson(body string) (interface{}, error) {
+	d := json.NewDecoder(strings.NewReader(body))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+var recordingRedactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie", "X-Goog-Api-Key"}
+
+var recordingRedactedQueryParams = []string{"key", "access_token"}
+
+func sanitizeRecordedHeaders(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range recordingRedactedHeaders {
+		h.Del(k)
+	}
+	return h
+}
+
+func sanitizeRecordedURL(u *url.URL) string {
+	q := u.Query()
+	redacted := false
+	for _, k := range recordingRedactedQueryParams {
+		if q.Has(k) {
+			q.Set(k, recordingRedacted)
+			redacted = true
+		}
+	}
+	if !redacted {
+		return u.String()
+	}
+	cp := *u
+	cp.RawQuery = q.Encode()
+	return cp.String()
+}
+
+// sanitizeRecordedBody redacts the values of secret fields of a JSON body.
+// Other bodies are recorded as they are.
+func sanitizeRecordedBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	v, err := decodeRecordedJson(string(body))
+	if err != nil {
+		return string(body)
+	}
+	if !redactRecordedJson("", v) {
+		return string(body)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(b)
+}
+
+// redactRecordedJson redacts the string values of secret fields in v, the
+// value of the field named parent, reporting whether any were redacted.
+func redactRecordedJson(parent string, v interface{}) bool {
+	redacted := false
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, fv := range t {
+			if _, ok := fv.(string); ok && isRecordedSecretField(parent, k) {
+				t[k] = recordingRedacted
+				redacted = true
+				continue
+			}
+			if redactRecordedJson(k, fv) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, e := range t {
+			if redactRecordedJson(parent, e) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+// isRecordedSecretField reports whether the field k of the object in the
+// field parent holds a secret, such as a password, token or private key, or
+// the data of a Secret Manager secret payload.
+func isRecordedSecretField(parent, k string) bool {
+	lk := strings.ToLower(strings.ReplaceAll(k, "_", ""))
+	switch {
+	case strings.HasSuffix(lk, "pagetoken"):
+		return false
+	case strings.HasSuffix(lk, "password"),
+		strings.HasSuffix(lk, "secret"),
+		strings.HasSuffix(lk, "token"),
+		strings.HasPrefix(lk, "privatekey"):
+		return true
+	}
+	return parent == "payload" && lk == "data"
+}
+
+// ExpandProviderRecording returns the recording configuration for the
+// provider `recording` block, or nil if it is unset.
+func ExpandProviderRecording(v interface{}) (*RecordingConfig, error) {
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return nil, nil
+	}
+
+	cfgV := ls[0].(map[string]interface{})
+	cfg := &RecordingConfig{
+		Path: cfgV["path"].(string),
+		Mode: RecordingModeRecord,
+	}
+	if mode, ok := cfgV["mode"].(string); ok && mode != "" {
+		cfg.Mode = mode
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate checks that the recording configuration is usable.
+func (cfg *RecordingConfig) Validate() error {
+	if cfg.Path == "" {
+		return fmt.Errorf("recording.path must be set")
+	}
+	for _, m := range RecordingModes {
+		if cfg.Mode == m {
+			return nil
+		}
+	}
+	return fmt.Errorf("recording.mode must be one of %v, got %q", RecordingModes, cfg.Mode)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// testRecordingServer serves a single "thing", which doesn't exist until
+// it's created.
+func testRecordingServer() *httptest.Server {
+	var mu sync.Mutex
+	created := false
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch {
+		case r.Method == http.MethodPost:
+			created = true
+			w.Write([]byte(`{"name": "thing", "password": "hunter2"}`))
+		case created:
+			w.Write([]byte(`{"name": "thing"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": 404}}`))
+		}
+	}))
+}
+
+// testRecordingClient returns a client recording to, or replaying from, dir
+// as a new provider process would, and its recorder.
+func testRecordingClient(t *testing.T, dir, mode string) (*http.Client, *RequestRecorder) {
+	rr := &RequestRecorder{path: dir, mode: mode}
+	if mode == RecordingModeReplay {
+		candidates, err := loadRecordedCassettes(dir)
+		if err != nil {
+			t.Fatalf("unexpected error loading recorded requests: %v", err)
+		}
+		rr.candidates = candidates
+	}
+	return &http.Client{Transport: rr.Transport(http.DefaultTransport)}, rr
+}
+
+func testRecordingRequest(t *testing.T, client *http.Client, method, url, body string) (int, string) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set("Authorization", "Bearer ya29.secret")
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error sending %s %s: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error reading response: %v", err)
+	}
+	return resp.StatusCode, string(b)
+}
+
+func TestRequestRecorder_RecordAndReplay(t *testing.T) {
+	ts := testRecordingServer()
+	dir := filepath.Join(t.TempDir(), "recording")
+	thingUrl := ts.URL + "/v1/things/thing?alt=json&key=my-api-key"
+
+	// Record a plan, which finds nothing, then an apply creating the thing,
+	// each in its own process.
+	plan, planRecorder := testRecordingClient(t, dir, RecordingModeRecord)
+	if code, _ := testRecordingRequest(t, plan, http.MethodGet, thingUrl, ""); code != http.StatusNotFound {
+		t.Fatalf("expected the thing not to exist yet, got %d", code)
+	}
+	apply, applyRecorder := testRecordingClient(t, dir, RecordingModeRecord)
+	if code, body := testRecordingRequest(t, apply, http.MethodPost, ts.URL+"/v1/things", `{"name": "thing", "password": "hunter2"}`); code != http.StatusOK || !strings.Contains(body, "hunter2") {
+		t.Fatalf("expected the recorded response to be returned as it was sent, got %d %s", code, body)
+	}
+	testRecordingRequest(t, apply, http.MethodGet, thingUrl, "")
+	ts.Close()
+
+	// Cassettes are only written once the recorders are flushed.
+	files, _ := filepath.Glob(filepath.Join(dir, "*.yaml"))
+	for _, f := range files {
+		if b, _ := os.ReadFile(f); len(b) != 0 {
+			t.Fatalf("expected %s to be empty before flushing, got:\n%s", f, b)
+		}
+	}
+	planRecorder.Flush()
+	applyRecorder.Flush()
+
+	files, _ = filepath.Glob(filepath.Join(dir, "*.yaml"))
+	if len(files) != 2 {
+		t.Fatalf("expected one cassette per process, got %v", files)
+	}
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		for _, secret := range []string{"hunter2", "ya29.secret", "my-api-key"} {
+			if strings.Contains(string(b), secret) {
+				t.Errorf("expected %q to be redacted from %s:\n%s", secret, f, b)
+			}
+		}
+	}
+
+	// Replay both with the server gone. Secrets in request bodies are
+	// redacted before they're matched.
+	plan, _ = testRecordingClient(t, dir, RecordingModeReplay)
+	if code, _ := testRecordingRequest(t, plan, http.MethodGet, thingUrl, ""); code != http.StatusNotFound {
+		t.Errorf("expected the replayed plan to find nothing, got %d", code)
+	}
+	apply, _ = testRecordingClient(t, dir, RecordingModeReplay)
+	if code, _ := testRecordingRequest(t, apply, http.MethodPost, ts.URL+"/v1/things", `{"password": "another", "name": "thing"}`); code != http.StatusOK {
+		t.Errorf("expected the replayed create to succeed, got %d", code)
+	}
+	if code, body := testRecordingRequest(t, apply, http.MethodGet, thingUrl, ""); code != http.StatusOK || !strings.Contains(body, `"thing"`) {
+		t.Errorf("expected the replayed apply to read the created thing, got %d %s", code, body)
+	}
+
+	req, _ := http.NewRequest(http.MethodDelete, thingUrl, nil)
+	if _, err := apply.Do(req); err == nil {
+		t.Errorf("expected an error for a request that wasn't recorded")
+	}
+}
+
+func TestSanitizeRecordedBody(t *testing.T) {
+	cases := map[string]struct {
+		body string
+		want string
+	}{
+		"empty": {
+			body: "",
+			want: "",
+		},
+		"not json": {
+			body: "password=hunter2",
+			want: "password=hunter2",
+		},
+		"nothing to redact": {
+			body: `{"name": "thing", "nextPageToken": "abc"}`,
+			want: `{"name": "thing", "nextPageToken": "abc"}`,
+		},
+		"secrets": {
+			body: `{"rootPassword": "a", "client_secret": "b", "access_token": "c", "privateKeyData": "d", "size": 10000000000000000001}`,
+			want: `{"access_token":"REDACTED","client_secret":"REDACTED","privateKeyData":"REDACTED","rootPassword":"REDACTED","size":10000000000000000001}`,
+		},
+		"nested secrets": {
+			body: `{"users": [{"name": "a", "password": "b"}], "payload": {"data": "c2VjcmV0"}, "data": "kept"}`,
+			want: `{"data":"kept","payload":{"data":"REDACTED"},"users":[{"name":"a","password":"REDACTED"}]}`,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			if got := sanitizeRecordedBody([]byte(tc.body)); got != tc.want {
+				t.Errorf("want %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestExpandProviderRecording(t *testing.T) {
+	cfg, err := ExpandProviderRecording([]interface{}{})
+	if err != nil || cfg != nil {
+		t.Fatalf("expected no config for an unset block, got %#v, %v", cfg, err)
+	}
+
+	cfg, err = ExpandProviderRecording([]interface{}{
+		map[string]interface{}{"path": "/tmp/recording", "mode": ""},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Mode != RecordingModeRecord {
+		t.Errorf("expected mode to default to %q, got %q", RecordingModeRecord, cfg.Mode)
+	}
+
+	if _, err := ExpandProviderRecording([]interface{}{
+		map[string]interface{}{"path": "/tmp/recording", "mode": "rewind"},
+	}); err == nil {
+		t.Errorf("expected an error for an unknown mode")
+	}
+}
@@ -0,0 +1,644 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+// Opt-in recording of every API call made by the provider, configured with
+// the provider `request_tracing` block:
+//
+//	provider "google" {
+//	  request_tracing {
+//	    path   = "/tmp/google-requests.jsonl"
+//	    format = "spans"
+//	  }
+//	}
+//
+// With the "spans" format, each request is appended to the file as one line
+// of OpenTelemetry (OTLP) JSON, as read by the OpenTelemetry Collector's
+// otlpjsonfile receiver. With the "summary" format, the file holds a JSON
+// report aggregated by service, method and URL template, rewritten
+// periodically and when the provider exits.
+//
+// Terraform doesn't send resource addresses to providers, so requests are
+// tied to the Terraform resource type and operation of the provider function
+// that sent them, e.g. google_compute_instance and create.
+//
+// A RequestTracer provides two transports: Transport wraps the outermost
+// transport of the client and records one span per request, and
+// AttemptTransport wraps a transport beneath the retry transport to count
+// attempts.
+
+package transport
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode"
+)
+
+const (
+	RequestTraceFormatSpans   = "spans"
+	RequestTraceFormatSummary = "summary"
+)
+
+var RequestTraceFormats = []string{RequestTraceFormatSpans, RequestTraceFormatSummary}
+
+type RequestTracingConfig struct {
+	Path   string
+	Format string
+}
+
+type requestSpan struct {
+	method       string
+	url          string
+	urlTemplate  string
+	service      string
+	resourceName string
+	operation    string
+	// terraformResourceType and terraformOperation identify the resource
+	// function that sent the request, if any.
+	terraformResourceType string
+	terraformOperation    string
+	status                int
+	err                   string
+	start                 time.Time
+	end                   time.Time
+	attempts              int32
+	// pollingTime is the time since the first poll of operation.
+	pollingTime time.Duration
+}
+
+type traceService struct {
+	service   string
+	match     *regexp.Regexp
+	prefixLen int
+}
+
+type requestSummaryKey struct {
+	ResourceType string
+	Service      string
+	Method       string
+	URLTemplate  string
+}
+
+type requestSummary struct {
+	ResourceType   string  `json:"resource_type,omitempty"`
+	Service        string  `json:"service"`
+	Method         string  `json:"method"`
+	URLTemplate    string  `json:"url_template"`
+	Count          int     `json:"count"`
+	ErrorCount     int     `json:"error_count"`
+	RetryCount     int     `json:"retry_count"`
+	TotalLatencyMs float64 `json:"total_latency_ms"`
+	MaxLatencyMs   float64 `json:"max_latency_ms"`
+}
+
+type operationTiming struct {
+	service string
+	first   time.Time
+	last    time.Time
+	polls   int
+}
+
+type operationSummary struct {
+	Service        string  `json:"service"`
+	Count          int     `json:"count"`
+	Polls          int     `json:"polls"`
+	TotalPollingMs float64 `json:"total_polling_ms"`
+	MaxPollingMs   float64 `json:"max_polling_ms"`
+}
+
+type requestTraceReport struct {
+	Requests   []*requestSummary   `json:"requests"`
+	Operations []*operationSummary `json:"operations"`
+}
+
+// RequestTracer records API calls to a local file.
+type RequestTracer struct {
+	path    string
+	format  string
+	traceID string
+
+	servicesOnce sync.Once
+	services     []*traceService
+
+	mu         sync.Mutex
+	file       *os.File
+	summaries  map[requestSummaryKey]*requestSummary
+	operations map[string]*operationTiming
+	// dirty is set when the summary has changed since it was last written
+	dirty bool
+}
+
+// requestTraceSummaryInterval is how often a changed summary is written
+const requestTraceSummaryInterval = 10 * time.Second
+
+var (
+	requestTracersMu sync.Mutex
+	requestTracers   = map[string]*RequestTracer{}
+)
+
+// GetRequestTracer returns the tracer writing to cfg.Path. Tracers are shared
+// within the provider process, so the SDK and plugin framework clients
+// record to the same file.
+func GetRequestTracer(cfg *RequestTracingConfig) *RequestTracer {
+	requestTracersMu.Lock()
+	defer requestTracersMu.Unlock()
+
+	if rt, ok := requestTracers[cfg.Path]; ok {
+		if rt.format != cfg.Format {
+			log.Printf("[WARN] Request tracing to %q is already recorded in the %q format, ignoring format %q", cfg.Path, rt.format, cfg.Format)
+		}
+		return rt
+	}
+
+	rt := &RequestTracer{
+		path:       cfg.Path,
+		format:     cfg.Format,
+		traceID:    randomHex(16),
+		summaries:  map[requestSummaryKey]*requestSummary{},
+		operations: map[string]*operationTiming{},
+	}
+	requestTracers[cfg.Path] = rt
+	if rt.format == RequestTraceFormatSummary {
+		go func() {
+			for range time.Tick(requestTraceSummaryInterval) {
+				rt.Flush()
+			}
+		}()
+	}
+	return rt
+}
+
+// FlushRequestTracers writes the summaries of all tracers. It's called once the
+// provider server stops.
+func FlushRequestTracers() {
+	requestTracersMu.Lock()
+	defer requestTracersMu.Unlock()
+
+	for _, rt := range requestTracers {
+		rt.Flush()
+	}
+}
+
+// Flush writes the summary of the tracer if it has changed since it was last
+// written. Spans are written as requests complete.
+func (rt *RequestTracer) Flush() {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if !rt.dirty {
+		return
+	}
+	if err := rt.writeSummary(); err != nil {
+		log.Printf("[WARN] Unable to write request trace to %q: %s", rt.path, err)
+		return
+	}
+	rt.dirty = false
+}
+
+type requestSpanKey struct{}
+
+// Transport returns a transport recording a span for each request sent
+// through t.
+func (rt *RequestTracer) Transport(t http.RoundTripper) http.RoundTripper {
+	return &requestTraceTransport{tracer: rt, internal: t}
+}
+
+// AttemptTransport returns a transport counting each attempt sent through t
+// against the span of the request. It must be beneath the retry transport.
+func (rt *RequestTracer) AttemptTransport(t http.RoundTripper) http.RoundTripper {
+	return &requestAttemptTransport{internal: t}
+}
+
+type requestTraceTransport struct {
+	tracer   *RequestTracer
+	internal http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface method.
+func (t *requestTraceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	span := &requestSpan{
+		method: req.Method,
+		url:    req.URL.String(),
+		start:  time.Now(),
+	}
+	span.terraformResourceType, span.terraformOperation = callingTerraformResource()
+	resp, err := t.internal.RoundTrip(req.WithContext(context.WithValue(req.Context(), requestSpanKey{}, span)))
+	span.end = time.Now()
+	if err != nil {
+		span.err = err.Error()
+	} else {
+		span.status = resp.StatusCode
+	}
+	t.tracer.record(span)
+	return resp, err
+}
+
+type requestAttemptTransport struct {
+	internal http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface method.
+func (t *requestAttemptTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if span, ok := req.Context().Value(requestSpanKey{}).(*requestSpan); ok {
+		atomic.AddInt32(&span.attempts, 1)
+	}
+	return t.internal.RoundTrip(req)
+}
+
+func (rt *RequestTracer) record(span *requestSpan) {
+	u, err := url.Parse(span.url)
+	if err == nil {
+		span.resourceName = u.Host + u.Path
+		span.service, span.urlTemplate = rt.serviceAndTemplate(u)
+	}
+	if strings.Contains(span.urlTemplate, "/operations/") && span.method == http.MethodGet {
+		span.operation = span.resourceName
+	}
+
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if span.operation != "" {
+		op, ok := rt.operations[span.operation]
+		if !ok {
+			op = &operationTiming{service: span.service, first: span.start}
+			rt.operations[span.operation] = op
+		}
+		op.last = span.end
+		op.polls++
+		span.pollingTime = op.last.Sub(op.first)
+	}
+
+	switch rt.format {
+	case RequestTraceFormatSummary:
+		rt.addToSummary(span)
+		rt.dirty = true
+	default:
+		if err := rt.appendSpan(span); err != nil {
+			log.Printf("[WARN] Unable to write request trace to %q: %s", rt.path, err)
+		}
+	}
+}
+
+var terraformResourceFuncRegex = regexp.MustCompile(`/services/\w+\.(resource|dataSource)(\w+?)(Create|Read|Update|Delete|Import|ImportState|StateImporter)$`)
+
+var terraformOperations = map[string]string{
+	"Create":        "create",
+	"Read":          "read",
+	"Update":        "update",
+	"Delete":        "delete",
+	"Import":        "import",
+	"ImportState":   "import",
+	"StateImporter": "import",
+}
+
+// callingTerraformResource returns the resource type and operation of the
+// innermost resource or data source function on the stack.
+func callingTerraformResource() (string, string) {
+	pcs := make([]uintptr, 64)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if resourceType, operation := terraformResourceForFunction(frame.Function); resourceType != "" {
+			return resourceType, operation
+		}
+		if !more {
+			return "", ""
+		}
+	}
+}
+
+// terraformResourceForFunction returns the resource type and operation of a
+// function of the services packages, following their naming, e.g.
+// "google_compute_instance" and "create" for resourceComputeInstanceCreate. Data
+// source types are prefixed with "data.".
+func terraformResourceForFunction(name string) (string, string) {
+	m := terraformResourceFuncRegex.FindStringSubmatch(name)
+	if m == nil {
+		return "", ""
+	}
+	resourceType := camelToSnake(m[2])
+	if !strings.HasPrefix(resourceType, "google_") {
+		resourceType = "google_" + resourceType
+	}
+	if m[1] == "dataSource" {
+		resourceType = "data." + resourceType
+	}
+	return resourceType, terraformOperations[m[3]]
+}
+
+func camelToSnake(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+func (rt *RequestTracer) addToSummary(span *requestSpan) {
+	key := requestSummaryKey{ResourceType: span.terraformResourceType, Service: span.service, Method: span.method, URLTemplate: span.urlTemplate}
+	s, ok := rt.summaries[key]
+	if !ok {
+		s = &requestSummary{ResourceType: span.terraformResourceType, Service: span.service, Method: span.method, URLTemplate: span.urlTemplate}
+		rt.summaries[key] = s
+	}
+
+	latency := durationMs(span.end.Sub(span.start))
+	s.Count++
+	if span.err != "" || span.status >= 400 {
+		s.ErrorCount++
+	}
+	if span.attempts > 1 {
+		s.RetryCount += int(span.attempts - 1)
+	}
+	s.TotalLatencyMs += latency
+	if latency > s.MaxLatencyMs {
+		s.MaxLatencyMs = latency
+	}
+}
+
+func (rt *RequestTracer) writeSummary() error {
+	report := requestTraceReport{
+		Requests:   []*requestSummary{},
+		Operations: []*operationSummary{},
+	}
+	for _, s := range rt.summaries {
+		report.Requests = append(report.Requests, s)
+	}
+	sort.Slice(report.Requests, func(i, j int) bool {
+		return report.Requests[i].TotalLatencyMs > report.Requests[j].TotalLatencyMs
+	})
+
+	ops := map[string]*operationSummary{}
+	for _, op := range rt.operations {
+		s, ok := ops[op.service]
+		if !ok {
+			s = &operationSummary{Service: op.service}
+			ops[op.service] = s
+			report.Operations = append(report.Operations, s)
+		}
+		polling := durationMs(op.last.Sub(op.first))
+		s.Count++
+		s.Polls += op.polls
+		s.TotalPollingMs += polling
+		if polling > s.MaxPollingMs {
+			s.MaxPollingMs = polling
+		}
+	}
+	sort.Slice(report.Operations, func(i, j int) bool {
+		return report.Operations[i].TotalPollingMs > report.Operations[j].TotalPollingMs
+	})
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so the report is never left truncated.
+	tmp, err := os.CreateTemp(filepath.Dir(rt.path), filepath.Base(rt.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), rt.path)
+}
+
+func (rt *RequestTracer) appendSpan(span *requestSpan) error {
+	if rt.file == nil {
+		f, err := os.OpenFile(rt.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			return err
+		}
+		rt.file = f
+	}
+
+	b, err := json.Marshal(rt.otlpSpan(span))
+	if err != nil {
+		return err
+	}
+	_, err = rt.file.Write(append(b, '\n'))
+	return err
+}
+
+// otlpSpan returns span as an OTLP ExportTraceServiceRequest, using the
+// OpenTelemetry HTTP semantic conventions where they apply.
+func (rt *RequestTracer) otlpSpan(span *requestSpan) map[string]interface{} {
+	attributes := []map[string]interface{}{
+		otlpStringAttribute("http.request.method", span.method),
+		otlpStringAttribute("url.full", span.url),
+		otlpStringAttribute("url.template", span.urlTemplate),
+		otlpStringAttribute("gcp.service", span.service),
+		otlpStringAttribute("gcp.resource_name", span.resourceName),
+		otlpIntAttribute("http.request.resend_count", int64(maxInt32(span.attempts-1, 0))),
+	}
+	if span.status != 0 {
+		attributes = append(attributes, otlpIntAttribute("http.response.status_code", int64(span.status)))
+	}
+	if span.terraformResourceType != "" {
+		attributes = append(attributes,
+			otlpStringAttribute("terraform.resource.type", span.terraformResourceType),
+			otlpStringAttribute("terraform.operation", span.terraformOperation),
+		)
+	}
+	if span.operation != "" {
+		attributes = append(attributes,
+			otlpStringAttribute("gcp.operation.name", span.operation),
+			otlpIntAttribute("gcp.operation.polling_time_ms", span.pollingTime.Milliseconds()),
+		)
+	}
+
+	// STATUS_CODE_UNSET is 0, STATUS_CODE_ERROR is 2.
+	status := map[string]interface{}{"code": 0}
+	if span.err != "" {
+		status = map[string]interface{}{"code": 2, "message": span.err}
+	} else if span.status >= 400 {
+		status = map[string]interface{}{"code": 2, "message": http.StatusText(span.status)}
+	}
+
+	return map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": []interface{}{
+						otlpStringAttribute("service.name", "terraform-provider-google-beta"),
+					},
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"},
+						"spans": []interface{}{
+							map[string]interface{}{
+								"traceId": rt.traceID,
+								"spanId":  randomHex(8),
+								"name":    fmt.Sprintf("%s %s", span.method, span.urlTemplate),
+								// SPAN_KIND_CLIENT
+								"kind":              3,
+								"startTimeUnixNano": fmt.Sprintf("%d", span.start.UnixNano()),
+								"endTimeUnixNano":   fmt.Sprintf("%d", span.end.UnixNano()),
+								"attributes":        attributes,
+								"status":            status,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// serviceAndTemplate returns the base path key of the service u belongs to,
+// or its host if it doesn't match a known service, and the URL template of u
+// with resource IDs replaced by "*".
+func (rt *RequestTracer) serviceAndTemplate(u *url.URL) (string, string) {
+	rt.servicesOnce.Do(func() {
+		for service, basePath := range DefaultBasePaths {
+			rt.services = append(rt.services, &traceService{
+				service:   service,
+				match:     basePathMatcher(basePath),
+				prefixLen: len(basePath),
+			})
+		}
+		sort.Slice(rt.services, func(i, j int) bool {
+			return rt.services[i].prefixLen > rt.services[j].prefixLen
+		})
+	})
+
+	s := u.Scheme + "://" + u.Host + u.Path
+	for _, service := range rt.services {
+		if loc := service.match.FindStringIndex(s); loc != nil {
+			return service.service, templateResourcePath(s[:loc[1]], s[loc[1]:])
+		}
+	}
+	// Unknown services, e.g. custom endpoints, are templated after the API
+	// version segment.
+	return u.Host, templateResourcePath(u.Scheme+"://"+u.Host+"/", strings.TrimPrefix(u.Path, "/"))
+}
+
+var apiVersionRegex = regexp.MustCompile(`^(v\d+((alpha|beta)\d*)?|alpha|beta)$`)
+
+// templateResourcePath replaces the IDs in path, relative to basePath, with
+// "*". Resource paths alternate collections and IDs after the API version,
+// e.g. "projects/p/global/networks/n:patch" becomes
+// "projects/*/global/networks/*:patch".
+func templateResourcePath(basePath, path string) string {
+	segments := strings.Split(path, "/")
+	start := 0
+	for i, segment := range segments {
+		if apiVersionRegex.MatchString(segment) {
+			start = i + 1
+			break
+		}
+	}
+
+	isID := false
+	for i := start; i < len(segments); i++ {
+		if !isID {
+			// Scope segments like "global" aren't followed by an ID.
+			isID = !resourcePathScopes[segments[i]]
+			continue
+		}
+		isID = false
+		if segments[i] == "" {
+			continue
+		}
+		suffix := ""
+		if idx := strings.LastIndex(segments[i], ":"); idx >= 0 {
+			suffix = segments[i][idx:]
+		}
+		segments[i] = "*" + suffix
+	}
+	return basePath + strings.Join(segments, "/")
+}
+
+var resourcePathScopes = map[string]bool{
+	"global":     true,
+	"aggregated": true,
+}
+
+func otlpStringAttribute(key, value string) map[string]interface{} {
+	return map[string]interface{}{"key": key, "value": map[string]interface{}{"stringValue": value}}
+}
+
+func otlpIntAttribute(key string, value int64) map[string]interface{} {
+	// OTLP JSON encodes 64 bit integers as strings.
+	return map[string]interface{}{"key": key, "value": map[string]interface{}{"intValue": fmt.Sprintf("%d", value)}}
+}
+
+func durationMs(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func maxInt32(a, b int32) int32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return strings.Repeat("0", 2*n)
+	}
+	return hex.EncodeToString(b)
+}
+
+// ExpandProviderRequestTracing expands the provider `request_tracing` block.
+// It returns nil if the block is unset.
+func ExpandProviderRequestTracing(v interface{}) (*RequestTracingConfig, error) {
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return nil, nil
+	}
+
+	cfgV := ls[0].(map[string]interface{})
+	cfg := &RequestTracingConfig{
+		Path:   cfgV["path"].(string),
+		Format: RequestTraceFormatSpans,
+	}
+	if format, ok := cfgV["format"].(string); ok && format != "" {
+		cfg.Format = format
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Validate checks that the request tracing configuration is usable.
+func (cfg *RequestTracingConfig) Validate() error {
+	if cfg.Path == "" {
+		return fmt.Errorf("request_tracing.path must be set")
+	}
+	for _, f := range RequestTraceFormats {
+		if cfg.Format == f {
+			return nil
+		}
+	}
+	return fmt.Errorf("request_tracing.format must be one of %v, got %q", RequestTraceFormats, cfg.Format)
+}
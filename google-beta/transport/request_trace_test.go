@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// setUpRequestTraceClient returns a client sending requests to handler
+// through the same chain of transports as Config.LoadAndValidate, traced in
+// the given format.
+func setUpRequestTraceClient(t *testing.T, handler http.Handler, format string) (*httptest.Server, *http.Client, string) {
+	ts := httptest.NewServer(handler)
+	path := filepath.Join(t.TempDir(), "trace.json")
+
+	tracer := GetRequestTracer(&RequestTracingConfig{Path: path, Format: format})
+	retryTransport := NewTransportWithDefaultRetries(tracer.AttemptTransport(http.DefaultTransport), &RetryPolicy{InitialBackoff: time.Millisecond})
+
+	client := ts.Client()
+	client.Transport = tracer.Transport(NewTransportWithHeaders(retryTransport))
+	return ts, client, path
+}
+
+func TestRequestTracer_Spans(t *testing.T) {
+	var hits int32
+	ts, client, path := setUpRequestTraceClient(t, testRetryPolicyHandler(&hits, 1, http.StatusServiceUnavailable, `{"error": {"code": 503}}`), RequestTraceFormatSpans)
+	defer ts.Close()
+
+	resp, err := client.Get(ts.URL + "/compute/v1/projects/my-project/zones/us-central1-a/instances/my-instance?alt=json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("expected the trace file to be written: %v", err)
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if len(lines) != 1 {
+		t.Fatalf("expected 1 span, got %d", len(lines))
+	}
+
+	var export struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []struct {
+					TraceID    string `json:"traceId"`
+					Name       string `json:"name"`
+					Attributes []struct {
+						Key   string            `json:"key"`
+						Value map[string]string `json:"value"`
+					} `json:"attributes"`
+				} `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}
+	if err := json.Unmarshal([]byte(lines[0]), &export); err != nil {
+		t.Fatalf("expected an OTLP JSON span, got error: %v", err)
+	}
+	span := export.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if len(span.TraceID) != 32 {
+		t.Errorf("expected a 16 byte hex trace ID, got %q", span.TraceID)
+	}
+
+	attributes := map[string]string{}
+	for _, a := range span.Attributes {
+		for _, v := range a.Value {
+			attributes[a.Key] = v
+		}
+	}
+	expected := map[string]string{
+		"http.request.method":       "GET",
+		"http.response.status_code": "200",
+		"http.request.resend_count": "1",
+		"url.template":              ts.URL + "/compute/v1/projects/*/zones/*/instances/*",
+	}
+	for k, v := range expected {
+		if attributes[k] != v {
+			t.Errorf("expected attribute %s to be %q, got %q", k, v, attributes[k])
+		}
+	}
+}
+
+func TestRequestTracer_Summary(t *testing.T) {
+	var hits int32
+	ts, client, path := setUpRequestTraceClient(t, testRetryPolicyHandler(&hits, 0, http.StatusOK, ""), RequestTraceFormatSummary)
+	defer ts.Close()
+
+	for _, p := range []string{
+		"/compute/v1/projects/p/global/networks/a",
+		"/compute/v1/projects/p/global/networks/b",
+		"/compute/v1/projects/p/global/operations/op-1",
+		"/compute/v1/projects/p/global/operations/op-1",
+	} {
+		resp, err := client.Get(ts.URL + p)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	// The summary is written periodically and once the provider stops
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the summary not to be written for each request, got: %v", err)
+	}
+	FlushRequestTracers()
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("expected the summary to be written: %v", err)
+	}
+	var report requestTraceReport
+	if err := json.Unmarshal(b, &report); err != nil {
+		t.Fatalf("expected a JSON summary, got error: %v", err)
+	}
+
+	counts := map[string]int{}
+	for _, s := range report.Requests {
+		counts[s.URLTemplate] = s.Count
+	}
+	if got := counts[ts.URL+"/compute/v1/projects/*/global/networks/*"]; got != 2 {
+		t.Errorf("expected 2 network requests, got %d", got)
+	}
+	if len(report.Operations) != 1 || report.Operations[0].Count != 1 || report.Operations[0].Polls != 2 {
+		t.Errorf("expected 1 operation polled twice, got %+v", report.Operations)
+	}
+}
+
+func TestTerraformResourceForFunction(t *testing.T) {
+	cases := map[string]struct {
+		Function     string
+		ResourceType string
+		Operation    string
+	}{
+		"create": {
+			Function:     "github.com/hashicorp/terraform-provider-google-beta/google-beta/services/compute.resourceComputeInstanceCreate",
+			ResourceType: "google_compute_instance",
+			Operation:    "create",
+		},
+		"google prefix": {
+			Function:     "github.com/hashicorp/terraform-provider-google-beta/google-beta/services/resourcemanager.resourceGoogleProjectRead",
+			ResourceType: "google_project",
+			Operation:    "read",
+		},
+		"import": {
+			Function:     "github.com/hashicorp/terraform-provider-google-beta/google-beta/services/container.resourceContainerNodePoolStateImporter",
+			ResourceType: "google_container_node_pool",
+			Operation:    "import",
+		},
+		"data source": {
+			Function:     "github.com/hashicorp/terraform-provider-google-beta/google-beta/services/compute.dataSourceGoogleComputeNetworkRead",
+			ResourceType: "data.google_compute_network",
+			Operation:    "read",
+		},
+		"closure": {
+			Function: "github.com/hashicorp/terraform-provider-google-beta/google-beta/services/compute.resourceComputeInstanceCreate.func1",
+		},
+		"helper": {
+			Function: "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport.SendRequest",
+		},
+	}
+
+	for tn, tc := range cases {
+		resourceType, operation := terraformResourceForFunction(tc.Function)
+		if resourceType != tc.ResourceType || operation != tc.Operation {
+			t.Errorf("%s: expected (%q, %q), got (%q, %q)", tn, tc.ResourceType, tc.Operation, resourceType, operation)
+		}
+	}
+}
+
+func TestRequestTracer_ServiceAndTemplate(t *testing.T) {
+	rt := &RequestTracer{}
+	cases := map[string]struct {
+		URL              string
+		ExpectedService  string
+		ExpectedTemplate string
+	}{
+		"known service": {
+			URL:              "https://compute.googleapis.com/compute/beta/projects/p/zones/z/instances/i:start",
+			ExpectedService:  ComputeBasePathKey,
+			ExpectedTemplate: "https://compute.googleapis.com/compute/beta/projects/*/zones/*/instances/*:start",
+		},
+		"templated base path": {
+			URL:              "https://us-central1-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/p/services/s",
+			ExpectedService:  CloudRunBasePathKey,
+			ExpectedTemplate: "https://us-central1-run.googleapis.com/apis/serving.knative.dev/v1/namespaces/*/services/*",
+		},
+		"unknown service": {
+			URL:              "https://example.com/api/v2/projects/p/things/t",
+			ExpectedService:  "example.com",
+			ExpectedTemplate: "https://example.com/api/v2/projects/*/things/*",
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			u, err := url.Parse(tc.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			service, template := rt.serviceAndTemplate(u)
+			if service != tc.ExpectedService {
+				t.Errorf("expected service %q, got %q", tc.ExpectedService, service)
+			}
+			if template != tc.ExpectedTemplate {
+				t.Errorf("expected template %q, got %q", tc.ExpectedTemplate, template)
+			}
+		})
+	}
+}
+
+func TestExpandProviderRequestTracing(t *testing.T) {
+	cfg, err := ExpandProviderRequestTracing([]interface{}{})
+	if err != nil || cfg != nil {
+		t.Fatalf("expected no config for an unset block, got %#v, %v", cfg, err)
+	}
+
+	cfg, err = ExpandProviderRequestTracing([]interface{}{
+		map[string]interface{}{"path": "/tmp/trace.jsonl", "format": ""},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Format != RequestTraceFormatSpans {
+		t.Errorf("expected format to default to %q, got %q", RequestTraceFormatSpans, cfg.Format)
+	}
+
+	if _, err := ExpandProviderRequestTracing([]interface{}{
+		map[string]interface{}{"path": "/tmp/trace.jsonl", "format": "xml"},
+	}); err == nil {
+		t.Errorf("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/errwrap"
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	retryInfoType     = "type.googleapis.com/google.rpc.RetryInfo"
+	quotaFailureType  = "type.googleapis.com/google.rpc.QuotaFailure"
+	errorInfoType     = "type.googleapis.com/google.rpc.ErrorInfo"
+	rateLimitExceeded = "RATE_LIMIT_EXCEEDED"
+)
+
+// Per-minute quotas are enforced over a rolling window, so waiting a full
+// window guarantees the quota has refreshed.
+var quotaPerMinuteRetryDelay = 1 * time.Minute
+
+// RetryDelayFromError returns how long the API asked the client to wait before
+// retrying, based on the Retry-After header and the google.rpc.RetryInfo and
+// google.rpc.QuotaFailure error details of a Google API error. It returns 0
+// if the error carries no such hint, along with a description of the hint for
+// logging.
+func RetryDelayFromError(err error) (time.Duration, string) {
+	gerr, ok := errwrap.GetType(err, &googleapi.Error{}).(*googleapi.Error)
+	if !ok || gerr == nil {
+		return 0, ""
+	}
+
+	if d, ok := parseRetryAfter(gerr.Header); ok {
+		return d, fmt.Sprintf("Retry-After header of %s", d)
+	}
+
+	details := gerr.Details
+	if len(details) == 0 {
+		details = parseErrorDetails(gerr.Body)
+	}
+
+	var quotaViolations []string
+	perMinuteQuota := false
+	for _, detail := range details {
+		m, ok := detail.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch m["@type"] {
+		case retryInfoType:
+			if v, ok := m["retryDelay"].(string); ok {
+				if d, err := time.ParseDuration(v); err == nil && d > 0 {
+					return d, fmt.Sprintf("RetryInfo retryDelay of %s", d)
+				}
+			}
+		case quotaFailureType:
+			violations, _ := m["violations"].([]interface{})
+			for _, v := range violations {
+				violation, ok := v.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				subject, _ := violation["subject"].(string)
+				description, _ := violation["description"].(string)
+				quotaViolations = append(quotaViolations, strings.TrimSpace(fmt.Sprintf("%s %s", subject, description)))
+				if isPerMinuteQuota(description) {
+					perMinuteQuota = true
+				}
+			}
+		case errorInfoType:
+			if m["reason"] != rateLimitExceeded {
+				continue
+			}
+			metadata, _ := m["metadata"].(map[string]interface{})
+			if limit, ok := metadata["quota_limit"].(string); ok {
+				quotaViolations = append(quotaViolations, limit)
+				if isPerMinuteQuota(limit) {
+					perMinuteQuota = true
+				}
+			}
+		}
+	}
+
+	if perMinuteQuota {
+		return quotaPerMinuteRetryDelay, fmt.Sprintf("per-minute quota exceeded (%s)", strings.Join(quotaViolations, ", "))
+	}
+	return 0, ""
+}
+
+func isPerMinuteQuota(s string) bool {
+	s = strings.ToLower(s)
+	return strings.Contains(s, "per minute") || strings.Contains(s, "perminute")
+}
+
+// parseRetryAfter parses a Retry-After header given either in seconds or as
+// an HTTP date.
+func parseRetryAfter(header http.Header) (time.Duration, bool) {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(strings.TrimSpace(v)); err == nil {
+		if secs <= 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// parseErrorDetails extracts error.details from an error response body. The
+// body may be a full response dump, as produced by the retry transport, in
+// which case the headers are skipped.
+func parseErrorDetails(body string) []interface{} {
+	if i := strings.Index(body, "\r\n\r\n"); i >= 0 && !strings.HasPrefix(strings.TrimSpace(body), "{") {
+		body = body[i+4:]
+	}
+
+	var reply struct {
+		Error struct {
+			Details []interface{} `json:"details"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(body), &reply); err != nil {
+		return nil
+	}
+	return reply.Error.Details
+}
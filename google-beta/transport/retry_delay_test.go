@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+func TestRetryDelayFromError(t *testing.T) {
+	cases := map[string]struct {
+		Err      error
+		Expected time.Duration
+	}{
+		"non-API error has no delay": {
+			Err:      http.ErrHandlerTimeout,
+			Expected: 0,
+		},
+		"Retry-After in seconds": {
+			Err: &googleapi.Error{
+				Code:   429,
+				Header: http.Header{"Retry-After": []string{"7"}},
+			},
+			Expected: 7 * time.Second,
+		},
+		"RetryInfo in details": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+						"retryDelay": "1.5s",
+					},
+				},
+			},
+			Expected: 1500 * time.Millisecond,
+		},
+		"RetryInfo in a dumped response body": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Body: "HTTP/1.1 429 Too Many Requests\r\nContent-Type: application/json\r\n\r\n" +
+					`{"error": {"code": 429, "details": [{"@type": "type.googleapis.com/google.rpc.RetryInfo", "retryDelay": "3s"}]}}`,
+			},
+			Expected: 3 * time.Second,
+		},
+		"Retry-After takes precedence over RetryInfo": {
+			Err: &googleapi.Error{
+				Code:   429,
+				Header: http.Header{"Retry-After": []string{"2"}},
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":      "type.googleapis.com/google.rpc.RetryInfo",
+						"retryDelay": "30s",
+					},
+				},
+			},
+			Expected: 2 * time.Second,
+		},
+		"per-minute QuotaFailure waits for the quota window": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type": "type.googleapis.com/google.rpc.QuotaFailure",
+						"violations": []interface{}{
+							map[string]interface{}{
+								"subject":     "project:123",
+								"description": "Quota exceeded for quota metric 'Read requests' and limit 'Read requests per minute'",
+							},
+						},
+					},
+				},
+			},
+			Expected: quotaPerMinuteRetryDelay,
+		},
+		"per-minute rate limit ErrorInfo waits for the quota window": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type":  "type.googleapis.com/google.rpc.ErrorInfo",
+						"reason": "RATE_LIMIT_EXCEEDED",
+						"metadata": map[string]interface{}{
+							"quota_limit": "ReadRequestsPerMinutePerProject",
+						},
+					},
+				},
+			},
+			Expected: quotaPerMinuteRetryDelay,
+		},
+		"daily QuotaFailure has no delay": {
+			Err: &googleapi.Error{
+				Code: 429,
+				Details: []interface{}{
+					map[string]interface{}{
+						"@type": "type.googleapis.com/google.rpc.QuotaFailure",
+						"violations": []interface{}{
+							map[string]interface{}{
+								"description": "Quota exceeded for limit 'Requests per day'",
+							},
+						},
+					},
+				},
+			},
+			Expected: 0,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			if got, _ := RetryDelayFromError(tc.Err); got != tc.Expected {
+				t.Errorf("expected delay %s, got %s", tc.Expected, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_HonorsRetryInfo(t *testing.T) {
+	var hits int32
+	body := `{"error": {"code": 429, "details": [{"@type": "type.googleapis.com/google.rpc.RetryInfo", "retryDelay": "0.5s"}]}}`
+	ts := httptest.NewServer(testRetryPolicyHandler(&hits, 1, http.StatusTooManyRequests, body))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = NewTransportWithDefaultRetries(http.DefaultTransport, &RetryPolicy{
+		InitialBackoff:       time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusTooManyRequests},
+	})
+
+	start := time.Now()
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if elapsed := time.Since(start); elapsed < 500*time.Millisecond {
+		t.Errorf("expected the retry to wait for the requested delay of 0.5s, waited %s", elapsed)
+	}
+}
+
+func TestSendRequest_HonorsRetryAfter(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error": {"code": 503}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	}))
+	defer ts.Close()
+
+	config := &Config{Client: ts.Client()}
+	start := time.Now()
+	_, err := SendRequest(SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		RawURL:    ts.URL,
+		UserAgent: "test",
+		Timeout:   10 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hits != 2 {
+		t.Errorf("expected 2 requests, got %d", hits)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected the retry to wait for Retry-After of 1s, waited %s", elapsed)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"time"
+
+	"google.golang.org/api/googleapi"
+)
+
+const (
+	DefaultRetryInitialBackoff = 500 * time.Millisecond
+)
+
+// RetryPolicy holds the user-configurable retry settings set in the provider
+// `retry` block. A nil *RetryPolicy is valid and results in the provider's
+// default retry behavior.
+type RetryPolicy struct {
+	// MaxAttempts caps the number of attempts made for a single request,
+	// including the first one. Zero means attempts are only bounded by the
+	// request timeout.
+	MaxAttempts int
+	// InitialBackoff is the wait before the first retry. Later waits follow
+	// a Fibonacci sequence starting from this value.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts. Zero means uncapped.
+	MaxBackoff time.Duration
+	// Jitter randomizes each wait by up to this fraction of its value, in
+	// the range [0, 1].
+	Jitter float64
+	// RetryableStatusCodes are HTTP status codes retried in addition to
+	// the codes retried by default.
+	RetryableStatusCodes []int
+	// RetryableErrorReasons are Google API error reasons (e.g.
+	// "rateLimitExceeded") retried in addition to the default predicates.
+	RetryableErrorReasons []string
+}
+
+// ErrorRetryPredicates returns the additional retry predicates implied by the
+// policy's status code and error reason lists.
+func (p *RetryPolicy) ErrorRetryPredicates() []RetryErrorPredicateFunc {
+	if p == nil {
+		return nil
+	}
+
+	var predicates []RetryErrorPredicateFunc
+	if len(p.RetryableStatusCodes) > 0 {
+		predicates = append(predicates, isRetryableStatusCode(p.RetryableStatusCodes))
+	}
+	if len(p.RetryableErrorReasons) > 0 {
+		predicates = append(predicates, isRetryableErrorReason(p.RetryableErrorReasons))
+	}
+	return predicates
+}
+
+// attemptsExhausted reports whether no more attempts are allowed after
+// the given number of attempts have been made.
+func (p *RetryPolicy) attemptsExhausted(attempts int) bool {
+	return p != nil && p.MaxAttempts > 0 && attempts >= p.MaxAttempts
+}
+
+// newBackoff returns the backoff sequence to use for one request.
+func (p *RetryPolicy) newBackoff() *retryBackoff {
+	b := &retryBackoff{
+		next:     DefaultRetryInitialBackoff,
+		previous: DefaultRetryInitialBackoff,
+	}
+	if p == nil {
+		return b
+	}
+	if p.InitialBackoff > 0 {
+		b.next = p.InitialBackoff
+		b.previous = p.InitialBackoff
+	}
+	b.max = p.MaxBackoff
+	b.jitter = p.Jitter
+	return b
+}
+
+// retryBackoff produces a Fibonacci backoff sequence - 0.5, 1, 1.5, 2.5, 4,
+// 6.5, 10.5, ... for the default initial value - capped and jittered
+// according to a RetryPolicy.
+type retryBackoff struct {
+	next     time.Duration
+	previous time.Duration
+	max      time.Duration
+	jitter   float64
+}
+
+// Next returns the wait before the next attempt and advances the sequence.
+func (b *retryBackoff) Next() time.Duration {
+	wait := b.next
+	if b.max > 0 && wait > b.max {
+		wait = b.max
+	}
+
+	last := b.next
+	b.next = b.next + b.previous
+	b.previous = last
+	if b.max > 0 && b.next > b.max {
+		// Stop growing once capped so the sequence can't overflow.
+		b.next = b.max
+		b.previous = b.max
+	}
+
+	if b.jitter > 0 {
+		delta := time.Duration(b.jitter * float64(wait) * (2*rand.Float64() - 1))
+		wait += delta
+	}
+	return wait
+}
+
+func isRetryableStatusCode(codes []int) RetryErrorPredicateFunc {
+	return func(err error) (bool, string) {
+		gerr, ok := err.(*googleapi.Error)
+		if !ok {
+			return false, ""
+		}
+
+		for _, code := range codes {
+			if gerr.Code == code {
+				log.Printf("[DEBUG] Dismissed an error as retryable based on provider retry policy status code %d: %s", code, err)
+				return true, fmt.Sprintf("Retryable error code %d from provider retry policy", code)
+			}
+		}
+		return false, ""
+	}
+}
+
+func isRetryableErrorReason(reasons []string) RetryErrorPredicateFunc {
+	return func(err error) (bool, string) {
+		gerr, ok := err.(*googleapi.Error)
+		if !ok {
+			return false, ""
+		}
+
+		for _, reason := range reasons {
+			for _, item := range gerr.Errors {
+				if item.Reason == reason {
+					return true, fmt.Sprintf("Retryable error reason %q from provider retry policy", reason)
+				}
+			}
+			// Newer APIs report the reason in a google.rpc.ErrorInfo detail.
+			for _, detail := range gerr.Details {
+				m, ok := detail.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				if r, ok := m["reason"].(string); ok && r == reason {
+					return true, fmt.Sprintf("Retryable error reason %q from provider retry policy", reason)
+				}
+			}
+		}
+		return false, ""
+	}
+}
+
+// ExpandProviderRetryPolicy expands the SDK provider `retry` block. It
+// returns nil if the block is not set.
+func ExpandProviderRetryPolicy(v interface{}) (*RetryPolicy, error) {
+	if v == nil {
+		return nil, nil
+	}
+	ls := v.([]interface{})
+	if len(ls) == 0 || ls[0] == nil {
+		return nil, nil
+	}
+
+	policy := &RetryPolicy{}
+	cfgV := ls[0].(map[string]interface{})
+
+	if maxAttempts, ok := cfgV["max_attempts"]; ok {
+		policy.MaxAttempts = maxAttempts.(int)
+	}
+
+	if initialBackoffV, ok := cfgV["initial_backoff"]; ok && initialBackoffV != "" {
+		initialBackoff, err := time.ParseDuration(initialBackoffV.(string))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse duration from 'initial_backoff' value %q", initialBackoffV)
+		}
+		policy.InitialBackoff = initialBackoff
+	}
+
+	if maxBackoffV, ok := cfgV["max_backoff"]; ok && maxBackoffV != "" {
+		maxBackoff, err := time.ParseDuration(maxBackoffV.(string))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse duration from 'max_backoff' value %q", maxBackoffV)
+		}
+		policy.MaxBackoff = maxBackoff
+	}
+
+	if jitter, ok := cfgV["jitter"]; ok {
+		policy.Jitter = jitter.(float64)
+	}
+
+	if codes, ok := cfgV["retryable_status_codes"]; ok {
+		for _, code := range codes.([]interface{}) {
+			policy.RetryableStatusCodes = append(policy.RetryableStatusCodes, code.(int))
+		}
+	}
+
+	if reasons, ok := cfgV["retryable_error_reasons"]; ok {
+		for _, reason := range reasons.([]interface{}) {
+			policy.RetryableErrorReasons = append(policy.RetryableErrorReasons, reason.(string))
+		}
+	}
+
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// Validate checks the policy for inconsistent settings.
+func (p *RetryPolicy) Validate() error {
+	if p == nil {
+		return nil
+	}
+	if p.MaxAttempts < 0 {
+		return fmt.Errorf("'max_attempts' must be non-negative, got %d", p.MaxAttempts)
+	}
+	if p.Jitter < 0 || p.Jitter > 1 {
+		return fmt.Errorf("'jitter' must be between 0 and 1, got %v", p.Jitter)
+	}
+	if p.MaxBackoff > 0 && p.InitialBackoff > p.MaxBackoff {
+		return fmt.Errorf("'initial_backoff' (%s) must not be greater than 'max_backoff' (%s)", p.InitialBackoff, p.MaxBackoff)
+	}
+	return nil
+}
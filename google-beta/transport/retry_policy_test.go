@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+package transport
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// testRetryPolicyHandler returns failCode (with the given JSON body) for the
+// first failures requests and 200 afterwards, counting every request.
+func testRetryPolicyHandler(hits *int32, failures int32, failCode int, failBody string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(hits, 1)
+		w.Header().Set("Content-Type", "application/json")
+		if n <= failures {
+			w.WriteHeader(failCode)
+			w.Write([]byte(failBody))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`))
+	})
+}
+
+func TestRetryTransport_PolicyMaxAttempts(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(testRetryPolicyHandler(&hits, 100, http.StatusServiceUnavailable, `{"error": {"code": 503}}`))
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport = NewTransportWithDefaultRetries(http.DefaultTransport, &RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	})
+
+	resp, err := client.Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	if hits != 3 {
+		t.Errorf("expected 3 requests, got %d", hits)
+	}
+}
+
+func TestRetryTransport_PolicyRetryableStatusCodes(t *testing.T) {
+	cases := map[string]struct {
+		Policy       *RetryPolicy
+		ExpectedHits int32
+		ExpectedCode int
+	}{
+		"504 is not retried by default": {
+			Policy:       &RetryPolicy{InitialBackoff: time.Millisecond},
+			ExpectedHits: 1,
+			ExpectedCode: http.StatusGatewayTimeout,
+		},
+		"504 is retried when listed in the policy": {
+			Policy:       &RetryPolicy{InitialBackoff: time.Millisecond, RetryableStatusCodes: []int{504}},
+			ExpectedHits: 3,
+			ExpectedCode: http.StatusOK,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			var hits int32
+			ts := httptest.NewServer(testRetryPolicyHandler(&hits, 2, http.StatusGatewayTimeout, `{"error": {"code": 504}}`))
+			defer ts.Close()
+
+			client := ts.Client()
+			client.Transport = NewTransportWithDefaultRetries(http.DefaultTransport, tc.Policy)
+
+			resp, err := client.Get(ts.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if resp.StatusCode != tc.ExpectedCode {
+				t.Errorf("expected status %d, got %d", tc.ExpectedCode, resp.StatusCode)
+			}
+			if hits != tc.ExpectedHits {
+				t.Errorf("expected %d requests, got %d", tc.ExpectedHits, hits)
+			}
+		})
+	}
+}
+
+func TestSendRequest_PolicyRetryableErrorReasons(t *testing.T) {
+	body := `{"error": {"code": 403, "message": "slow down", "errors": [{"reason": "userRateLimitExceeded"}]}}`
+
+	cases := map[string]struct {
+		Policy       *RetryPolicy
+		ExpectError  bool
+		ExpectedHits int32
+	}{
+		"reason is not retried without a policy entry": {
+			Policy:       &RetryPolicy{InitialBackoff: time.Millisecond},
+			ExpectError:  true,
+			ExpectedHits: 1,
+		},
+		"reason is retried when listed in the policy": {
+			Policy:       &RetryPolicy{InitialBackoff: time.Millisecond, RetryableErrorReasons: []string{"userRateLimitExceeded"}},
+			ExpectedHits: 3,
+		},
+		"max attempts bounds retries of a listed reason": {
+			Policy:       &RetryPolicy{InitialBackoff: time.Millisecond, MaxAttempts: 2, RetryableErrorReasons: []string{"userRateLimitExceeded"}},
+			ExpectError:  true,
+			ExpectedHits: 2,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			var hits int32
+			ts := httptest.NewServer(testRetryPolicyHandler(&hits, 2, http.StatusForbidden, body))
+			defer ts.Close()
+
+			config := &Config{
+				Client:      ts.Client(),
+				RetryPolicy: tc.Policy,
+			}
+			_, err := SendRequest(SendRequestOptions{
+				Config:    config,
+				Method:    "GET",
+				RawURL:    ts.URL,
+				UserAgent: "test",
+				Timeout:   10 * time.Second,
+			})
+			if tc.ExpectError && err == nil {
+				t.Errorf("expected an error, got none")
+			}
+			if !tc.ExpectError && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tc.ExpectError && err != nil && !IsGoogleApiErrorWithCode(err, 403) {
+				t.Errorf("expected the last API error to be returned, got: %v", err)
+			}
+			if hits != tc.ExpectedHits {
+				t.Errorf("expected %d requests, got %d", tc.ExpectedHits, hits)
+			}
+		})
+	}
+}
+
+func TestSendRequest_PolicyMaxAttemptsWithRetryTransport(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(testRetryPolicyHandler(&hits, 100, http.StatusServiceUnavailable, `{"error": {"code": 503}}`))
+	defer ts.Close()
+
+	policy := &RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+	client := ts.Client()
+	client.Transport = NewTransportWithDefaultRetries(http.DefaultTransport, policy)
+
+	config := &Config{
+		Client:      client,
+		RetryPolicy: policy,
+	}
+	_, err := SendRequest(SendRequestOptions{
+		Config:    config,
+		Method:    "GET",
+		RawURL:    ts.URL,
+		UserAgent: "test",
+		Timeout:   10 * time.Second,
+	})
+	if !IsGoogleApiErrorWithCode(err, 503) {
+		t.Errorf("expected the last API error to be returned, got: %v", err)
+	}
+	// The transport doesn't retry each attempt of SendRequest again
+	if hits != 3 {
+		t.Errorf("expected 3 requests, got %d", hits)
+	}
+}
+
+func TestClientWithAdditionalRetries_Policy(t *testing.T) {
+	var hits int32
+	ts := httptest.NewServer(testRetryPolicyHandler(&hits, 100, http.StatusServiceUnavailable, `{"error": {"code": 503}}`))
+	defer ts.Close()
+
+	policy := &RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}
+	client := ts.Client()
+	client.Transport = NewTransportWithDefaultRetries(http.DefaultTransport, policy)
+
+	resp, err := ClientWithAdditionalRetries(client, policy, testRetryTransportRetryPredicate).Get(ts.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, got %d", http.StatusServiceUnavailable, resp.StatusCode)
+	}
+	// Only the outer retry transport retries, within the attempts of the policy
+	if hits != 2 {
+		t.Errorf("expected 2 requests, got %d", hits)
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	cases := map[string]struct {
+		Policy   *RetryPolicy
+		Expected []time.Duration
+	}{
+		"nil policy uses the default Fibonacci backoff": {
+			Policy: nil,
+			Expected: []time.Duration{
+				500 * time.Millisecond, 1 * time.Second, 1500 * time.Millisecond, 2500 * time.Millisecond, 4 * time.Second,
+			},
+		},
+		"initial backoff is configurable": {
+			Policy: &RetryPolicy{InitialBackoff: time.Second},
+			Expected: []time.Duration{
+				1 * time.Second, 2 * time.Second, 3 * time.Second, 5 * time.Second, 8 * time.Second,
+			},
+		},
+		"max backoff caps the sequence": {
+			Policy: &RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 3 * time.Second},
+			Expected: []time.Duration{
+				1 * time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second, 3 * time.Second,
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			b := tc.Policy.newBackoff()
+			for i, expected := range tc.Expected {
+				if got := b.Next(); got != expected {
+					t.Errorf("backoff %d: expected %s, got %s", i, expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryBackoff_Jitter(t *testing.T) {
+	b := (&RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Second, Jitter: 0.5}).newBackoff()
+	for i := 0; i < 100; i++ {
+		got := b.Next()
+		if got < 500*time.Millisecond || got > 1500*time.Millisecond {
+			t.Fatalf("expected jittered backoff within [500ms, 1.5s], got %s", got)
+		}
+	}
+}
+
+func TestExpandProviderRetryPolicy(t *testing.T) {
+	cases := map[string]struct {
+		Input       interface{}
+		Expected    *RetryPolicy
+		ExpectError bool
+	}{
+		"unset block returns a nil policy": {
+			Input:    []interface{}{},
+			Expected: nil,
+		},
+		"all fields are expanded": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":            5,
+					"initial_backoff":         "1s",
+					"max_backoff":             "30s",
+					"jitter":                  0.1,
+					"retryable_status_codes":  []interface{}{504},
+					"retryable_error_reasons": []interface{}{"rateLimitExceeded"},
+				},
+			},
+			Expected: &RetryPolicy{
+				MaxAttempts:           5,
+				InitialBackoff:        time.Second,
+				MaxBackoff:            30 * time.Second,
+				Jitter:                0.1,
+				RetryableStatusCodes:  []int{504},
+				RetryableErrorReasons: []string{"rateLimitExceeded"},
+			},
+		},
+		"initial backoff greater than max backoff is an error": {
+			Input: []interface{}{
+				map[string]interface{}{
+					"initial_backoff": "1m",
+					"max_backoff":     "30s",
+				},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			policy, err := ExpandProviderRetryPolicy(tc.Input)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.Expected == nil {
+				if policy != nil {
+					t.Fatalf("expected a nil policy, got %#v", policy)
+				}
+				return
+			}
+			if policy.MaxAttempts != tc.Expected.MaxAttempts ||
+				policy.InitialBackoff != tc.Expected.InitialBackoff ||
+				policy.MaxBackoff != tc.Expected.MaxBackoff ||
+				policy.Jitter != tc.Expected.Jitter ||
+				len(policy.RetryableStatusCodes) != len(tc.Expected.RetryableStatusCodes) ||
+				len(policy.RetryableErrorReasons) != len(tc.Expected.RetryableErrorReasons) {
+				t.Fatalf("expected %#v, got %#v", tc.Expected, policy)
+			}
+		})
+	}
+}
@@ -3,6 +3,9 @@
 package transport
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 	"time"
@@ -212,3 +215,74 @@ func TestRetryWithPolling_retriedAndFailed(t *testing.T) {
 		t.Errorf("expected error function to be called exactly twice, but was called %d times", retryCount)
 	}
 }
+
+func TestRetry_ContextCancelled(t *testing.T) {
+	cases := map[string]RetryOptions{
+		"default backoff": {},
+		"polling": {
+			PollInterval: 10 * time.Second,
+		},
+		"retry policy": {
+			Policy: &RetryPolicy{InitialBackoff: 10 * time.Second},
+		},
+	}
+
+	for tn, opt := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			opt.Context = ctx
+			opt.Timeout = 10 * time.Minute
+			opt.RetryFunc = func() error {
+				return &googleapi.Error{
+					Code: 503,
+				}
+			}
+			time.AfterFunc(100*time.Millisecond, cancel)
+
+			start := time.Now()
+			if err := Retry(opt); err == nil {
+				t.Errorf("expected an error, got none")
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("expected retries to stop promptly after cancellation, took %s", elapsed)
+			}
+		})
+	}
+}
+
+func TestSendRequest_ContextCancelled(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer ts.Close()
+
+	cases := map[string]func(ctx context.Context) SendRequestOptions{
+		"request context": func(ctx context.Context) SendRequestOptions {
+			return SendRequestOptions{Config: &Config{Client: ts.Client()}, Context: ctx}
+		},
+		"config context": func(ctx context.Context) SendRequestOptions {
+			return SendRequestOptions{Config: &Config{Client: ts.Client(), Context: ctx}}
+		},
+	}
+
+	for tn, opts := range cases {
+		t.Run(tn, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(100*time.Millisecond, cancel)
+
+			opt := opts(ctx)
+			opt.Method = "GET"
+			opt.RawURL = ts.URL
+			opt.UserAgent = "test"
+			opt.Timeout = 10 * time.Minute
+
+			start := time.Now()
+			if _, err := SendRequest(opt); err == nil {
+				t.Errorf("expected an error, got none")
+			}
+			if elapsed := time.Since(start); elapsed > 5*time.Second {
+				t.Errorf("expected the request to stop promptly after cancellation, took %s", elapsed)
+			}
+		})
+	}
+}
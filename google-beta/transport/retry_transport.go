@@ -17,13 +17,13 @@
 // Example Usage in Terraform Config:
 //	client := oauth2.NewClient(ctx, tokenSource)
 //	// Create with default retry predicates
-//	client.Transport := NewTransportWithDefaultRetries(client.Transport, defaultTimeout)
+//	client.Transport := NewTransportWithDefaultRetries(client.Transport, config.RetryPolicy)
 //
 //	// If API uses just default retry predicates:
 //	c.clientCompute, err = compute.NewService(ctx, option.WithHTTPClient(client))
 //	...
 //	// If API needs custom additional retry predicates:
-//	sqlAdminHttpClient := ClientWithAdditionalRetries(client, retryTransport,
+//	sqlAdminHttpClient := ClientWithAdditionalRetries(client, config.RetryPolicy,
 //			isTemporarySqlError1,
 //			isTemporarySqlError2)
 //	c.clientSqlAdmin, err = compute.NewService(ctx, option.WithHTTPClient(sqlAdminHttpClient))
@@ -48,19 +48,23 @@ import (
 
 const defaultRetryTransportTimeoutSec = 90
 
-// NewTransportWithDefaultRetries constructs a default retryTransport that will retry common temporary errors
-func NewTransportWithDefaultRetries(t http.RoundTripper) *retryTransport {
+// NewTransportWithDefaultRetries constructs a default retryTransport that will retry common temporary errors.
+// The policy may be nil, in which case the default backoff and predicates are used.
+func NewTransportWithDefaultRetries(t http.RoundTripper, policy *RetryPolicy) *retryTransport {
 	return &retryTransport{
-		retryPredicates: defaultErrorRetryPredicates,
+		retryPredicates: append(defaultErrorRetryPredicates, policy.ErrorRetryPredicates()...),
+		policy:          policy,
 		internal:        t,
 	}
 }
 
 // Helper method to create a shallow copy of an HTTP client with a shallow-copied retryTransport
 // s.t. the base HTTP transport is the same (i.e. client connection pools are shared, retryPredicates are different)
-func ClientWithAdditionalRetries(baseClient *http.Client, predicates ...RetryErrorPredicateFunc) *http.Client {
+// The policy should be the one of the base client, as retry transports in the base client
+// leave retries to the outer one.
+func ClientWithAdditionalRetries(baseClient *http.Client, policy *RetryPolicy, predicates ...RetryErrorPredicateFunc) *http.Client {
 	copied := *baseClient
-	baseRetryTransport := NewTransportWithDefaultRetries(baseClient.Transport)
+	baseRetryTransport := NewTransportWithDefaultRetries(baseClient.Transport, policy)
 	copied.Transport = baseRetryTransport.WithAddedPredicates(predicates...)
 	return &copied
 }
@@ -75,9 +79,23 @@ func (t *retryTransport) WithAddedPredicates(predicates ...RetryErrorPredicateFu
 
 type retryTransport struct {
 	retryPredicates []RetryErrorPredicateFunc
+	policy          *RetryPolicy
 	internal        http.RoundTripper
 }
 
+type transportRetriesDisabledKey struct{}
+
+// WithoutTransportRetries returns a context whose requests are sent once by retry
+// transports, for requests retried by their caller, so that attempts aren't multiplied.
+func WithoutTransportRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, transportRetriesDisabledKey{}, true)
+}
+
+func transportRetriesDisabled(ctx context.Context) bool {
+	disabled, _ := ctx.Value(transportRetriesDisabledKey{}).(bool)
+	return disabled
+}
+
 // RoundTrip implements the RoundTripper interface method.
 // It retries the given HTTP request based on the retry predicates
 // registered under the retryTransport.
@@ -96,8 +114,9 @@ func (t *retryTransport) RoundTrip(req *http.Request) (resp *http.Response, resp
 	}
 
 	attempts := 0
-	backoff := time.Millisecond * 500
-	nextBackoff := time.Millisecond * 500
+	backoff := t.policy.newBackoff()
+	// Requests retried by an outer retry transport or by their caller are only sent once
+	singleAttempt := transportRetriesDisabled(ctx)
 
 	// VCR depends on the original request body being consumed, so
 	// consume here. Since this won't affect the request itself,
@@ -117,15 +136,19 @@ Retry:
 		if copyErr != nil {
 			log.Printf("[WARN] Retry Transport: Unable to copy request body: %v.", copyErr)
 			log.Printf("[WARN] Retry Transport: Running request as non-retryable")
-			resp, respErr = t.internal.RoundTrip(req)
+			resp, respErr = t.internal.RoundTrip(req.WithContext(WithoutTransportRetries(req.Context())))
 			break Retry
 		}
 
 		log.Printf("[DEBUG] Retry Transport: request attempt %d", attempts)
 		// Do the wrapped Roundtrip. This is one request in the retry loop.
-		resp, respErr = t.internal.RoundTrip(newRequest)
+		resp, respErr = t.internal.RoundTrip(newRequest.WithContext(WithoutTransportRetries(newRequest.Context())))
 		attempts++
 
+		if singleAttempt {
+			break Retry
+		}
+
 		retryErr := t.checkForRetryableError(resp, respErr)
 		if retryErr == nil {
 			log.Printf("[DEBUG] Retry Transport: Stopping retries, last request was successful")
@@ -135,19 +158,27 @@ Retry:
 			log.Printf("[DEBUG] Retry Transport: Stopping retries, last request failed with non-retryable error: %s", retryErr.Err)
 			break Retry
 		}
+		if t.policy.attemptsExhausted(attempts) {
+			log.Printf("[DEBUG] Retry Transport: Stopping retries, reached max attempts (%d): %s", t.policy.MaxAttempts, retryErr.Err)
+			break Retry
+		}
 
-		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", backoff)
+		wait := backoff.Next()
+		if hint, reason := RetryDelayFromError(retryErr.Err); hint > wait {
+			if deadline, ok := ctx.Deadline(); ok && time.Now().Add(hint).After(deadline) {
+				log.Printf("[DEBUG] Retry Transport: Stopping retries, server requested delay (%s) exceeds the remaining timeout: %s", reason, retryErr.Err)
+				break Retry
+			}
+			log.Printf("[DEBUG] Retry Transport: Using server requested delay (%s) instead of backoff %s", reason, wait)
+			wait = hint
+		}
+		log.Printf("[DEBUG] Retry Transport: Waiting %s before trying request again", wait)
 		select {
 		case <-ctx.Done():
 			log.Printf("[DEBUG] Retry Transport: Stopping retries, context done: %v", ctx.Err())
 			break Retry
-		case <-time.After(backoff):
-			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", backoff)
-
-			// Fibonnaci backoff - 0.5, 1, 1.5, 2.5, 4, 6.5, 10.5, ...
-			lastBackoff := backoff
-			backoff = backoff + nextBackoff
-			nextBackoff = lastBackoff
+		case <-time.After(wait):
+			log.Printf("[DEBUG] Retry Transport: Finished waiting %s before next retry", wait)
 			continue
 		}
 	}
@@ -3,6 +3,7 @@
 package transport
 
 import (
+	"context"
 	"log"
 	"time"
 
@@ -16,12 +17,27 @@ type RetryOptions struct {
 	PollInterval         time.Duration
 	ErrorRetryPredicates []RetryErrorPredicateFunc
 	ErrorAbortPredicates []RetryErrorPredicateFunc
+	// Policy is the provider-level retry policy. If set, it replaces the
+	// default backoff and adds its predicates to ErrorRetryPredicates.
+	Policy *RetryPolicy
+	// Context stops retrying, including any wait between attempts, once it's
+	// done. Defaults to context.Background().
+	Context context.Context
 }
 
 func Retry(opt RetryOptions) error {
 	if opt.Timeout == 0 {
 		opt.Timeout = 1 * time.Minute
 	}
+	if opt.Context == nil {
+		opt.Context = context.Background()
+	}
+
+	if opt.Policy != nil {
+		return retryWithPolicy(opt)
+	}
+
+	deadline := time.Now().Add(opt.Timeout)
 
 	if opt.PollInterval != 0 {
 		refreshFunc := func() (interface{}, string, error) {
@@ -32,6 +48,7 @@ func Retry(opt RetryOptions) error {
 
 			// Check if it is a retryable error.
 			if IsRetryableError(err, opt.ErrorRetryPredicates, opt.ErrorAbortPredicates) {
+				waitForRetryDelay(opt.Context, err, deadline)
 				return "", "retrying", nil
 			}
 
@@ -50,22 +67,98 @@ func Retry(opt RetryOptions) error {
 			PollInterval: opt.PollInterval,
 		}
 
-		_, err := stateChange.WaitForState()
+		_, err := stateChange.WaitForStateContext(opt.Context)
 		return err
 	}
 
-	return resource.Retry(opt.Timeout, func() *resource.RetryError {
+	return resource.RetryContext(opt.Context, opt.Timeout, func() *resource.RetryError {
 		err := opt.RetryFunc()
 		if err == nil {
 			return nil
 		}
 		if IsRetryableError(err, opt.ErrorRetryPredicates, opt.ErrorAbortPredicates) {
+			waitForRetryDelay(opt.Context, err, deadline)
 			return resource.RetryableError(err)
 		}
 		return resource.NonRetryableError(err)
 	})
 }
 
+// retryWithPolicy retries opt.RetryFunc using the backoff and attempt limit
+// from opt.Policy, until it succeeds, returns a non-retryable error or
+// opt.Timeout elapses.
+func retryWithPolicy(opt RetryOptions) error {
+	retryPredicates := append(opt.Policy.ErrorRetryPredicates(), opt.ErrorRetryPredicates...)
+	backoff := opt.Policy.newBackoff()
+	deadline := time.Now().Add(opt.Timeout)
+
+	attempts := 0
+	for {
+		if err := opt.Context.Err(); err != nil {
+			return err
+		}
+		err := opt.RetryFunc()
+		attempts++
+		if err == nil {
+			return nil
+		}
+		if !IsRetryableError(err, retryPredicates, opt.ErrorAbortPredicates) {
+			return err
+		}
+		if opt.Policy.attemptsExhausted(attempts) {
+			log.Printf("[DEBUG] Stopping retries, reached max attempts (%d)", attempts)
+			return err
+		}
+
+		wait := backoff.Next()
+		if hint, reason := RetryDelayFromError(err); hint > wait {
+			log.Printf("[DEBUG] Using server requested delay (%s) instead of backoff %s", reason, wait)
+			wait = hint
+		}
+		if time.Now().Add(wait).After(deadline) {
+			log.Printf("[DEBUG] Stopping retries after %d attempts, timeout of %s would be exceeded", attempts, opt.Timeout)
+			return err
+		}
+		log.Printf("[DEBUG] Waiting %s before retrying after error: %s", wait, err)
+		if ctxErr := sleepContext(opt.Context, wait); ctxErr != nil {
+			log.Printf("[DEBUG] Stopping retries after %d attempts, context done: %s", attempts, ctxErr)
+			return err
+		}
+	}
+}
+
+// sleepContext sleeps for d, returning early with the context's error if ctx
+// is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// waitForRetryDelay sleeps for the delay requested by the server in err, if
+// any, before the next attempt, without sleeping past deadline or after ctx is
+// done. The regular backoff of resource.Retry and StateChangeConf still
+// applies afterwards.
+func waitForRetryDelay(ctx context.Context, err error, deadline time.Time) {
+	hint, reason := RetryDelayFromError(err)
+	if hint <= 0 {
+		return
+	}
+	if remaining := time.Until(deadline); hint > remaining {
+		hint = remaining
+	}
+	if hint <= 0 {
+		return
+	}
+	log.Printf("[DEBUG] Waiting %s before retrying, server requested delay (%s)", hint, reason)
+	sleepContext(ctx, hint)
+}
+
 func IsRetryableError(topErr error, retryPredicates, abortPredicates []RetryErrorPredicateFunc) bool {
 	if topErr == nil {
 		return false
@@ -4,6 +4,7 @@ package transport
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -29,6 +30,10 @@ type SendRequestOptions struct {
 	Headers              http.Header
 	ErrorRetryPredicates []RetryErrorPredicateFunc
 	ErrorAbortPredicates []RetryErrorPredicateFunc
+	// Context cancels the request and any retries once it's done, e.g. the
+	// context of a CRUD function. Defaults to the context of Config, which is
+	// done once Terraform is interrupted.
+	Context context.Context
 }
 
 func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
@@ -54,6 +59,18 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 	if opt.Timeout == 0 {
 		opt.Timeout = DefaultRequestTimeout
 	}
+	if opt.Context == nil {
+		opt.Context = opt.Config.Context
+	}
+	if opt.Context == nil {
+		opt.Context = context.Background()
+	}
+
+	// The retry policy caps attempts here, so the retry transport sends each attempt once
+	reqCtx := opt.Context
+	if opt.Config.RetryPolicy != nil {
+		reqCtx = WithoutTransportRetries(reqCtx)
+	}
 
 	var res *http.Response
 	err := Retry(RetryOptions{
@@ -70,7 +87,7 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 			if err != nil {
 				return err
 			}
-			req, err := http.NewRequest(opt.Method, u, &buf)
+			req, err := http.NewRequestWithContext(reqCtx, opt.Method, u, &buf)
 			if err != nil {
 				return err
 			}
@@ -91,6 +108,8 @@ func SendRequest(opt SendRequestOptions) (map[string]interface{}, error) {
 		Timeout:              opt.Timeout,
 		ErrorRetryPredicates: opt.ErrorRetryPredicates,
 		ErrorAbortPredicates: opt.ErrorAbortPredicates,
+		Policy:               opt.Config.RetryPolicy,
+		Context:              opt.Context,
 	})
 	if err != nil {
 		return nil, err
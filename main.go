@@ -14,6 +14,7 @@ import (
 
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/fwprovider"
 	"github.com/hashicorp/terraform-provider-google-beta/google-beta/provider"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
 	ver "github.com/hashicorp/terraform-provider-google-beta/version"
 )
 
@@ -56,6 +57,10 @@ func main() {
 		serveOpts...,
 	)
 
+	// Write the request logs buffered by the provider once Terraform stops it
+	transport_tpg.FlushRequestTracers()
+	transport_tpg.FlushRequestRecorders()
+
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,330 @@
+// caiimport generates Terraform import blocks for the resources in a Cloud Asset Inventory export.
+//
+// Example usage:
+//
+//	gcloud asset export --project my-project --content-type resource --output-path gs://my-bucket/assets.json
+//	gsutil cp gs://my-bucket/assets.json .
+//	go run scripts/caiimport/caiimport.go -input assets.json -output imports.tf
+//	terraform plan -generate-config-out=generated.tf
+//
+// The script is run by file from the repository root, as scripts/ is a separate module
+// that doesn't depend on the provider. Its tests are run the same way:
+//
+//	go test scripts/caiimport/caiimport.go scripts/caiimport/caiimport_test.go
+//
+// The input may also be the JSON output of `gcloud asset search-all-resources --format=json`.
+// The script runs offline: asset types are mapped to provider resource types using the
+// resources registered in provider.ResourceMap(), and each import ID is checked by running
+// the resource's importer, which parses it with the same formats as `terraform import`.
+// Terraform generates the configuration of the imported resources from the import blocks.
+//
+// Asset types without a matching resource, and assets whose import ID is rejected, are
+// reported on stderr.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	// "github.com/hashicorp/terraform-provider-google-beta/google-beta/provider" will be replaced with corresponding package based on the version when generating the provider package
+	google "github.com/hashicorp/terraform-provider-google-beta/google-beta/provider"
+	transport_tpg "github.com/hashicorp/terraform-provider-google-beta/google-beta/transport"
+)
+
+var inputFlag = flag.String("input", "", "file containing a Cloud Asset Inventory export, as newline-delimited JSON or a JSON array")
+var outputFlag = flag.String("output", "", "file to write the import blocks to, defaults to stdout")
+
+// asset holds the fields of a Cloud Asset Inventory asset used by this script
+type asset struct {
+	Name      string `json:"name"`
+	AssetType string `json:"assetType"`
+	Resource  struct {
+		Data map[string]interface{} `json:"data"`
+	} `json:"resource"`
+}
+
+// serviceResourcePrefixes maps API services to the prefix of their resource types
+// where the two differ, e.g. "cloudkms.googleapis.com/KeyRing" is "google_kms_key_ring".
+var serviceResourcePrefixes = map[string]string{
+	"artifactregistry":     "artifact_registry",
+	"bigtableadmin":        "bigtable",
+	"cloudkms":             "kms",
+	"cloudresourcemanager": "",
+	"file":                 "filestore",
+	"iam":                  "",
+	"run":                  "cloud_run_v2",
+	"secretmanager":        "secret_manager",
+	"sqladmin":             "sql",
+}
+
+// assetResourceTypes maps asset types whose resource type can't be derived from their name
+var assetResourceTypes = map[string]string{
+	"logging.googleapis.com/LogSink":   "google_logging_project_sink",
+	"sqladmin.googleapis.com/Instance": "google_sql_database_instance",
+}
+
+// assetImportIds builds the import ID of asset types whose name doesn't contain it
+var assetImportIds = map[string]func(a asset, relativePath string) string{
+	// Projects are named by number, but imported by id
+	"cloudresourcemanager.googleapis.com/Project": func(a asset, relativePath string) string {
+		if id, ok := a.Resource.Data["projectId"].(string); ok {
+			return id
+		}
+		return strings.TrimPrefix(relativePath, "projects/")
+	},
+	// Service accounts are named by unique id, but imported by email
+	"iam.googleapis.com/ServiceAccount": func(a asset, relativePath string) string {
+		if email, ok := a.Resource.Data["email"].(string); ok {
+			return relativePath[:strings.LastIndex(relativePath, "/")+1] + email
+		}
+		return relativePath
+	},
+}
+
+// errOffline is returned for any API request made while checking import IDs
+var errOffline = errors.New("caiimport runs offline")
+
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errOffline
+}
+
+func main() {
+	flag.Parse()
+	if *inputFlag == "" {
+		fmt.Println("-input must be set")
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	assets, err := readAssets(*inputFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out := os.Stdout
+	if *outputFlag != "" {
+		out, err = os.Create(*outputFlag)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer out.Close()
+	}
+
+	// Importers log through the standard logger, which is only used for the report from here on
+	log.SetFlags(0)
+	log.SetOutput(io.Discard)
+	report := log.New(os.Stderr, "", 0)
+
+	resources := google.ResourceMap()
+	config := &transport_tpg.Config{
+		Client:    &http.Client{Transport: offlineTransport{}},
+		UserAgent: "caiimport",
+	}
+
+	unmapped := map[string]int{}
+	names := map[string]int{}
+	imported := 0
+	for _, a := range assets {
+		resourceType, ok := resourceTypeForAsset(a, resources)
+		if !ok {
+			unmapped[a.AssetType]++
+			continue
+		}
+
+		id := importIdForAsset(a)
+		verified, err := checkImportId(resources[resourceType], id, config)
+		if err != nil {
+			report.Printf("Skipping %s: import ID %q for %s is invalid: %s", a.Name, id, resourceType, err)
+			continue
+		}
+
+		label := resourceLabel(id)
+		names[resourceType+"."+label]++
+		if n := names[resourceType+"."+label]; n > 1 {
+			label = fmt.Sprintf("%s_%d", label, n)
+		}
+
+		fmt.Fprintf(out, "# %s\n", a.Name)
+		if !verified {
+			fmt.Fprintf(out, "# The import ID could not be verified offline.\n")
+		}
+		fmt.Fprintf(out, "import {\n  to = %s.%s\n  id = %q\n}\n\n", resourceType, label, id)
+		imported++
+	}
+
+	report.Printf("Generated %d import blocks for %d assets.", imported, len(assets))
+	if len(unmapped) > 0 {
+		types := make([]string, 0, len(unmapped))
+		for t := range unmapped {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+		report.Printf("Asset types without a matching resource:")
+		for _, t := range types {
+			report.Printf("  %s (%d assets)", t, unmapped[t])
+		}
+	}
+}
+
+// readAssets reads assets from newline-delimited JSON, as written by `gcloud asset export`,
+// or from a JSON array, as written by `gcloud asset search-all-resources --format=json`.
+func readAssets(path string) ([]asset, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := bufio.NewReader(f)
+	first, err := firstNonSpace(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %s", path, err)
+	}
+
+	var assets []asset
+	dec := json.NewDecoder(r)
+	if first == '[' {
+		if err := dec.Decode(&assets); err != nil {
+			return nil, fmt.Errorf("error parsing %s: %s", path, err)
+		}
+		return assets, nil
+	}
+	for {
+		var a asset
+		if err := dec.Decode(&a); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error parsing %s: %s", path, err)
+		}
+		assets = append(assets, a)
+	}
+	return assets, nil
+}
+
+func firstNonSpace(r *bufio.Reader) (byte, error) {
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if !unicode.IsSpace(rune(b)) {
+			return b, r.UnreadByte()
+		}
+	}
+}
+
+// resourceTypeForAsset returns the provider resource type that manages an asset,
+// e.g. "google_compute_instance" for "compute.googleapis.com/Instance".
+func resourceTypeForAsset(a asset, resources map[string]*schema.Resource) (string, bool) {
+	if t, ok := assetResourceTypes[a.AssetType]; ok {
+		_, exists := resources[t]
+		return t, exists
+	}
+
+	parts := strings.SplitN(a.AssetType, "/", 2)
+	if len(parts) != 2 {
+		return "", false
+	}
+	service := strings.TrimSuffix(parts[0], ".googleapis.com")
+	prefix, ok := serviceResourcePrefixes[service]
+	if !ok {
+		prefix = service
+	}
+	kind := toSnakeCase(parts[1])
+
+	var candidates []string
+	if prefix == "" {
+		candidates = []string{"google_" + kind}
+	} else {
+		candidates = []string{"google_" + prefix + "_" + kind}
+		// Compute Engine shares asset types between global and regional resources
+		if service == "compute" && strings.Contains(a.Name, "/regions/") {
+			candidates = append([]string{"google_compute_region_" + kind}, candidates...)
+		}
+	}
+
+	for _, t := range candidates {
+		if r, ok := resources[t]; ok && r.Importer != nil {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// importIdForAsset returns the import ID of an asset, which is the relative resource
+// name of the asset unless the asset type has an entry in assetImportIds.
+func importIdForAsset(a asset) string {
+	relativePath := strings.TrimPrefix(a.Name, "//")
+	relativePath = relativePath[strings.Index(relativePath, "/")+1:]
+	if f, ok := assetImportIds[a.AssetType]; ok {
+		return f(a, relativePath)
+	}
+	return relativePath
+}
+
+// checkImportId runs the importer of a resource on an import ID. It returns whether
+// the ID could be verified, as importers that make API requests can't run offline.
+func checkImportId(r *schema.Resource, id string, config *transport_tpg.Config) (verified bool, err error) {
+	defer func() {
+		// Importers expecting a fully configured provider may panic
+		if recover() != nil {
+			verified, err = false, nil
+		}
+	}()
+
+	d := r.Data(nil)
+	d.SetId(id)
+	if r.Importer.StateContext != nil {
+		_, err = r.Importer.StateContext(context.Background(), d, config)
+	} else {
+		_, err = r.Importer.State(d, config)
+	}
+	// Request errors are often flattened into strings by the time they are returned
+	if err != nil && (errors.Is(err, errOffline) || strings.Contains(err.Error(), errOffline.Error())) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+var nonLabelCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// resourceLabel returns a name for the resource in the import block from the last
+// segment of its import ID, e.g. "my_instance" for "projects/p/zones/z/instances/my-instance".
+func resourceLabel(id string) string {
+	label := id[strings.LastIndex(id, "/")+1:]
+	label = strings.Trim(nonLabelCharacters.ReplaceAllString(strings.ToLower(label), "_"), "_")
+	if label == "" || unicode.IsDigit(rune(label[0])) {
+		label = "r_" + label
+	}
+	return label
+}
+
+func toSnakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
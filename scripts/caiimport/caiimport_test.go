@@ -0,0 +1,90 @@
+package main
+
+import (
+	"testing"
+
+	google "github.com/hashicorp/terraform-provider-google-beta/google-beta/provider"
+)
+
+func TestResourceTypeForAsset(t *testing.T) {
+	assets, err := readAssets("testdata/assets.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	byType := map[string]asset{}
+	for _, a := range assets {
+		byType[a.AssetType] = a
+	}
+
+	cases := map[string]struct {
+		AssetType    string
+		ResourceType string
+		ImportId     string
+		Mapped       bool
+	}{
+		"project is imported by project id": {
+			AssetType:    "cloudresourcemanager.googleapis.com/Project",
+			ResourceType: "google_project",
+			ImportId:     "my-project",
+			Mapped:       true,
+		},
+		"service account is imported by email": {
+			AssetType:    "iam.googleapis.com/ServiceAccount",
+			ResourceType: "google_service_account",
+			ImportId:     "projects/my-project/serviceAccounts/deployer@my-project.iam.gserviceaccount.com",
+			Mapped:       true,
+		},
+		"service prefix differs from resource prefix": {
+			AssetType:    "cloudkms.googleapis.com/KeyRing",
+			ResourceType: "google_kms_key_ring",
+			ImportId:     "projects/my-project/locations/us/keyRings/my-ring",
+			Mapped:       true,
+		},
+		"cloud run service maps to the v2 resource": {
+			AssetType:    "run.googleapis.com/Service",
+			ResourceType: "google_cloud_run_v2_service",
+			ImportId:     "projects/my-project/locations/us-central1/services/my-service",
+			Mapped:       true,
+		},
+		"asset type with an explicit resource type": {
+			AssetType:    "sqladmin.googleapis.com/Instance",
+			ResourceType: "google_sql_database_instance",
+			ImportId:     "projects/my-project/instances/my-db",
+			Mapped:       true,
+		},
+		"regional compute asset without a regional resource": {
+			AssetType:    "compute.googleapis.com/Subnetwork",
+			ResourceType: "google_compute_subnetwork",
+			ImportId:     "projects/my-project/regions/us-central1/subnetworks/my-subnet",
+			Mapped:       true,
+		},
+		"unknown asset type": {
+			AssetType: "example.googleapis.com/Widget",
+			Mapped:    false,
+		},
+	}
+
+	resources := google.ResourceMap()
+	for tn, tc := range cases {
+		t.Run(tn, func(t *testing.T) {
+			a, ok := byType[tc.AssetType]
+			if !ok {
+				t.Fatalf("no asset of type %s in testdata/assets.json", tc.AssetType)
+			}
+
+			resourceType, mapped := resourceTypeForAsset(a, resources)
+			if mapped != tc.Mapped {
+				t.Fatalf("expected mapped to be %t, got %t (%q)", tc.Mapped, mapped, resourceType)
+			}
+			if !mapped {
+				return
+			}
+			if resourceType != tc.ResourceType {
+				t.Errorf("expected resource type %q, got %q", tc.ResourceType, resourceType)
+			}
+			if id := importIdForAsset(a); id != tc.ImportId {
+				t.Errorf("expected import ID %q, got %q", tc.ImportId, id)
+			}
+		})
+	}
+}